@@ -0,0 +1,33 @@
+package twilio
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// AttributesEqual reports whether two JSON attributes hold the same value. The API
+// returns attributes either as a JSON object or as a string holding the JSON object,
+// missing attributes equal an empty object and malformed attributes are compared
+// as text.
+func AttributesEqual(a, b json.RawMessage) bool {
+	return reflect.DeepEqual(decodeAttributes(a), decodeAttributes(b))
+}
+
+func decodeAttributes(raw json.RawMessage) interface{} {
+	var v interface{}
+	if len(raw) == 0 {
+		return map[string]interface{}{}
+	}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return string(raw)
+	}
+	if s, ok := v.(string); ok {
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return s
+		}
+	}
+	if v == nil {
+		return map[string]interface{}{}
+	}
+	return v
+}
@@ -0,0 +1,30 @@
+package twilio
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAttributesEqual(t *testing.T) {
+	tt := []struct {
+		a, b string
+		exp  bool
+	}{
+		{`{"a": 1}`, `{"a":1}`, true},
+		{`{"a": 1}`, `"{\"a\": 1}"`, true},
+		{`{"a": 1}`, `{"a": 2}`, false},
+		{`{}`, `null`, true},
+		{`{}`, ``, true},
+		{`"null"`, ``, true},
+		{`{"a":`, `{"a":`, true},
+		{`{"a":`, `{"b":`, false},
+		{`{"a":`, `{}`, false},
+		{`"not-encoded"`, `"not-encoded"`, true},
+		{`"not-encoded"`, `{}`, false},
+	}
+	for _, tc := range tt {
+		if got := AttributesEqual(json.RawMessage(tc.a), json.RawMessage(tc.b)); got != tc.exp {
+			t.Errorf("exp %s == %s to be %v", tc.a, tc.b, tc.exp)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Package archive exports the history of Programmable Chat channels to NDJSON
+// and imports it back into a service.
+//
+// An archive holds one Record per line, a channel record is followed by the
+// records of its members, a members record marking them complete, and then the
+// records of its messages ordered by index.
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// Record types.
+const (
+	TypeChannel = "channel"
+	TypeMember  = "member"
+	TypeMembers = "members"
+	TypeMessage = "message"
+)
+
+// Record is a single line of an archive, only the field matching the Type is set.
+// A TypeMembers record only sets the ChannelSid whose members were all exported.
+type Record struct {
+	Type       string        `json:"type"`
+	Channel    *chat.Channel `json:"channel,omitempty"`
+	Member     *chat.Member  `json:"member,omitempty"`
+	ChannelSid string        `json:"channel_sid,omitempty"`
+	Message    *chat.Message `json:"message,omitempty"`
+}
+
+// maxRecordSize bounds the size of a single archive line.
+const maxRecordSize = 1 << 20
+
+// Resume indexes of the channels whose messages were not exported yet.
+const (
+	// HeaderExported is the resume index of a channel whose channel and member records
+	// were exported but none of its messages, the export resumes from its first message.
+	HeaderExported = -1
+
+	// ChannelExported is the resume index of a channel whose channel record was exported
+	// but not all of its members, the export resumes from its members. The members
+	// already exported are written again, the importer ensures they are added once.
+	ChannelExported = -2
+)
+
+// Resume reads an archive and returns by channel sid the index following the last
+// exported message, HeaderExported or ChannelExported, used to resume an interrupted
+// export. A partial last line left by an interrupted write is ignored, use ResumeFile
+// to remove it before appending to the archive.
+func Resume(r io.Reader) (map[string]int, error) {
+	next, _, err := resume(r)
+	return next, err
+}
+
+// ResumeFile reads the archive like Resume and truncates its partial last line, the
+// file offset is left at the end of the last complete record.
+func ResumeFile(f *os.File) (map[string]int, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "archive: could not read records")
+	}
+	next, size, err := resume(f)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Truncate(size); err != nil {
+		return nil, errors.Wrap(err, "archive: could not truncate partial record")
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "archive: could not truncate partial record")
+	}
+	return next, nil
+}
+
+// resume returns the resume indexes and the size of the complete records of an archive.
+func resume(r io.Reader) (map[string]int, int64, error) {
+	next := make(map[string]int)
+	size, err := scan(r, true, func(rec Record) error {
+		switch rec.Type {
+		case TypeChannel:
+			if _, ok := next[rec.Channel.Sid]; !ok {
+				next[rec.Channel.Sid] = ChannelExported
+			}
+		case TypeMembers:
+			if next[rec.ChannelSid] < HeaderExported {
+				next[rec.ChannelSid] = HeaderExported
+			}
+		case TypeMessage:
+			if rec.Message.Index+1 > next[rec.Message.ChannelSid] {
+				next[rec.Message.ChannelSid] = rec.Message.Index + 1
+			}
+		}
+		return nil
+	})
+	return next, size, err
+}
+
+// scan decodes the records of an archive and calls fn for each of them, it returns
+// the size of the lines read. With partial set a last line without a newline, left by
+// an interrupted write, is ignored and not counted.
+func scan(r io.Reader, partial bool, fn func(Record) error) (int64, error) {
+	var (
+		size         int64
+		advance      int
+		unterminated bool
+	)
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), maxRecordSize)
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		n, token, err := bufio.ScanLines(data, atEOF)
+		if token != nil {
+			advance, unterminated = n, data[n-1] != '\n'
+		}
+		return n, token, err
+	})
+	for line := 1; s.Scan(); line++ {
+		if partial && unterminated {
+			break
+		}
+		if len(s.Bytes()) == 0 {
+			size += int64(advance)
+			continue
+		}
+
+		var rec Record
+		if err := json.Unmarshal(s.Bytes(), &rec); err != nil {
+			return size, errors.Wrapf(err, "archive: invalid record on line %d", line)
+		}
+		if (rec.Type == TypeChannel && rec.Channel == nil) ||
+			(rec.Type == TypeMember && rec.Member == nil) ||
+			(rec.Type == TypeMembers && rec.ChannelSid == "") ||
+			(rec.Type == TypeMessage && rec.Message == nil) {
+			return size, errors.Errorf("archive: incomplete %s record on line %d", rec.Type, line)
+		}
+		if err := fn(rec); err != nil {
+			return size, err
+		}
+		size += int64(advance)
+	}
+	return size, errors.Wrap(s.Err(), "archive: could not read records")
+}
+
+// Attributes returns the JSON attributes as sent on creation, the API responds with
+// either a JSON object or a string holding the JSON object.
+func Attributes(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return json.RawMessage(s)
+	}
+	return raw
+}
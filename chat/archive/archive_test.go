@@ -0,0 +1,110 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResume(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		archive := `{"type":"channel","channel":{"sid":"CH1"}}
+{"type":"member","member":{"sid":"MB1","channel_sid":"CH1"}}
+{"type":"members","channel_sid":"CH1"}
+{"type":"message","message":{"channel_sid":"CH1","index":0}}
+{"type":"message","message":{"channel_sid":"CH1","index":4}}
+
+{"type":"channel","channel":{"sid":"CH2"}}
+{"type":"members","channel_sid":"CH2"}
+{"type":"channel","channel":{"sid":"CH3"}}
+{"type":"member","member":{"sid":"MB3","channel_sid":"CH3"}}
+`
+		next, err := Resume(strings.NewReader(archive))
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := map[string]int{"CH1": 5, "CH2": HeaderExported, "CH3": ChannelExported}; !cmp.Equal(exp, next) {
+			t.Errorf("resume diff %v", cmp.Diff(exp, next))
+		}
+	})
+
+	t.Run("partial last line", func(t *testing.T) {
+		archive := `{"type":"channel","channel":{"sid":"CH1"}}
+{"type":"members","channel_sid":"CH1"}
+{"type":"message","message":{"channel_sid":"CH1","ind`
+		next, err := Resume(strings.NewReader(archive))
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := map[string]int{"CH1": HeaderExported}; !cmp.Equal(exp, next) {
+			t.Errorf("resume diff %v", cmp.Diff(exp, next))
+		}
+	})
+
+	t.Run("invalid record", func(t *testing.T) {
+		if _, err := Resume(strings.NewReader("{\n")); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+
+	t.Run("incomplete record", func(t *testing.T) {
+		if _, err := Resume(strings.NewReader("{\"type\":\"message\"}\n")); err == nil {
+			t.Error("exp err, got none")
+		}
+		if _, err := Resume(strings.NewReader("{\"type\":\"members\"}\n")); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+func TestResumeFile(t *testing.T) {
+	complete := `{"type":"channel","channel":{"sid":"CH1"}}
+{"type":"members","channel_sid":"CH1"}
+`
+	f, err := ioutil.TempFile("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	f.WriteString(complete + `{"type":"message","message":{"channel_sid":"CH1","index":0}}`)
+
+	next, err := ResumeFile(f)
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if exp := map[string]int{"CH1": HeaderExported}; !cmp.Equal(exp, next) {
+		t.Errorf("resume diff %v", cmp.Diff(exp, next))
+	}
+
+	// the partial record is removed and the next records are appended after the last complete one
+	f.WriteString(`{"type":"message","message":{"channel_sid":"CH1","index":0}}` + "\n")
+	data, _ := ioutil.ReadFile(f.Name())
+	if _, err := Resume(bytes.NewReader(data)); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if exp := complete + `{"type":"message","message":{"channel_sid":"CH1","index":0}}` + "\n"; string(data) != exp {
+		t.Errorf("exp archive %s, got %s", exp, data)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	tt := map[string]string{
+		`{"a":1}`:       `{"a":1}`,
+		`"{\"a\":1}"`:   `{"a":1}`,
+		`null`:          ``,
+		``:              ``,
+		`[1]`:           `[1]`,
+		`"not-encoded"`: `not-encoded`,
+	}
+	for in, exp := range tt {
+		if got := Attributes(json.RawMessage(in)); string(got) != exp {
+			t.Errorf("exp attributes %s for %s, got %s", exp, in, got)
+		}
+	}
+}
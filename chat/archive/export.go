@@ -0,0 +1,140 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// ChannelLister reads and lists channels, satisfied by chat.ChannelResource.
+type ChannelLister interface {
+	Read(ctx context.Context, serviceSid, identity string) (chat.Channel, error)
+	List(ctx context.Context, serviceSid string, params chat.ChannelListParams) (chat.ChannelList, error)
+}
+
+// MemberLister lists members, satisfied by chat.MemberResource.
+type MemberLister interface {
+	List(ctx context.Context, serviceSid, channelSid string, params chat.MemberListParams) (chat.MemberList, error)
+}
+
+// MessageLister lists messages, satisfied by chat.MessageResource.
+type MessageLister interface {
+	List(ctx context.Context, serviceSid, channelSid string, params chat.MessageListParams) (chat.MessageList, error)
+}
+
+// Exporter streams the history of channels as NDJSON.
+type Exporter struct {
+	Channels ChannelLister
+	Members  MemberLister
+	Messages MessageLister
+}
+
+// NewExporter returns an Exporter using the chat client resources.
+func NewExporter(c chat.Chat) Exporter {
+	return Exporter{Channels: c.Channels, Members: c.Members, Messages: c.Messages}
+}
+
+// ExportChannel writes the channel, its members and its messages with an index greater
+// than or equal to fromIndex. The channel and member records are only written from
+// index 0, a resumed export appends the remaining messages to the archive,
+// HeaderExported appends all of them and ChannelExported the members first.
+func (e Exporter) ExportChannel(ctx context.Context, w io.Writer, serviceSid, channelSid string, fromIndex int) error {
+	chn, err := e.Channels.Read(ctx, serviceSid, channelSid)
+	if err != nil {
+		return errors.Wrapf(err, "archive: could not read channel %s", channelSid)
+	}
+	return e.export(ctx, json.NewEncoder(w), chn, fromIndex)
+}
+
+// ExportService writes every channel of the service, resume holds by channel sid the
+// index to resume from as returned by `Resume`.
+func (e Exporter) ExportService(ctx context.Context, w io.Writer, serviceSid string, resume map[string]int) error {
+	var (
+		enc    = json.NewEncoder(w)
+		params chat.ChannelListParams
+	)
+	for {
+		page, err := e.Channels.List(ctx, serviceSid, params)
+		if err != nil {
+			return errors.Wrap(err, "archive: could not list channels")
+		}
+		for _, chn := range page.Channels {
+			if err := e.export(ctx, enc, chn, resume[chn.Sid]); err != nil {
+				return err
+			}
+		}
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return nil
+		}
+		params.ListParams = next
+	}
+}
+
+func (e Exporter) export(ctx context.Context, enc *json.Encoder, chn chat.Channel, fromIndex int) error {
+	switch fromIndex {
+	case 0:
+		if err := enc.Encode(Record{Type: TypeChannel, Channel: &chn}); err != nil {
+			return err
+		}
+		fallthrough
+	case ChannelExported:
+		if err := e.exportMembers(ctx, enc, chn); err != nil {
+			return err
+		}
+		fromIndex = 0
+	case HeaderExported:
+		fromIndex = 0
+	}
+	return e.exportMessages(ctx, enc, chn, fromIndex)
+}
+
+func (e Exporter) exportMembers(ctx context.Context, enc *json.Encoder, chn chat.Channel) error {
+	var params chat.MemberListParams
+	for {
+		page, err := e.Members.List(ctx, chn.ServiceSid, chn.Sid, params)
+		if err != nil {
+			return errors.Wrapf(err, "archive: could not list members of %s", chn.Sid)
+		}
+		for i := range page.Members {
+			if err := enc.Encode(Record{Type: TypeMember, Member: &page.Members[i]}); err != nil {
+				return err
+			}
+		}
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return enc.Encode(Record{Type: TypeMembers, ChannelSid: chn.Sid})
+		}
+		params.ListParams = next
+	}
+}
+
+// exportMessages pages through all the messages, the API can't list from an index.
+func (e Exporter) exportMessages(ctx context.Context, enc *json.Encoder, chn chat.Channel, fromIndex int) error {
+	params := chat.MessageListParams{ListParams: chat.ListParams{PageSize: 100}, Order: "asc"}
+	for {
+		page, err := e.Messages.List(ctx, chn.ServiceSid, chn.Sid, params)
+		if err != nil {
+			return errors.Wrapf(err, "archive: could not list messages of %s", chn.Sid)
+		}
+		for i := range page.Messages {
+			if page.Messages[i].Index < fromIndex {
+				continue
+			}
+			if err := enc.Encode(Record{Type: TypeMessage, Message: &page.Messages[i]}); err != nil {
+				return err
+			}
+		}
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return nil
+		}
+		params.ListParams = next
+	}
+}
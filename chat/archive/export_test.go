@@ -0,0 +1,182 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+func newFakeChat() *fakeChat {
+	return &fakeChat{
+		channels: []chat.Channel{
+			{Sid: "CH1", ServiceSid: "IS1", UniqueName: "support"},
+			{Sid: "CH2", ServiceSid: "IS1"},
+		},
+		members: map[string][]chat.Member{
+			"CH1": {{Sid: "MB1", ChannelSid: "CH1", Identity: "alice"}},
+		},
+		messages: map[string][]chat.Message{
+			"CH1": {
+				{Sid: "IM0", ChannelSid: "CH1", Index: 0, Body: "hi"},
+				{Sid: "IM1", ChannelSid: "CH1", Index: 1, Body: "hello"},
+			},
+		},
+	}
+}
+
+func types(t *testing.T, archive string) []string {
+	var types []string
+	if _, err := scan(strings.NewReader(archive), false, func(rec Record) error {
+		types = append(types, rec.Type)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return types
+}
+
+func TestExportChannel(t *testing.T) {
+	fake := newFakeChat()
+	e := Exporter{Channels: fake, Members: fakeMembers{fake}, Messages: fakeMessages{fake}}
+
+	t.Run("full export", func(t *testing.T) {
+		var w bytes.Buffer
+		if err := e.ExportChannel(context.TODO(), &w, "IS1", "CH1", 0); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp, got := []string{"channel", "member", "members", "message", "message"}, types(t, w.String()); !cmp.Equal(exp, got) {
+			t.Errorf("records diff %v", cmp.Diff(exp, got))
+		}
+	})
+
+	t.Run("resumed export", func(t *testing.T) {
+		var w bytes.Buffer
+		if err := e.ExportChannel(context.TODO(), &w, "IS1", "CH1", 1); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		exp := `{"type":"message","message":{"sid":"IM1",`
+		if got := w.String(); !strings.HasPrefix(got, exp) || strings.Count(got, "\n") != 1 {
+			t.Errorf("exp a single message record, got %s", got)
+		}
+	})
+
+	t.Run("resumed after the channel record", func(t *testing.T) {
+		var w bytes.Buffer
+		if err := e.ExportChannel(context.TODO(), &w, "IS1", "CH1", HeaderExported); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp, got := []string{"message", "message"}, types(t, w.String()); !cmp.Equal(exp, got) {
+			t.Errorf("records diff %v", cmp.Diff(exp, got))
+		}
+	})
+
+	t.Run("resumed during the members", func(t *testing.T) {
+		var w bytes.Buffer
+		if err := e.ExportChannel(context.TODO(), &w, "IS1", "CH1", ChannelExported); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp, got := []string{"member", "members", "message", "message"}, types(t, w.String()); !cmp.Equal(exp, got) {
+			t.Errorf("records diff %v", cmp.Diff(exp, got))
+		}
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		if err := e.ExportChannel(context.TODO(), &bytes.Buffer{}, "IS1", "CH3", 0); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+func TestExportService(t *testing.T) {
+	fake := newFakeChat()
+	e := Exporter{Channels: fake, Members: fakeMembers{fake}, Messages: fakeMessages{fake}}
+
+	var w bytes.Buffer
+	if err := e.ExportService(context.TODO(), &w, "IS1", map[string]int{"CH1": 2}); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if exp, got := []string{"channel", "members"}, types(t, w.String()); !cmp.Equal(exp, got) {
+		t.Errorf("records diff %v", cmp.Diff(exp, got))
+	}
+	if !strings.Contains(w.String(), `"sid":"CH2"`) {
+		t.Errorf("exp channel CH2 exported, got %s", w.String())
+	}
+}
+
+func TestExportServiceResume(t *testing.T) {
+	fake := newFakeChat()
+	e := Exporter{Channels: fake, Members: fakeMembers{fake}, Messages: fakeMessages{fake}}
+
+	// the export was interrupted once the record of CH2 was written, before its messages
+	var archive bytes.Buffer
+	if err := e.ExportService(context.TODO(), &archive, "IS1", nil); err != nil {
+		t.Fatal(err)
+	}
+	resume, err := Resume(bytes.NewReader(archive.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (map[string]int{"CH1": 2, "CH2": HeaderExported}); !cmp.Equal(exp, resume) {
+		t.Errorf("resume diff %v", cmp.Diff(exp, resume))
+	}
+
+	fake.messages["CH2"] = []chat.Message{{Sid: "IM3", ChannelSid: "CH2", Index: 0, Body: "late"}}
+	if err := e.ExportService(context.TODO(), &archive, "IS1", resume); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+
+	dst := &fakeChat{}
+	im := Importer{Channels: dst, Members: fakeMembers{dst}, Messages: fakeMessages{dst}}
+	if _, err := im.Import(context.TODO(), &archive, "IS2"); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if exp := 1; len(dst.created) != exp {
+		t.Errorf("exp %d created channel, got %d", exp, len(dst.created))
+	}
+	if exp := []chat.MessageCreateParams{{Body: "late"}}; !cmp.Equal(exp, dst.sent["CHnew1"]) {
+		t.Errorf("messages diff %v", cmp.Diff(exp, dst.sent["CHnew1"]))
+	}
+}
+
+func TestExportServiceResumeMembers(t *testing.T) {
+	fake := newFakeChat()
+	e := Exporter{Channels: fake, Members: fakeMembers{fake}, Messages: fakeMessages{fake}}
+
+	// the export was interrupted while writing the members of CH1
+	var archive bytes.Buffer
+	if err := e.ExportChannel(context.TODO(), &archive, "IS1", "CH1", 0); err != nil {
+		t.Fatal(err)
+	}
+	archive.Truncate(strings.Index(archive.String(), `{"type":"members"`))
+
+	resume, err := Resume(bytes.NewReader(archive.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := ChannelExported; resume["CH1"] != exp {
+		t.Errorf("exp resume index %d, got %d", exp, resume["CH1"])
+	}
+	if err := e.ExportService(context.TODO(), &archive, "IS1", resume); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	exp := []string{"channel", "member", "member", "members", "message", "message", "channel", "members"}
+	if got := types(t, archive.String()); !cmp.Equal(exp, got) {
+		t.Errorf("records diff %v", cmp.Diff(exp, got))
+	}
+
+	dst := &fakeChat{}
+	im := Importer{Channels: dst, Members: fakeMembers{dst}, Messages: fakeMessages{dst}}
+	if _, err := im.Import(context.TODO(), &archive, "IS2"); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if exp := 1; len(dst.upserts) != exp {
+		t.Errorf("exp %d upserted channel, got %d", exp, len(dst.upserts))
+	}
+	if exp := 2; len(dst.sent["CHsupport"]) != exp {
+		t.Errorf("exp %d messages, got %d", exp, len(dst.sent["CHsupport"]))
+	}
+}
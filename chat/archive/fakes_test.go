@@ -0,0 +1,88 @@
+package archive
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// pageMeta returns the meta of a page, pages hold a single resource.
+func pageMeta(page, total int) chat.Meta {
+	if page+1 >= total {
+		return chat.Meta{}
+	}
+	return chat.Meta{NextPageURL: "https://chat.twilio.com/v2/Services/IS1/Channels?PageSize=1&Page=" + strconv.Itoa(page+1)}
+}
+
+type fakeChat struct {
+	channels []chat.Channel
+	members  map[string][]chat.Member
+	messages map[string][]chat.Message
+
+	created []chat.ChannelCreateParams
+	upserts []chat.ChannelCreateParams
+	added   map[string][]chat.MemberCreateParams
+	sent    map[string][]chat.MessageCreateParams
+}
+
+func (f *fakeChat) Read(ctx context.Context, serviceSid, identity string) (chat.Channel, error) {
+	for _, c := range f.channels {
+		if c.Sid == identity {
+			return c, nil
+		}
+	}
+	return chat.Channel{}, context.Canceled
+}
+
+func (f *fakeChat) List(ctx context.Context, serviceSid string, params chat.ChannelListParams) (chat.ChannelList, error) {
+	return chat.ChannelList{
+		Channels: f.channels[params.Page : params.Page+1],
+		Meta:     pageMeta(params.Page, len(f.channels)),
+	}, nil
+}
+
+func (f *fakeChat) Create(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error) {
+	f.created = append(f.created, body)
+	return chat.Channel{Sid: "CHnew" + strconv.Itoa(len(f.created))}, nil
+}
+
+func (f *fakeChat) Upsert(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error) {
+	f.upserts = append(f.upserts, body)
+	return chat.Channel{Sid: "CH" + body.UniqueName}, nil
+}
+
+type fakeMembers struct{ *fakeChat }
+
+func (f fakeMembers) List(ctx context.Context, serviceSid, channelSid string, params chat.MemberListParams) (chat.MemberList, error) {
+	return chat.MemberList{Members: f.members[channelSid]}, nil
+}
+
+func (f fakeMembers) Ensure(ctx context.Context, serviceSid, channelSid string, body chat.MemberCreateParams) (chat.Member, error) {
+	if f.added == nil {
+		f.added = make(map[string][]chat.MemberCreateParams)
+	}
+	f.added[channelSid] = append(f.added[channelSid], body)
+	return chat.Member{}, nil
+}
+
+type fakeMessages struct{ *fakeChat }
+
+func (f fakeMessages) List(ctx context.Context, serviceSid, channelSid string, params chat.MessageListParams) (chat.MessageList, error) {
+	msgs := f.messages[channelSid]
+	if len(msgs) == 0 {
+		return chat.MessageList{}, nil
+	}
+	return chat.MessageList{
+		Messages: msgs[params.Page : params.Page+1],
+		Meta:     pageMeta(params.Page, len(msgs)),
+	}, nil
+}
+
+func (f fakeMessages) Send(ctx context.Context, serviceSid, channelSid string, body chat.MessageCreateParams) (chat.Message, error) {
+	if f.sent == nil {
+		f.sent = make(map[string][]chat.MessageCreateParams)
+	}
+	f.sent[channelSid] = append(f.sent[channelSid], body)
+	return chat.Message{}, nil
+}
@@ -0,0 +1,152 @@
+package archive
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// ChannelCreator creates channels, satisfied by chat.ChannelResource.
+type ChannelCreator interface {
+	Create(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error)
+	Upsert(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error)
+}
+
+// MemberAdder adds members, satisfied by chat.MemberResource.
+type MemberAdder interface {
+	Ensure(ctx context.Context, serviceSid, channelSid string, body chat.MemberCreateParams) (chat.Member, error)
+}
+
+// MessageSender sends messages, satisfied by chat.MessageResource.
+type MessageSender interface {
+	Send(ctx context.Context, serviceSid, channelSid string, body chat.MessageCreateParams) (chat.Message, error)
+}
+
+// Importer recreates the channels of an archive preserving the authors and dates.
+type Importer struct {
+	Channels ChannelCreator
+	Members  MemberAdder
+	Messages MessageSender
+
+	// Roles maps the role sids of the archive to the roles of the service,
+	// members with an unmapped role get the default channel role.
+	Roles map[string]string
+}
+
+// Stats counts the imported records, media messages are skipped since their
+// media belongs to the exported service.
+type Stats struct {
+	Channels int
+	Members  int
+	Messages int
+	Skipped  int
+}
+
+// NewImporter returns an Importer using the chat client resources.
+func NewImporter(c chat.Chat) Importer {
+	return Importer{Channels: c.Channels, Members: c.Members, Messages: c.Messages}
+}
+
+// Import reads the archive and recreates its channels, members and messages in the
+// service. Channels with a unique name and members are reused when they exist.
+func (im Importer) Import(ctx context.Context, r io.Reader, serviceSid string) (Stats, error) {
+	var (
+		stats    Stats
+		channels = make(map[string]string)
+	)
+	_, err := scan(r, false, func(rec Record) error {
+		switch rec.Type {
+		case TypeChannel:
+			sid, err := im.importChannel(ctx, serviceSid, *rec.Channel)
+			if err != nil {
+				return errors.Wrapf(err, "archive: could not import channel %s", rec.Channel.Sid)
+			}
+			channels[rec.Channel.Sid] = sid
+			stats.Channels++
+
+		case TypeMember:
+			sid, ok := channels[rec.Member.ChannelSid]
+			if !ok {
+				return errors.Errorf("archive: member %s of unknown channel %s", rec.Member.Sid, rec.Member.ChannelSid)
+			}
+			if _, err := im.Members.Ensure(ctx, serviceSid, sid, MemberParams(*rec.Member, im.Roles)); err != nil {
+				return errors.Wrapf(err, "archive: could not import member %s", rec.Member.Sid)
+			}
+			stats.Members++
+
+		case TypeMessage:
+			sid, ok := channels[rec.Message.ChannelSid]
+			if !ok {
+				return errors.Errorf("archive: message %s of unknown channel %s", rec.Message.Sid, rec.Message.ChannelSid)
+			}
+			if IsMedia(*rec.Message) {
+				stats.Skipped++
+				return nil
+			}
+			if _, err := im.Messages.Send(ctx, serviceSid, sid, MessageParams(*rec.Message)); err != nil {
+				return errors.Wrapf(err, "archive: could not import message %s", rec.Message.Sid)
+			}
+			stats.Messages++
+		}
+		return nil
+	})
+	return stats, err
+}
+
+func (im Importer) importChannel(ctx context.Context, serviceSid string, chn chat.Channel) (string, error) {
+	var (
+		body     = ChannelParams(chn)
+		imported chat.Channel
+		err      error
+	)
+	if body.UniqueName != "" {
+		imported, err = im.Channels.Upsert(ctx, serviceSid, body)
+	} else {
+		imported, err = im.Channels.Create(ctx, serviceSid, body)
+	}
+	return imported.Sid, err
+}
+
+// ChannelParams returns the params recreating the channel in another service.
+func ChannelParams(chn chat.Channel) chat.ChannelCreateParams {
+	return chat.ChannelCreateParams{
+		FriendlyName: chn.FriendlyName,
+		UniqueName:   chn.UniqueName,
+		Attributes:   Attributes(chn.Attributes),
+		Type:         chn.Type,
+		DateCreated:  chn.DateCreated,
+		CreatedBy:    chn.CreatedBy,
+	}
+}
+
+// MemberParams returns the params recreating the member in another service, roles
+// maps the role sids between the services.
+func MemberParams(m chat.Member, roles map[string]string) chat.MemberCreateParams {
+	return chat.MemberCreateParams{
+		Identity:                 m.Identity,
+		RoleSid:                  roles[m.RoleSid],
+		LastConsumedMessageIndex: m.LastConsumedMessageIndex,
+		LastConsumptionTimestamp: m.LastConsumptionTimestamp,
+		DateCreated:              m.DateCreated,
+		Attributes:               Attributes(m.Attributes),
+	}
+}
+
+// MessageParams returns the params recreating the message in another service.
+func MessageParams(m chat.Message) chat.MessageCreateParams {
+	return chat.MessageCreateParams{
+		From:          m.From,
+		Body:          m.Body,
+		DateCreated:   m.DateCreated,
+		DateUpdated:   m.DateUpdated,
+		LastUpdatedBy: m.LastUpdatedBy,
+		Attributes:    Attributes(m.Attributes),
+	}
+}
+
+// IsMedia reports whether the message only holds a media, which can't be recreated.
+func IsMedia(m chat.Message) bool {
+	return m.Type == "media" && m.Body == ""
+}
@@ -0,0 +1,61 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+func TestImport(t *testing.T) {
+	t.Run("export round trip", func(t *testing.T) {
+		src := newFakeChat()
+		src.channels[0].Attributes = json.RawMessage(`"{\"team\":\"a\"}"`)
+		src.members["CH1"][0].RoleSid = "RLsrc"
+		src.messages["CH1"][0].From = "alice"
+		src.messages["CH1"] = append(src.messages["CH1"], chat.Message{Sid: "IM2", ChannelSid: "CH1", Index: 2, Type: "media"})
+
+		var archive bytes.Buffer
+		e := Exporter{Channels: src, Members: fakeMembers{src}, Messages: fakeMessages{src}}
+		if err := e.ExportService(context.TODO(), &archive, "IS1", nil); err != nil {
+			t.Fatal(err)
+		}
+
+		dst := &fakeChat{}
+		im := Importer{Channels: dst, Members: fakeMembers{dst}, Messages: fakeMessages{dst}, Roles: map[string]string{"RLsrc": "RLdst"}}
+		stats, err := im.Import(context.TODO(), &archive, "IS2")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		if exp := (Stats{Channels: 2, Members: 1, Messages: 2, Skipped: 1}); exp != stats {
+			t.Errorf("exp stats %+v, got %+v", exp, stats)
+		}
+		if exp := []chat.ChannelCreateParams{{UniqueName: "support", Attributes: json.RawMessage(`{"team":"a"}`)}}; !cmp.Equal(exp, dst.upserts) {
+			t.Errorf("upserts diff %v", cmp.Diff(exp, dst.upserts))
+		}
+		if exp := 1; len(dst.created) != exp {
+			t.Errorf("exp %d created channel, got %d", exp, len(dst.created))
+		}
+		if exp := []chat.MemberCreateParams{{Identity: "alice", RoleSid: "RLdst"}}; !cmp.Equal(exp, dst.added["CHsupport"]) {
+			t.Errorf("members diff %v", cmp.Diff(exp, dst.added["CHsupport"]))
+		}
+		exp := []chat.MessageCreateParams{{From: "alice", Body: "hi"}, {Body: "hello"}}
+		if !cmp.Equal(exp, dst.sent["CHsupport"]) {
+			t.Errorf("messages diff %v", cmp.Diff(exp, dst.sent["CHsupport"]))
+		}
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		dst := &fakeChat{}
+		im := Importer{Channels: dst, Members: fakeMembers{dst}, Messages: fakeMessages{dst}}
+		archive := `{"type":"message","message":{"sid":"IM1","channel_sid":"CH9"}}`
+		if _, err := im.Import(context.TODO(), strings.NewReader(archive), "IS2"); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package chat
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultBatchWorkers number of concurrent requests made by the batch helpers
+// when a non positive number of workers is given.
+const DefaultBatchWorkers = 10
+
+// MemberResult holds the outcome of adding a single member in a batch.
+type MemberResult struct {
+	Member Member
+	Err    error
+}
+
+// UserResult holds the outcome of creating a single user in a batch.
+type UserResult struct {
+	User User
+	Err  error
+}
+
+// MessageResult holds the outcome of sending a single message in a batch.
+type MessageResult struct {
+	Message Message
+	Err     error
+}
+
+// AddMany adds the members to a channel using a pool of workers, the results are
+// returned in the order of the params.
+func (r MemberResource) AddMany(ctx context.Context, serviceSid, channelSid string, body []MemberCreateParams, workers int) []MemberResult {
+	res := make([]MemberResult, len(body))
+	errs := batch(ctx, len(body), workers, func(ctx context.Context, i int) (err error) {
+		res[i].Member, err = r.Add(ctx, serviceSid, channelSid, body[i])
+		return err
+	})
+	for i, err := range errs {
+		res[i].Err = err
+	}
+	return res
+}
+
+// CreateMany creates the users using a pool of workers, the results are returned
+// in the order of the params.
+func (r UserResource) CreateMany(ctx context.Context, serviceSid string, body []UserCreateParams, workers int) []UserResult {
+	res := make([]UserResult, len(body))
+	errs := batch(ctx, len(body), workers, func(ctx context.Context, i int) (err error) {
+		res[i].User, err = r.Create(ctx, serviceSid, body[i])
+		return err
+	})
+	for i, err := range errs {
+		res[i].Err = err
+	}
+	return res
+}
+
+// SendMany sends the messages to a channel using a pool of workers, the results are
+// returned in the order of the params. Messages are only delivered in order with one worker.
+func (r MessageResource) SendMany(ctx context.Context, serviceSid, channelSid string, body []MessageCreateParams, workers int) []MessageResult {
+	res := make([]MessageResult, len(body))
+	errs := batch(ctx, len(body), workers, func(ctx context.Context, i int) (err error) {
+		res[i].Message, err = r.Send(ctx, serviceSid, channelSid, body[i])
+		return err
+	})
+	for i, err := range errs {
+		res[i].Err = err
+	}
+	return res
+}
+
+// DeleteMany deletes the channels by sid or unique name using a pool of workers,
+// the errors are returned in the order of the identities.
+func (r ChannelResource) DeleteMany(ctx context.Context, serviceSid string, identities []string, workers int) []error {
+	return batch(ctx, len(identities), workers, func(ctx context.Context, i int) error {
+		return r.Delete(ctx, serviceSid, identities[i])
+	})
+}
+
+// batch calls fn for every index in [0, n) from a pool of workers, once ctx is done
+// the remaining indexes fail with the ctx error.
+func batch(ctx context.Context, n, workers int, fn func(ctx context.Context, i int) error) []error {
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	if workers > n {
+		workers = n
+	}
+
+	var (
+		errs = make([]error, n)
+		idx  = make(chan int)
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,156 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smnalex/twilio-go"
+)
+
+func TestMemberAddMany(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+		if exp := "/Services/sid/Channels/csid/Members"; exp != path {
+			t.Errorf("exp path %s, got %s", exp, path)
+		}
+		data, _ := ioutil.ReadAll(body)
+		params, _ := url.ParseQuery(string(data))
+		if identity := params.Get("Identity"); identity != "fail" {
+			return []byte(fmt.Sprintf(`{"identity": %q}`, identity)), nil
+		}
+		return nil, twilio.ErrTwilioResponse{Code: 50404}
+	}
+
+	body := []MemberCreateParams{{Identity: "a"}, {Identity: "fail"}, {Identity: "c"}}
+	res := MemberResource{memberAPI{client}}.AddMany(context.TODO(), "sid", "csid", body, 2)
+
+	if exp, got := len(body), len(res); exp != got {
+		t.Fatalf("exp %d results, got %d", exp, got)
+	}
+	for i, r := range res {
+		if body[i].Identity == "fail" {
+			if exp := (twilio.ErrTwilioResponse{Code: 50404}); r.Err != exp {
+				t.Errorf("exp err %v, got %v", exp, r.Err)
+			}
+			continue
+		}
+		if r.Err != nil {
+			t.Errorf("exp no err, got %v", r.Err)
+		}
+		if exp, got := body[i].Identity, r.Member.Identity; exp != got {
+			t.Errorf("exp member %s at %d, got %s", exp, i, got)
+		}
+	}
+}
+
+func TestUserCreateMany(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+		data, _ := ioutil.ReadAll(body)
+		params, _ := url.ParseQuery(string(data))
+		return []byte(fmt.Sprintf(`{"identity": %q}`, params.Get("Identity"))), nil
+	}
+
+	body := []UserCreateParams{{Identity: "a"}, {Identity: "b"}, {Identity: "c"}}
+	res := UserResource{userAPI{client}}.CreateMany(context.TODO(), "sid", body, 0)
+	for i, r := range res {
+		if r.Err != nil {
+			t.Errorf("exp no err, got %v", r.Err)
+		}
+		if exp, got := body[i].Identity, r.User.Identity; exp != got {
+			t.Errorf("exp user %s at %d, got %s", exp, i, got)
+		}
+	}
+}
+
+func TestMessageSendMany(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+		data, _ := ioutil.ReadAll(body)
+		params, _ := url.ParseQuery(string(data))
+		return []byte(fmt.Sprintf(`{"body": %q}`, params.Get("Body"))), nil
+	}
+
+	body := []MessageCreateParams{{Body: "1"}, {Body: "2"}}
+	res := MessageResource{messageAPI{client}}.SendMany(context.TODO(), "sid", "csid", body, 1)
+	for i, r := range res {
+		if exp, got := body[i].Body, r.Message.Body; exp != got {
+			t.Errorf("exp message %s at %d, got %s", exp, i, got)
+		}
+	}
+}
+
+func TestChannelDeleteMany(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+		if path == "/Services/sid/Channels/missing" {
+			return nil, twilio.ErrTwilioResponse{Status: 404}
+		}
+		return nil, nil
+	}
+
+	errs := ChannelResource{channelAPI{client}}.DeleteMany(context.TODO(), "sid", []string{"a", "missing", "b"}, 1)
+	if exp := (twilio.ErrTwilioResponse{Status: 404}); errs[1] != exp {
+		t.Errorf("exp err %v, got %v", exp, errs[1])
+	}
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("exp no errs, got %v", errs)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	t.Run("bounded concurrency", func(t *testing.T) {
+		var running, max int32
+		errs := batch(context.TODO(), 20, 3, func(ctx context.Context, i int) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			return nil
+		})
+
+		if exp := 20; len(errs) != exp {
+			t.Errorf("exp %d errs, got %d", exp, len(errs))
+		}
+		if max > 3 {
+			t.Errorf("exp at most 3 concurrent calls, got %d", max)
+		}
+	})
+
+	t.Run("ctx cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var calls int32
+		errs := batch(ctx, 10, 1, func(ctx context.Context, i int) error {
+			if atomic.AddInt32(&calls, 1) == 2 {
+				cancel()
+			}
+			return nil
+		})
+
+		if exp := int32(2); calls != exp {
+			t.Errorf("exp %d calls, got %d", exp, calls)
+		}
+		for _, err := range errs[2:] {
+			if err != context.Canceled {
+				t.Errorf("exp err %v, got %v", context.Canceled, err)
+			}
+		}
+	})
+
+	t.Run("no items", func(t *testing.T) {
+		if errs := batch(context.TODO(), 0, 0, nil); len(errs) != 0 {
+			t.Errorf("exp no errs, got %v", errs)
+		}
+	})
+}
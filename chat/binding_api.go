@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/smnalex/twilio-go"
@@ -16,11 +15,7 @@ type bindingAPI struct {
 // https://www.twilio.com/docs/chat/rest/bindings-resource#read-a-binding
 func (api bindingAPI) Read(ctx context.Context, serviceSid, bindingSid string) (Binding, error) {
 	var bind Binding
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s/Bindings/%s", serviceSid, bindingSid))
-	if err != nil {
-		return bind, err
-	}
-	err = json.Unmarshal(data, &bind)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Bindings/%s", serviceSid, bindingSid), &bind)
 	return bind, err
 }
 
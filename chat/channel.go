@@ -41,6 +41,24 @@ type Channel struct {
 	} `json:"links"`
 }
 
+// ChannelList holds a page of channels of a service.
+type ChannelList struct {
+	Channels []Channel `json:"channels"`
+	Meta     Meta      `json:"meta"`
+}
+
+// ChannelListParams holds information used in listing channels.
+type ChannelListParams struct {
+	ListParams
+
+	// Type filters by public and/or private channels.
+	Type []string `url:",omitempty"`
+}
+
+func (c ChannelListParams) query() string {
+	return twilio.Query(c)
+}
+
 // ChannelCreateParams holds information used in creating a new channel.
 type ChannelCreateParams struct {
 	FriendlyName string          `url:",omitempty"`
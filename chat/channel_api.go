@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -18,14 +17,18 @@ type channelAPI struct {
 // https://www.twilio.com/docs/chat/rest/channels#retrieve-a-channel
 func (api channelAPI) Read(ctx context.Context, serviceSid, identity string) (Channel, error) {
 	var chn Channel
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s/Channels/%s", serviceSid, identity))
-	if err != nil {
-		return chn, err
-	}
-	err = json.Unmarshal(data, &chn)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s", serviceSid, identity), &chn)
 	return chn, err
 }
 
+// GET /Services/{Service SID}/Channels
+// https://www.twilio.com/docs/chat/rest/channels#read-multiple-channels
+func (api channelAPI) List(ctx context.Context, serviceSid string, params ChannelListParams) (ChannelList, error) {
+	var chns ChannelList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels%s", serviceSid, params.query()), &chns)
+	return chns, err
+}
+
 // POST /Services/{Service SID}/Channels/{Channel SID}
 // POST /Services/{Service SID}/Channels/{Unique Name}
 func (api channelAPI) Create(ctx context.Context, serviceSid string, body ChannelCreateParams) (Channel, error) {
@@ -49,10 +52,6 @@ func (api channelAPI) Delete(ctx context.Context, serviceSid, identity string) e
 
 func (api channelAPI) post(ctx context.Context, path string, body io.Reader) (Channel, error) {
 	var chn Channel
-	data, err := api.client.Post(ctx, path, body)
-	if err != nil {
-		return chn, err
-	}
-	err = json.Unmarshal(data, &chn)
+	err := twilio.PostInto(ctx, api.client, path, body, &chn)
 	return chn, err
 }
@@ -154,3 +154,37 @@ func TestChannelDelete(t *testing.T) {
 		APIMock(fn).TestDeletes((t))
 	})
 }
+
+func TestChannelList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Channels?PageSize=10&Type=public&Type=private"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/channels.json")
+		}
+
+		var (
+			exp  = ChannelList{}
+			f, _ = os.Open("fixtures/channels.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		params := ChannelListParams{ListParams: ListParams{PageSize: 10}, Type: []string{"public", "private"}}
+		channels, err := (channelAPI{client}).List(context.TODO(), "sid", params)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, channels) {
+			t.Errorf("response diff %v", cmp.Diff(exp, channels))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (channelAPI{client}).List(ctx, "sid", ChannelListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
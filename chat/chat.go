@@ -31,6 +31,8 @@ func New(tctx twilio.Context) (Chat, error) {
 		tctx.APISecret,
 		chatEndpointForRegion(tctx.Region),
 		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
 	)
 	if err != nil {
 		return chatClient, err
@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -17,11 +16,7 @@ type credentialAPI struct {
 // https://www.twilio.com/docs/chat/rest/credentials#retrieve-a-credential
 func (api credentialAPI) Read(ctx context.Context, credentialSid string) (Credential, error) {
 	var crd Credential
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Credentials/%s", credentialSid))
-	if err != nil {
-		return crd, err
-	}
-	err = json.Unmarshal(data, &crd)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Credentials/%s", credentialSid), &crd)
 	return crd, err
 }
 
@@ -46,10 +41,6 @@ func (api credentialAPI) Delete(ctx context.Context, credentialSid string) error
 
 func (api credentialAPI) post(ctx context.Context, path string, body io.Reader) (Credential, error) {
 	var crd Credential
-	data, err := api.client.Post(ctx, path, body)
-	if err != nil {
-		return crd, err
-	}
-	err = json.Unmarshal(data, &crd)
+	err := twilio.PostInto(ctx, api.client, path, body, &crd)
 	return crd, err
 }
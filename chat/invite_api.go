@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/smnalex/twilio-go"
@@ -16,11 +15,7 @@ type inviteAPI struct {
 // https://www.twilio.com/docs/chat/rest/invites#read-an-invite-to-a-channel
 func (api inviteAPI) Read(ctx context.Context, serviceSid, channelSid, inviteSid string) (Invite, error) {
 	var inv Invite
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s/Channels/%s/Invites/%s", serviceSid, channelSid, inviteSid))
-	if err != nil {
-		return inv, err
-	}
-	err = json.Unmarshal(data, &inv)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Invites/%s", serviceSid, channelSid, inviteSid), &inv)
 	return inv, err
 }
 
@@ -28,11 +23,7 @@ func (api inviteAPI) Read(ctx context.Context, serviceSid, channelSid, inviteSid
 // https://www.twilio.com/docs/chat/rest/invites#create-an-invite-to-a-channel
 func (api inviteAPI) Create(ctx context.Context, serviceSid, channelSid string, body InviteCreateParams) (Invite, error) {
 	var inv Invite
-	data, err := api.client.Post(ctx, fmt.Sprintf("/Services/%s/Channels/%s/Invites", serviceSid, channelSid), body.encode())
-	if err != nil {
-		return inv, err
-	}
-	err = json.Unmarshal(data, &inv)
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Invites", serviceSid, channelSid), body.encode(), &inv)
 	return inv, err
 }
 
@@ -33,6 +33,24 @@ type Member struct {
 	URL         string          `json:"url"`
 }
 
+// MemberList holds a page of members of a channel.
+type MemberList struct {
+	Members []Member `json:"members"`
+	Meta    Meta     `json:"meta"`
+}
+
+// MemberListParams holds information used in listing members.
+type MemberListParams struct {
+	ListParams
+
+	// Identity filters by the identities of the members.
+	Identity []string `url:",omitempty"`
+}
+
+func (mlp MemberListParams) query() string {
+	return twilio.Query(mlp)
+}
+
 // MemberCreateParams holds information used in adding a member to a channel.
 type MemberCreateParams struct {
 	Identity                 string
@@ -51,3 +69,22 @@ type MemberCreateParams struct {
 func (mcp MemberCreateParams) encode() io.Reader {
 	return strings.NewReader(twilio.Values(mcp).Encode())
 }
+
+// MemberUpdateParams holds information used in updating a member of a channel.
+// https://www.twilio.com/docs/chat/rest/members#update-a-member
+type MemberUpdateParams struct {
+	RoleSid                  string `url:",omitempty"`
+	LastConsumedMessageIndex int    `url:",omitempty"`
+	LastConsumptionTimestamp string `url:",omitempty"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `url:",omitempty"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string          `url:",omitempty"`
+	Attributes  json.RawMessage `url:",omitempty"`
+}
+
+func (mup MemberUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mup).Encode())
+}
@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/smnalex/twilio-go"
@@ -17,23 +16,32 @@ type memberAPI struct {
 // https://www.twilio.com/docs/chat/rest/members#retrieve-a-member-of-a-channel
 func (api memberAPI) Read(ctx context.Context, serviceSid, channelSid, identity string) (Member, error) {
 	var mem Member
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s/Channels/%s/Members/%s", serviceSid, channelSid, identity))
-	if err != nil {
-		return mem, err
-	}
-	err = json.Unmarshal(data, &mem)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Members/%s", serviceSid, channelSid, identity), &mem)
 	return mem, err
 }
 
+// GET /Services/{Service SID}/Channels/{Channel SID}/Members
+// https://www.twilio.com/docs/chat/rest/members#read-multiple-members
+func (api memberAPI) List(ctx context.Context, serviceSid, channelSid string, params MemberListParams) (MemberList, error) {
+	var mems MemberList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Members%s", serviceSid, channelSid, params.query()), &mems)
+	return mems, err
+}
+
 // POST /Services/{Service SID}/Channels/{Channel SID}/Members
 // https://www.twilio.com/docs/chat/rest/members#add-a-member-to-a-channel
 func (api memberAPI) Add(ctx context.Context, serviceSid, channelSid string, body MemberCreateParams) (Member, error) {
 	var mem Member
-	data, err := api.client.Post(ctx, fmt.Sprintf("/Services/%s/Channels/%s/Members", serviceSid, channelSid), body.encode())
-	if err != nil {
-		return mem, err
-	}
-	err = json.Unmarshal(data, &mem)
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Members", serviceSid, channelSid), body.encode(), &mem)
+	return mem, err
+}
+
+// POST /Services/{Service SID}/Channels/{Channel SID}/Members/{Member SID}
+// POST /Services/{Service SID}/Channels/{Channel SID}/Members/{Member Identity}
+// https://www.twilio.com/docs/chat/rest/members#update-a-member
+func (api memberAPI) Update(ctx context.Context, serviceSid, channelSid, identity string, body MemberUpdateParams) (Member, error) {
+	var mem Member
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Members/%s", serviceSid, channelSid, identity), body.encode(), &mem)
 	return mem, err
 }
 
@@ -86,6 +86,47 @@ func TestMemberCreate(t *testing.T) {
 	})
 }
 
+func TestMemberUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("LastConsumedMessageIndex=4&RoleSid=RL1")
+			)
+
+			if exp := "/Services/sid/Channels/csid/Members/jing"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(gotBody, expBody) {
+				t.Errorf("exp body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/member.json")
+		}
+
+		var (
+			exp  Member
+			f, _ = os.Open("fixtures/member.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		member, err := (memberAPI{client}).Update(context.TODO(), "sid", "csid", "jing", MemberUpdateParams{RoleSid: "RL1", LastConsumedMessageIndex: 4})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, member) {
+			t.Errorf("response diff %v", cmp.Diff(exp, member))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (memberAPI{client}).Update(ctx, "sid", "csid", "jing", MemberUpdateParams{})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
 func TestMemberDelete(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		client := &HTTPClientMock{}
@@ -113,3 +154,36 @@ func TestMemberDelete(t *testing.T) {
 		APIMock(fn).TestDeletes((t))
 	})
 }
+
+func TestMemberList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Channels/csid/Members?Identity=jing"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/members.json")
+		}
+
+		var (
+			exp  = MemberList{}
+			f, _ = os.Open("fixtures/members.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		members, err := (memberAPI{client}).List(context.TODO(), "sid", "csid", MemberListParams{Identity: []string{"jing"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, members) {
+			t.Errorf("response diff %v", cmp.Diff(exp, members))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (memberAPI{client}).List(ctx, "sid", "csid", MemberListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
@@ -38,6 +38,24 @@ type Message struct {
 	Attributes    json.RawMessage `json:"attributes"`
 }
 
+// MessageList holds a page of messages of a channel.
+type MessageList struct {
+	Messages []Message `json:"messages"`
+	Meta     Meta      `json:"meta"`
+}
+
+// MessageListParams holds information used in listing messages.
+type MessageListParams struct {
+	ListParams
+
+	// Order of the messages by index, asc or desc. Default asc.
+	Order string `url:",omitempty"`
+}
+
+func (mlp MessageListParams) query() string {
+	return twilio.Query(mlp)
+}
+
 // MessageCreateParams holds information used in sending a new message.
 type MessageCreateParams struct {
 	From string `url:",omitempty"`
@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -17,14 +16,18 @@ type messageAPI struct {
 // https://www.twilio.com/docs/chat/rest/messages#retrieve-a-message-from-a-channel
 func (api messageAPI) Read(ctx context.Context, serviceSid, channelSid, messageSid string) (Message, error) {
 	var msg Message
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s/Channels/%s/Messages/%s", serviceSid, channelSid, messageSid))
-	if err != nil {
-		return msg, err
-	}
-	err = json.Unmarshal(data, &msg)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Messages/%s", serviceSid, channelSid, messageSid), &msg)
 	return msg, err
 }
 
+// GET /Services/{Service SID}/Channels/{Channel SID}/Messages
+// https://www.twilio.com/docs/chat/rest/messages#read-multiple-messages
+func (api messageAPI) List(ctx context.Context, serviceSid, channelSid string, params MessageListParams) (MessageList, error) {
+	var msgs MessageList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Channels/%s/Messages%s", serviceSid, channelSid, params.query()), &msgs)
+	return msgs, err
+}
+
 // POST /Services/{Service SID}/Channels/{Channel SID}/Messages
 // https://www.twilio.com/docs/chat/rest/messages#send-a-message-to-a-channel
 func (api messageAPI) Send(ctx context.Context, serviceSid, channelSid string, body MessageCreateParams) (Message, error) {
@@ -44,10 +47,6 @@ func (api messageAPI) Delete(ctx context.Context, serviceSid, channelSid, messag
 
 func (api messageAPI) post(ctx context.Context, path string, body io.Reader) (Message, error) {
 	var msg Message
-	data, err := api.client.Post(ctx, path, body)
-	if err != nil {
-		return msg, err
-	}
-	err = json.Unmarshal(data, &msg)
+	err := twilio.PostInto(ctx, api.client, path, body, &msg)
 	return msg, err
 }
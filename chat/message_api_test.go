@@ -151,3 +151,37 @@ func TestUpdateDelete(t *testing.T) {
 		APIMock(fn).TestDeletes((t))
 	})
 }
+
+func TestMessageList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Channels/csid/Messages?Order=desc&PageSize=5"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/messages.json")
+		}
+
+		var (
+			exp  = MessageList{}
+			f, _ = os.Open("fixtures/messages.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		params := MessageListParams{ListParams: ListParams{PageSize: 5}, Order: "desc"}
+		messages, err := (messageAPI{client}).List(context.TODO(), "sid", "csid", params)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, messages) {
+			t.Errorf("response diff %v", cmp.Diff(exp, messages))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).List(ctx, "sid", "csid", MessageListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
@@ -0,0 +1,157 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// Checkpoint records the progress of a migration, it is saved after every migrated
+// page of users or channels, every created channel and the members of every channel.
+// The progress of the messages is appended to a log next to the checkpoint file by
+// SaveNext, the log is folded into the checkpoint on load and by the next Save.
+type Checkpoint struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+
+	// Users and Channels hold the next page to migrate.
+	Users    Progress `json:"users"`
+	Channels Progress `json:"channels"`
+
+	// Migrated holds the channels by source channel sid.
+	Migrated map[string]*Channel `json:"migrated"`
+
+	path string
+}
+
+// Progress of a paginated resource.
+type Progress struct {
+	Page chat.ListParams `json:"page"`
+	Done bool            `json:"done"`
+}
+
+// Channel is the progress of a single channel.
+type Channel struct {
+	// Sid of the channel in the target service.
+	Sid     string `json:"sid"`
+	Members bool   `json:"members"`
+
+	// Next index of the source messages to migrate.
+	Next int  `json:"next"`
+	Done bool `json:"done"`
+}
+
+// logEntry is a line of the checkpoint log, the next message index of a channel.
+type logEntry struct {
+	Channel string `json:"channel"`
+	Next    int    `json:"next"`
+}
+
+// LoadCheckpoint reads the checkpoint file and its log, a missing file returns an
+// empty checkpoint.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	cp := &Checkpoint{path: path}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "migrate: could not read checkpoint")
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, cp); err != nil {
+			return nil, errors.Wrapf(err, "migrate: invalid checkpoint %s", path)
+		}
+	}
+	if cp.Migrated == nil {
+		cp.Migrated = make(map[string]*Channel)
+	}
+	return cp, cp.replay()
+}
+
+// replay applies the log to the checkpoint and folds it into the checkpoint file. A
+// line partially written before an interruption is ignored.
+func (cp *Checkpoint) replay() error {
+	data, err := ioutil.ReadFile(cp.logPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "migrate: could not read checkpoint log")
+	}
+
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		var entry logEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+		if progress, ok := cp.Migrated[entry.Channel]; ok && entry.Next > progress.Next {
+			progress.Next = entry.Next
+		}
+	}
+	return cp.Save()
+}
+
+// SaveNext records the next message index of a migrated channel by appending it to
+// the checkpoint log, instead of rewriting the whole checkpoint.
+func (cp *Checkpoint) SaveNext(channelSid string, next int) error {
+	if progress, ok := cp.Migrated[channelSid]; ok {
+		progress.Next = next
+	}
+	if cp.path == "" {
+		return nil
+	}
+	line, err := json.Marshal(logEntry{Channel: channelSid, Next: next})
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(cp.logPath(), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrap(err, "migrate: could not save checkpoint log")
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return errors.Wrap(err, "migrate: could not save checkpoint log")
+	}
+	return errors.Wrap(f.Close(), "migrate: could not save checkpoint log")
+}
+
+func (cp *Checkpoint) logPath() string {
+	return cp.path + ".log"
+}
+
+// Save writes the checkpoint to a temporary file renamed over the checkpoint file and
+// clears the log, an interrupted save never leaves a partial checkpoint.
+func (cp *Checkpoint) Save() error {
+	if cp.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(cp.path), filepath.Base(cp.path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "migrate: could not save checkpoint")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "migrate: could not save checkpoint")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "migrate: could not save checkpoint")
+	}
+	if err := os.Rename(tmp.Name(), cp.path); err != nil {
+		return errors.Wrap(err, "migrate: could not save checkpoint")
+	}
+	if err := os.Remove(cp.logPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "migrate: could not clear checkpoint log")
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+// Package migrate clones the roles, users, channels, members and messages of a
+// Programmable Chat service into another service.
+//
+// Roles are mapped by friendly name and missing roles are created, users keep
+// their identities and attributes and channels keep their unique names. The
+// progress is saved to a local checkpoint file and its log after every channel and
+// message, an interrupted migration resumes where it stopped. A message, or a
+// channel without a unique name, created right before an interruption but not yet
+// saved to the checkpoint is created again on resume. The settings of the target
+// service are left as is, see the spec package to configure them.
+package migrate
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+	"github.com/smnalex/twilio-go/chat/archive"
+)
+
+// RoleResource lists and creates roles, satisfied by chat.RoleResource.
+type RoleResource interface {
+	List(ctx context.Context, serviceSid string, params chat.ListParams) (chat.RoleList, error)
+	Create(ctx context.Context, serviceSid string, body chat.RoleCreateParams) (chat.Role, error)
+}
+
+// UserResource lists and upserts users, satisfied by chat.UserResource.
+type UserResource interface {
+	List(ctx context.Context, serviceSid string, params chat.ListParams) (chat.UserList, error)
+	Upsert(ctx context.Context, serviceSid string, body chat.UserCreateParams) (chat.User, error)
+}
+
+// ChannelResource lists and creates channels, satisfied by chat.ChannelResource.
+type ChannelResource interface {
+	List(ctx context.Context, serviceSid string, params chat.ChannelListParams) (chat.ChannelList, error)
+	Create(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error)
+	Upsert(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error)
+}
+
+// MemberResource lists and adds members, satisfied by chat.MemberResource.
+type MemberResource interface {
+	List(ctx context.Context, serviceSid, channelSid string, params chat.MemberListParams) (chat.MemberList, error)
+	Ensure(ctx context.Context, serviceSid, channelSid string, body chat.MemberCreateParams) (chat.Member, error)
+}
+
+// MessageResource lists and sends messages, satisfied by chat.MessageResource.
+type MessageResource interface {
+	List(ctx context.Context, serviceSid, channelSid string, params chat.MessageListParams) (chat.MessageList, error)
+	Send(ctx context.Context, serviceSid, channelSid string, body chat.MessageCreateParams) (chat.Message, error)
+}
+
+// Resources of a chat client used by the migrator.
+type Resources struct {
+	Roles    RoleResource
+	Users    UserResource
+	Channels ChannelResource
+	Members  MemberResource
+	Messages MessageResource
+}
+
+// Migrator clones a service of the Source client into a service of the Target
+// client, both are the same client when migrating within an account.
+type Migrator struct {
+	Source Resources
+	Target Resources
+}
+
+// Stats counts the resources migrated by a run, media messages are skipped since
+// their media belongs to the source service.
+type Stats struct {
+	Roles    int
+	Users    int
+	Channels int
+	Members  int
+	Messages int
+	Skipped  int
+}
+
+// New returns a Migrator from the source chat client to the target chat client.
+func New(source, target chat.Chat) Migrator {
+	return Migrator{Source: resources(source), Target: resources(target)}
+}
+
+func resources(c chat.Chat) Resources {
+	return Resources{
+		Roles:    c.Roles,
+		Users:    c.Users,
+		Channels: c.Channels,
+		Members:  c.Members,
+		Messages: c.Messages,
+	}
+}
+
+// Migrate clones the source service into the target service, the checkpoint is
+// loaded from and saved to the checkpoint path. A checkpoint of a different pair
+// of services is rejected.
+func (m Migrator) Migrate(ctx context.Context, sourceSid, targetSid, checkpoint string) (Stats, error) {
+	cp, err := LoadCheckpoint(checkpoint)
+	if err != nil {
+		return Stats{}, err
+	}
+	if cp.Source == "" && cp.Target == "" {
+		cp.Source, cp.Target = sourceSid, targetSid
+	}
+	if cp.Source != sourceSid || cp.Target != targetSid {
+		return Stats{}, errors.Errorf("migrate: checkpoint %s is for %s to %s", checkpoint, cp.Source, cp.Target)
+	}
+
+	run := migration{Migrator: m, cp: cp}
+	err = run.migrate(ctx)
+	return run.stats, err
+}
+
+type migration struct {
+	Migrator
+	cp    *Checkpoint
+	stats Stats
+	roles map[string]string
+}
+
+func (run *migration) migrate(ctx context.Context) error {
+	if err := run.migrateRoles(ctx); err != nil {
+		return err
+	}
+	if err := run.migrateUsers(ctx); err != nil {
+		return err
+	}
+	return run.migrateChannels(ctx)
+}
+
+// migrateRoles maps the source role sids to the target roles with the same friendly
+// name, creating the missing roles. Roles are listed on every run as the mapping
+// is cheap to rebuild.
+func (run *migration) migrateRoles(ctx context.Context) error {
+	source, err := listRoles(ctx, run.Source.Roles, run.cp.Source)
+	if err != nil {
+		return err
+	}
+	target, err := listRoles(ctx, run.Target.Roles, run.cp.Target)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]string, len(target))
+	for _, role := range target {
+		byName[role.FriendlyName] = role.Sid
+	}
+
+	run.roles = make(map[string]string, len(source))
+	for _, role := range source {
+		sid, ok := byName[role.FriendlyName]
+		if !ok {
+			created, err := run.Target.Roles.Create(ctx, run.cp.Target, chat.RoleCreateParams{
+				FriendlyName: role.FriendlyName,
+				Type:         role.Type,
+				Permission:   role.Permissions,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "migrate: could not create role %s", role.FriendlyName)
+			}
+			sid = created.Sid
+			run.stats.Roles++
+		}
+		run.roles[role.Sid] = sid
+	}
+	return nil
+}
+
+func listRoles(ctx context.Context, roles RoleResource, serviceSid string) ([]chat.Role, error) {
+	var (
+		all    []chat.Role
+		params chat.ListParams
+	)
+	for {
+		page, err := roles.List(ctx, serviceSid, params)
+		if err != nil {
+			return nil, errors.Wrapf(err, "migrate: could not list roles of %s", serviceSid)
+		}
+		all = append(all, page.Roles...)
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return all, nil
+		}
+		params = next
+	}
+}
+
+// migrateUsers upserts the users page by page, a page interrupted midway is
+// upserted again on resume.
+func (run *migration) migrateUsers(ctx context.Context) error {
+	for !run.cp.Users.Done {
+		page, err := run.Source.Users.List(ctx, run.cp.Source, run.cp.Users.Page)
+		if err != nil {
+			return errors.Wrap(err, "migrate: could not list users")
+		}
+		for _, usr := range page.Users {
+			if _, err := run.Target.Users.Upsert(ctx, run.cp.Target, chat.UserCreateParams{
+				Identity:     usr.Identity,
+				RoleSid:      run.roles[usr.RoleSID],
+				Attributes:   archive.Attributes(usr.Attributes),
+				FriendlyName: usr.FriendlyName,
+			}); err != nil {
+				return errors.Wrapf(err, "migrate: could not migrate user %s", usr.Identity)
+			}
+			run.stats.Users++
+		}
+
+		run.cp.Users.Page, run.cp.Users.Done = next(page.Meta)
+		if err := run.cp.Save(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (run *migration) migrateChannels(ctx context.Context) error {
+	for !run.cp.Channels.Done {
+		page, err := run.Source.Channels.List(ctx, run.cp.Source, chat.ChannelListParams{ListParams: run.cp.Channels.Page})
+		if err != nil {
+			return errors.Wrap(err, "migrate: could not list channels")
+		}
+		for _, chn := range page.Channels {
+			if err := run.migrateChannel(ctx, chn); err != nil {
+				return errors.Wrapf(err, "migrate: could not migrate channel %s", chn.Sid)
+			}
+		}
+
+		run.cp.Channels.Page, run.cp.Channels.Done = next(page.Meta)
+		if err := run.cp.Save(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (run *migration) migrateChannel(ctx context.Context, chn chat.Channel) error {
+	progress, ok := run.cp.Migrated[chn.Sid]
+	if !ok {
+		var (
+			body     = archive.ChannelParams(chn)
+			migrated chat.Channel
+			err      error
+		)
+		if body.UniqueName != "" {
+			migrated, err = run.Target.Channels.Upsert(ctx, run.cp.Target, body)
+		} else {
+			migrated, err = run.Target.Channels.Create(ctx, run.cp.Target, body)
+		}
+		if err != nil {
+			return err
+		}
+
+		progress = &Channel{Sid: migrated.Sid}
+		run.cp.Migrated[chn.Sid] = progress
+		if err := run.cp.Save(); err != nil {
+			return err
+		}
+		run.stats.Channels++
+	}
+	if progress.Done {
+		return nil
+	}
+
+	if !progress.Members {
+		if err := run.migrateMembers(ctx, chn.Sid, progress); err != nil {
+			return err
+		}
+	}
+	if err := run.migrateMessages(ctx, chn.Sid, progress); err != nil {
+		return err
+	}
+
+	progress.Done = true
+	return run.cp.Save()
+}
+
+// migrateMembers ensures every member exists, members are idempotent so the
+// checkpoint is only saved once all members are migrated.
+func (run *migration) migrateMembers(ctx context.Context, channelSid string, progress *Channel) error {
+	var params chat.MemberListParams
+	for {
+		page, err := run.Source.Members.List(ctx, run.cp.Source, channelSid, params)
+		if err != nil {
+			return errors.Wrap(err, "could not list members")
+		}
+		for _, mem := range page.Members {
+			if _, err := run.Target.Members.Ensure(ctx, run.cp.Target, progress.Sid, archive.MemberParams(mem, run.roles)); err != nil {
+				return errors.Wrapf(err, "could not migrate member %s", mem.Identity)
+			}
+			run.stats.Members++
+		}
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			break
+		}
+		params.ListParams = next
+	}
+
+	progress.Members = true
+	return run.cp.Save()
+}
+
+// migrateMessages sends the messages in order, the next index is appended to the
+// checkpoint log after every message as a message sent twice can't be detected. A
+// message sent before a failed save is sent again on resume.
+func (run *migration) migrateMessages(ctx context.Context, channelSid string, progress *Channel) error {
+	params := chat.MessageListParams{ListParams: chat.ListParams{PageSize: 100}, Order: "asc"}
+	for {
+		page, err := run.Source.Messages.List(ctx, run.cp.Source, channelSid, params)
+		if err != nil {
+			return errors.Wrap(err, "could not list messages")
+		}
+		for _, msg := range page.Messages {
+			if msg.Index < progress.Next {
+				continue
+			}
+			if archive.IsMedia(msg) {
+				run.stats.Skipped++
+			} else {
+				if _, err := run.Target.Messages.Send(ctx, run.cp.Target, progress.Sid, archive.MessageParams(msg)); err != nil {
+					return errors.Wrapf(err, "could not migrate message %s", msg.Sid)
+				}
+				run.stats.Messages++
+			}
+
+			if err := run.cp.SaveNext(channelSid, msg.Index+1); err != nil {
+				return err
+			}
+		}
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return nil
+		}
+		params.ListParams = next
+	}
+}
+
+// next returns the params of the next page and whether the last page was reached.
+func next(meta chat.Meta) (chat.ListParams, bool) {
+	params, ok := meta.Next()
+	return params, !ok
+}
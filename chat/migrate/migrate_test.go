@@ -0,0 +1,320 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+var errSend = errors.New("send failed")
+
+// fakeService is an in-memory chat service, lists return a single resource per page.
+type fakeService struct {
+	roles    []chat.Role
+	users    []chat.User
+	channels []chat.Channel
+	members  map[string][]chat.Member
+	messages map[string][]chat.Message
+
+	// failSend fails the send of the message with the body.
+	failSend string
+}
+
+func newFakeService() *fakeService {
+	return &fakeService{members: make(map[string][]chat.Member), messages: make(map[string][]chat.Message)}
+}
+
+func (f *fakeService) resources() Resources {
+	return Resources{
+		Roles:    fakeRoles{f},
+		Users:    fakeUsers{f},
+		Channels: fakeChannels{f},
+		Members:  fakeMembers{f},
+		Messages: fakeMessages{f},
+	}
+}
+
+func meta(page, total int) chat.Meta {
+	if page+1 >= total {
+		return chat.Meta{}
+	}
+	return chat.Meta{NextPageURL: "https://chat.twilio.com/v2/Services/IS/Users?PageSize=1&Page=" + strconv.Itoa(page+1)}
+}
+
+func window(page, total int) (int, int) {
+	if total == 0 {
+		return 0, 0
+	}
+	return page, page + 1
+}
+
+type fakeRoles struct{ *fakeService }
+
+func (f fakeRoles) List(ctx context.Context, serviceSid string, params chat.ListParams) (chat.RoleList, error) {
+	i, j := window(params.Page, len(f.roles))
+	return chat.RoleList{Roles: f.roles[i:j], Meta: meta(params.Page, len(f.roles))}, nil
+}
+
+func (f fakeRoles) Create(ctx context.Context, serviceSid string, body chat.RoleCreateParams) (chat.Role, error) {
+	role := chat.Role{Sid: "RL" + body.FriendlyName, FriendlyName: body.FriendlyName, Type: body.Type, Permissions: body.Permission}
+	f.roles = append(f.roles, role)
+	return role, nil
+}
+
+type fakeUsers struct{ *fakeService }
+
+func (f fakeUsers) List(ctx context.Context, serviceSid string, params chat.ListParams) (chat.UserList, error) {
+	i, j := window(params.Page, len(f.users))
+	return chat.UserList{Users: f.users[i:j], Meta: meta(params.Page, len(f.users))}, nil
+}
+
+func (f fakeUsers) Upsert(ctx context.Context, serviceSid string, body chat.UserCreateParams) (chat.User, error) {
+	usr := chat.User{Identity: body.Identity, RoleSID: body.RoleSid, FriendlyName: body.FriendlyName, Attributes: body.Attributes}
+	for i := range f.users {
+		if f.users[i].Identity == body.Identity {
+			f.users[i] = usr
+			return usr, nil
+		}
+	}
+	f.users = append(f.users, usr)
+	return usr, nil
+}
+
+type fakeChannels struct{ *fakeService }
+
+func (f fakeChannels) List(ctx context.Context, serviceSid string, params chat.ChannelListParams) (chat.ChannelList, error) {
+	i, j := window(params.Page, len(f.channels))
+	return chat.ChannelList{Channels: f.channels[i:j], Meta: meta(params.Page, len(f.channels))}, nil
+}
+
+func (f fakeChannels) Create(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error) {
+	chn := chat.Channel{Sid: "CH" + strconv.Itoa(len(f.channels)), FriendlyName: body.FriendlyName, UniqueName: body.UniqueName, Attributes: body.Attributes}
+	f.channels = append(f.channels, chn)
+	return chn, nil
+}
+
+func (f fakeChannels) Upsert(ctx context.Context, serviceSid string, body chat.ChannelCreateParams) (chat.Channel, error) {
+	for _, chn := range f.channels {
+		if chn.UniqueName == body.UniqueName {
+			return chn, nil
+		}
+	}
+	return f.Create(ctx, serviceSid, body)
+}
+
+type fakeMembers struct{ *fakeService }
+
+func (f fakeMembers) List(ctx context.Context, serviceSid, channelSid string, params chat.MemberListParams) (chat.MemberList, error) {
+	return chat.MemberList{Members: f.members[channelSid]}, nil
+}
+
+func (f fakeMembers) Ensure(ctx context.Context, serviceSid, channelSid string, body chat.MemberCreateParams) (chat.Member, error) {
+	for _, mem := range f.members[channelSid] {
+		if mem.Identity == body.Identity {
+			return mem, nil
+		}
+	}
+	mem := chat.Member{Identity: body.Identity, RoleSid: body.RoleSid}
+	f.members[channelSid] = append(f.members[channelSid], mem)
+	return mem, nil
+}
+
+type fakeMessages struct{ *fakeService }
+
+func (f fakeMessages) List(ctx context.Context, serviceSid, channelSid string, params chat.MessageListParams) (chat.MessageList, error) {
+	return chat.MessageList{Messages: f.messages[channelSid]}, nil
+}
+
+func (f fakeMessages) Send(ctx context.Context, serviceSid, channelSid string, body chat.MessageCreateParams) (chat.Message, error) {
+	if body.Body == f.failSend {
+		return chat.Message{}, errSend
+	}
+	msg := chat.Message{Index: len(f.messages[channelSid]), From: body.From, Body: body.Body}
+	f.messages[channelSid] = append(f.messages[channelSid], msg)
+	return msg, nil
+}
+
+func setup() (*fakeService, *fakeService) {
+	source := newFakeService()
+	source.roles = []chat.Role{
+		{Sid: "RLsrcuser", FriendlyName: "service user", Type: "deployment"},
+		{Sid: "RLsrcmod", FriendlyName: "moderator", Type: "channel", Permissions: []string{"sendMessage"}},
+	}
+	source.users = []chat.User{
+		{Identity: "alice", RoleSID: "RLsrcuser", FriendlyName: "Alice", Attributes: json.RawMessage(`"{\"tenant\":\"a\"}"`)},
+		{Identity: "bob", RoleSID: "RLsrcuser"},
+	}
+	source.channels = []chat.Channel{
+		{Sid: "CHsupport", UniqueName: "support", Attributes: json.RawMessage(`"{\"tier\":1}"`)},
+		{Sid: "CHadhoc", FriendlyName: "adhoc"},
+	}
+	source.members["CHsupport"] = []chat.Member{{Identity: "alice", RoleSid: "RLsrcmod"}, {Identity: "bob"}}
+	source.messages["CHsupport"] = []chat.Message{
+		{Index: 0, From: "alice", Body: "one"},
+		{Index: 1, Type: "media"},
+		{Index: 2, From: "bob", Body: "two"},
+	}
+	source.messages["CHadhoc"] = []chat.Message{{Index: 0, From: "bob", Body: "three"}}
+
+	target := newFakeService()
+	target.roles = []chat.Role{{Sid: "RLdstuser", FriendlyName: "service user", Type: "deployment"}}
+	return source, target
+}
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	checkpoint := filepath.Join(dir, "checkpoint.json")
+
+	source, target := setup()
+	target.failSend = "two"
+	m := Migrator{Source: source.resources(), Target: target.resources()}
+
+	t.Run("interrupted", func(t *testing.T) {
+		stats, err := m.Migrate(context.TODO(), "ISsrc", "ISdst", checkpoint)
+		if errors.Cause(err) != errSend {
+			t.Fatalf("exp err %v, got %v", errSend, err)
+		}
+		if exp := (Stats{Roles: 1, Users: 2, Channels: 1, Members: 2, Messages: 1, Skipped: 1}); exp != stats {
+			t.Errorf("exp stats %+v, got %+v", exp, stats)
+		}
+
+		cp, err := LoadCheckpoint(checkpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := map[string]*Channel{"CHsupport": {Sid: "CH0", Members: true, Next: 2}}
+		if !cmp.Equal(exp, cp.Migrated) {
+			t.Errorf("checkpoint diff %v", cmp.Diff(exp, cp.Migrated))
+		}
+	})
+
+	t.Run("resumed", func(t *testing.T) {
+		target.failSend = ""
+		stats, err := m.Migrate(context.TODO(), "ISsrc", "ISdst", checkpoint)
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := (Stats{Channels: 1, Messages: 2}); exp != stats {
+			t.Errorf("exp stats %+v, got %+v", exp, stats)
+		}
+
+		if exp := []chat.Message{{Index: 0, From: "alice", Body: "one"}, {Index: 1, From: "bob", Body: "two"}}; !cmp.Equal(exp, target.messages["CH0"]) {
+			t.Errorf("messages diff %v", cmp.Diff(exp, target.messages["CH0"]))
+		}
+		if exp := []chat.Message{{Index: 0, From: "bob", Body: "three"}}; !cmp.Equal(exp, target.messages["CH1"]) {
+			t.Errorf("messages diff %v", cmp.Diff(exp, target.messages["CH1"]))
+		}
+		exp := []chat.Member{{Identity: "alice", RoleSid: "RLmoderator"}, {Identity: "bob"}}
+		if !cmp.Equal(exp, target.members["CH0"]) {
+			t.Errorf("members diff %v", cmp.Diff(exp, target.members["CH0"]))
+		}
+		if exp := "support"; target.channels[0].UniqueName != exp {
+			t.Errorf("exp unique name %s, got %s", exp, target.channels[0].UniqueName)
+		}
+	})
+
+	t.Run("users", func(t *testing.T) {
+		exp := []chat.User{
+			{Identity: "alice", RoleSID: "RLdstuser", FriendlyName: "Alice", Attributes: json.RawMessage(`{"tenant":"a"}`)},
+			{Identity: "bob", RoleSID: "RLdstuser"},
+		}
+		if !cmp.Equal(exp, target.users) {
+			t.Errorf("users diff %v", cmp.Diff(exp, target.users))
+		}
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		stats, err := m.Migrate(context.TODO(), "ISsrc", "ISdst", checkpoint)
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := (Stats{}); exp != stats {
+			t.Errorf("exp stats %+v, got %+v", exp, stats)
+		}
+	})
+
+	t.Run("other services", func(t *testing.T) {
+		if _, err := m.Migrate(context.TODO(), "ISsrc", "ISother", checkpoint); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+func TestLoadCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	t.Run("missing file", func(t *testing.T) {
+		cp, err := LoadCheckpoint(filepath.Join(dir, "missing.json"))
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if cp.Migrated == nil || cp.Users.Done {
+			t.Errorf("exp empty checkpoint, got %+v", cp)
+		}
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		ioutil.WriteFile(path, []byte("{"), 0644)
+		if _, err := LoadCheckpoint(path); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+func TestCheckpointLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "checkpoint.json")
+
+	cp, _ := LoadCheckpoint(path)
+	cp.Migrated["CH1"] = &Channel{Sid: "CH0", Members: true}
+	if err := cp.Save(); err != nil {
+		t.Fatal(err)
+	}
+	saved, _ := ioutil.ReadFile(path)
+
+	for next := 1; next <= 3; next++ {
+		if err := cp.SaveNext("CH1", next); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+	}
+	if data, _ := ioutil.ReadFile(path); string(data) != string(saved) {
+		t.Errorf("exp the checkpoint file left as is, got %s", data)
+	}
+
+	// a line partially written before an interruption is ignored
+	f, _ := os.OpenFile(path+".log", os.O_WRONLY|os.O_APPEND, 0644)
+	f.WriteString(`{"channel":"CH1","ne`)
+	f.Close()
+
+	loaded, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if exp := (&Channel{Sid: "CH0", Members: true, Next: 3}); !cmp.Equal(exp, loaded.Migrated["CH1"]) {
+		t.Errorf("checkpoint diff %v", cmp.Diff(exp, loaded.Migrated["CH1"]))
+	}
+	if _, err := os.Stat(path + ".log"); !os.IsNotExist(err) {
+		t.Errorf("exp the log folded into the checkpoint, got %v", err)
+	}
+}
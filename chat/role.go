@@ -26,6 +26,12 @@ type Role struct {
 	URL          string   `json:"url"`
 }
 
+// RoleList holds a page of roles of a service.
+type RoleList struct {
+	Roles []Role `json:"roles"`
+	Meta  Meta   `json:"meta"`
+}
+
 // RoleCreateParams holds information used in creating a new role.
 type RoleCreateParams struct {
 	// A descriptive string that you create to describe the new resource.
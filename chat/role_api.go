@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -17,14 +16,18 @@ type roleAPI struct {
 // https://www.twilio.com/docs/chat/rest/roles#retrieve-a-role
 func (r roleAPI) Read(ctx context.Context, serviceSid, roleSid string) (Role, error) {
 	var role Role
-	data, err := r.client.Get(ctx, fmt.Sprintf("/Services/%s/Roles/%s", serviceSid, roleSid))
-	if err != nil {
-		return role, err
-	}
-	err = json.Unmarshal(data, &role)
+	err := twilio.GetInto(ctx, r.client, fmt.Sprintf("/Services/%s/Roles/%s", serviceSid, roleSid), &role)
 	return role, err
 }
 
+// GET /Services/{Service SID}/Roles
+// https://www.twilio.com/docs/chat/rest/roles#read-multiple-roles
+func (r roleAPI) List(ctx context.Context, serviceSid string, params ListParams) (RoleList, error) {
+	var roles RoleList
+	err := twilio.GetInto(ctx, r.client, fmt.Sprintf("/Services/%s/Roles%s", serviceSid, twilio.Query(params)), &roles)
+	return roles, err
+}
+
 // POST /Services/{Service SID}/Roles
 // https://www.twilio.com/docs/chat/rest/roles#create-a-role
 func (r roleAPI) Create(ctx context.Context, serviceSid string, body RoleCreateParams) (Role, error) {
@@ -46,10 +49,6 @@ func (r roleAPI) Delete(ctx context.Context, serviceSid, roleSid string) error {
 
 func (r roleAPI) post(ctx context.Context, path string, body io.Reader) (Role, error) {
 	var role Role
-	data, err := r.client.Post(ctx, path, body)
-	if err != nil {
-		return role, err
-	}
-	err = json.Unmarshal(data, &role)
+	err := twilio.PostInto(ctx, r.client, path, body, &role)
 	return role, err
 }
@@ -151,3 +151,39 @@ func TestRoleDelete(t *testing.T) {
 		APIMock(fn).TestDeletes((t))
 	})
 }
+
+func TestRoleList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Roles?PageSize=1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/roles.json")
+		}
+
+		var (
+			exp  = RoleList{}
+			f, _ = os.Open("fixtures/roles.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		roles, err := roleAPI{client}.List(context.TODO(), "sid", ListParams{PageSize: 1})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, roles) {
+			t.Errorf("response diff %v", cmp.Diff(exp, roles))
+		}
+		if exp := 1; len(roles.Roles) != exp {
+			t.Errorf("exp %d roles, got %d", exp, len(roles.Roles))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return roleAPI{client}.List(ctx, "sid", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
@@ -39,6 +39,12 @@ type Service struct {
 	Media                        map[string]interface{} `json:"media"`
 }
 
+// ServiceList holds a page of services.
+type ServiceList struct {
+	Services []Service `json:"services"`
+	Meta     Meta      `json:"meta"`
+}
+
 // ServiceCreateParams holds information used in creating a new service.
 type ServiceCreateParams struct {
 	FriendlyName string
@@ -53,6 +59,7 @@ func (scp ServiceCreateParams) encode() io.Reader {
 type ServiceUpdateParams struct {
 	FriendlyName                 string         `url:",omitempty"`
 	DefaultServiceRoleSid        string         `url:",omitempty"`
+	DefaultChannelRoleSid        string         `url:",omitempty"`
 	DefaultChannelCreatorRoleSid string         `url:",omitempty"`
 	ReadStatusEnabled            bool           `url:",omitempty"`
 	ReachabilityEnabled          bool           `url:",omitempty"`
@@ -76,19 +83,24 @@ type ServiceUpdateParams struct {
 
 // NotificationChannelProperty holds the propeties of push notifications.
 type NotificationChannelProperty struct {
-	Template          string `url:",omitempty"`
-	Enabled           bool   `url:",omitempty"`
-	Sound             bool   `url:",omitempty"`
-	BadgeCountEnabled bool   `url:",omitempty"`
+	Template string `json:"template" url:",omitempty"`
+	Enabled  bool   `json:"enabled" url:",omitempty"`
+
+	// Sound is not decoded, the API responds with the name of the sound.
+	Sound             bool `json:"-" url:",omitempty"`
+	BadgeCountEnabled bool `json:"badge_count_enabled" url:",omitempty"`
 }
 
 // Notifications holds a service notification configuration.
 type Notifications struct {
-	LogEnabled        bool                         `url:",omitempty"`
-	AddedToChannel    *NotificationChannelProperty `url:",omitempty"`
-	InvitedToChannel  *NotificationChannelProperty `url:",omitempty"`
-	NewMessage        *NotificationChannelProperty `url:",omitempty"`
-	RemoveFromChannel *NotificationChannelProperty `url:",omitempty"`
+	LogEnabled       bool                         `json:"log_enabled" url:",omitempty"`
+	AddedToChannel   *NotificationChannelProperty `json:"added_to_channel" url:",omitempty"`
+	InvitedToChannel *NotificationChannelProperty `json:"invited_to_channel" url:",omitempty"`
+	NewMessage       *NotificationChannelProperty `json:"new_message" url:",omitempty"`
+
+	// RemoveFromChannel is sent as `Notifications.RemovedFromChannel`, the name of the
+	// API parameter, the field name is kept for compatibility.
+	RemoveFromChannel *NotificationChannelProperty `json:"removed_from_channel" url:"RemovedFromChannel,omitempty"`
 }
 
 func (sup ServiceUpdateParams) encode() io.Reader {
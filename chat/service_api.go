@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -17,14 +16,18 @@ type serviceAPI struct {
 // https://www.twilio.com/docs/chat/rest/services#retrieve-a-service
 func (api serviceAPI) Read(ctx context.Context, serviceSid string) (Service, error) {
 	var service Service
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s", serviceSid))
-	if err != nil {
-		return service, err
-	}
-	err = json.Unmarshal(data, &service)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s", serviceSid), &service)
 	return service, err
 }
 
+// GET /Services
+// https://www.twilio.com/docs/chat/rest/services#read-multiple-services
+func (api serviceAPI) List(ctx context.Context, params ListParams) (ServiceList, error) {
+	var services ServiceList
+	err := twilio.GetInto(ctx, api.client, "/Services"+twilio.Query(params), &services)
+	return services, err
+}
+
 // POST /Services
 // https://www.twilio.com/docs/chat/rest/services#create-a-service
 func (api serviceAPI) Create(ctx context.Context, body ServiceCreateParams) (Service, error) {
@@ -46,10 +49,6 @@ func (api serviceAPI) Delete(ctx context.Context, serviceSid string) error {
 
 func (api serviceAPI) post(ctx context.Context, path string, body io.Reader) (Service, error) {
 	var service Service
-	data, err := api.client.Post(ctx, path, body)
-	if err != nil {
-		return service, err
-	}
-	err = json.Unmarshal(data, &service)
+	err := twilio.PostInto(ctx, api.client, path, body, &service)
 	return service, err
 }
@@ -150,3 +150,57 @@ func TestServiceDelete(t *testing.T) {
 		APIMock(fn).TestDeletes((t))
 	})
 }
+
+func TestServiceNotificationsDecode(t *testing.T) {
+	data, _ := ioutil.ReadFile("fixtures/service.json")
+
+	var service Service
+	if err := json.Unmarshal(data, &service); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	exp := &Notifications{
+		LogEnabled: true,
+		NewMessage: &NotificationChannelProperty{
+			Enabled:           true,
+			Template:          "${USER}: ${MESSAGE}",
+			BadgeCountEnabled: true,
+		},
+		AddedToChannel: &NotificationChannelProperty{},
+	}
+	if !cmp.Equal(exp, service.Notifications) {
+		t.Errorf("notifications diff %v", cmp.Diff(exp, service.Notifications))
+	}
+}
+
+func TestServiceList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services?PageToken=PT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/services.json")
+		}
+
+		var (
+			exp  = ServiceList{}
+			f, _ = os.Open("fixtures/services.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		services, err := (serviceAPI{client}).List(context.TODO(), ListParams{PageToken: "PT1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, services) {
+			t.Errorf("response diff %v", cmp.Diff(exp, services))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (serviceAPI{client}).List(ctx, ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
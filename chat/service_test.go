@@ -21,4 +21,13 @@ func TestServiceParamsOptionals(t *testing.T) {
 			},
 		},
 	}, exp))
+	exp = []byte("DefaultChannelRoleSid=RL1&Notifications.RemovedFromChannel.Enabled=true")
+	t.Run("UpdateParams removed from channel", optionalsFn(ServiceUpdateParams{
+		DefaultChannelRoleSid: "RL1",
+		Notifications: &Notifications{
+			RemoveFromChannel: &NotificationChannelProperty{
+				Enabled: true,
+			},
+		},
+	}, exp))
 }
@@ -0,0 +1,127 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// Change actions.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+)
+
+// Change describes a single change of a plan.
+type Change struct {
+	Action string
+
+	// Resource is either `service` or `role`.
+	Resource string
+
+	// Name is the service sid or the role name.
+	Name  string
+	Field string
+	From  string
+	To    string
+}
+
+func (c Change) String() string {
+	if c.Action == ActionCreate {
+		return fmt.Sprintf("+ %s %q %s: %s", c.Resource, c.Name, c.Field, c.To)
+	}
+	return fmt.Sprintf("~ %s %q %s: %s -> %s", c.Resource, c.Name, c.Field, c.From, c.To)
+}
+
+// Plan holds the changes required to reconcile a live service with its spec.
+type Plan struct {
+	ServiceSid string
+	Changes    []Change
+
+	// Warnings lists the differences that can't be applied.
+	Warnings []string
+
+	service      chat.ServiceUpdateParams
+	serviceDirty bool
+	roleCreates  []chat.RoleCreateParams
+	roleUpdates  []roleUpdate
+
+	// roleSids by name of the live roles and defaultRoles by service field.
+	roleSids     map[string]string
+	defaultRoles map[string]string
+}
+
+type roleUpdate struct {
+	sid  string
+	body chat.RoleUpdateParams
+}
+
+// Empty reports whether the plan has no changes to apply.
+func (p Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the dry run output of the plan.
+func (p Plan) String() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		b.WriteString(c.String() + "\n")
+	}
+	for _, w := range p.Warnings {
+		b.WriteString("! " + w + "\n")
+	}
+	if b.Len() == 0 {
+		return fmt.Sprintf("service %q is up to date\n", p.ServiceSid)
+	}
+	return b.String()
+}
+
+func (p *Plan) change(action, resource, name, field string, from, to interface{}) {
+	p.Changes = append(p.Changes, Change{
+		Action:   action,
+		Resource: resource,
+		Name:     name,
+		Field:    field,
+		From:     fmt.Sprint(from),
+		To:       fmt.Sprint(to),
+	})
+}
+
+func (p *Plan) warn(format string, args ...interface{}) {
+	p.Warnings = append(p.Warnings, fmt.Sprintf(format, args...))
+}
+
+// diffString, diffInt and diffBool record a service field change and set its
+// param, empty desired values are left untouched.
+func (p *Plan) diffString(field, live, desired string, set func()) {
+	if desired == "" || desired == live {
+		return
+	}
+	p.change(ActionUpdate, "service", p.ServiceSid, field, fmt.Sprintf("%q", live), fmt.Sprintf("%q", desired))
+	p.serviceDirty = true
+	set()
+}
+
+func (p *Plan) diffInt(field string, live, desired int, set func()) {
+	if desired == 0 || desired == live {
+		return
+	}
+	p.change(ActionUpdate, "service", p.ServiceSid, field, live, desired)
+	p.serviceDirty = true
+	set()
+}
+
+// diffBool only enables fields, `chat.ServiceUpdateParams` omits false values.
+func (p *Plan) diffBool(field string, live bool, desired *bool, set func()) {
+	if desired == nil || *desired == live {
+		return
+	}
+	if !*desired {
+		p.warn("service %q %s can't be disabled through the API", p.ServiceSid, field)
+		return
+	}
+	p.change(ActionUpdate, "service", p.ServiceSid, field, live, true)
+	p.serviceDirty = true
+	set()
+}
@@ -0,0 +1,272 @@
+package spec
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// ServiceResource reads and updates services, satisfied by chat.ServiceResource.
+type ServiceResource interface {
+	Read(ctx context.Context, serviceSid string) (chat.Service, error)
+	Update(ctx context.Context, serviceSid string, body chat.ServiceUpdateParams) (chat.Service, error)
+}
+
+// RoleResource lists, creates and updates roles, satisfied by chat.RoleResource.
+type RoleResource interface {
+	List(ctx context.Context, serviceSid string, params chat.ListParams) (chat.RoleList, error)
+	Create(ctx context.Context, serviceSid string, body chat.RoleCreateParams) (chat.Role, error)
+	Update(ctx context.Context, serviceSid, roleSid string, body chat.RoleUpdateParams) (chat.Role, error)
+}
+
+// Reconciler diffs service specs against the live services and applies the changes.
+type Reconciler struct {
+	Services ServiceResource
+	Roles    RoleResource
+}
+
+// NewReconciler returns a Reconciler using the chat client resources.
+func NewReconciler(c chat.Chat) Reconciler {
+	return Reconciler{Services: c.Services, Roles: c.Roles}
+}
+
+// Plan diffs the spec against the live service, no changes are applied.
+func (r Reconciler) Plan(ctx context.Context, serviceSid string, desired Service) (Plan, error) {
+	plan := Plan{
+		ServiceSid:   serviceSid,
+		roleSids:     make(map[string]string),
+		defaultRoles: make(map[string]string),
+	}
+	if err := desired.Validate(); err != nil {
+		return plan, err
+	}
+
+	svc, err := r.Services.Read(ctx, serviceSid)
+	if err != nil {
+		return plan, errors.Wrap(err, "spec: could not read service")
+	}
+	roles, err := r.listRoles(ctx, serviceSid)
+	if err != nil {
+		return plan, errors.Wrap(err, "spec: could not list roles")
+	}
+
+	live := make(map[string]chat.Role, len(roles))
+	for _, role := range roles {
+		live[role.FriendlyName] = role
+		plan.roleSids[role.FriendlyName] = role.Sid
+	}
+	created := make(map[string]bool)
+	for _, role := range desired.Roles {
+		lr, ok := live[role.Name]
+		switch {
+		case !ok:
+			plan.change(ActionCreate, "role", role.Name, "permissions", nil, sorted(role.Permissions))
+			plan.roleCreates = append(plan.roleCreates, chat.RoleCreateParams{
+				FriendlyName: role.Name,
+				Type:         role.Type,
+				Permission:   role.Permissions,
+			})
+			created[role.Name] = true
+		case lr.Type != role.Type:
+			plan.warn("role %q type can't be changed from %s to %s", role.Name, lr.Type, role.Type)
+		case !equalSets(lr.Permissions, role.Permissions):
+			plan.change(ActionUpdate, "role", role.Name, "permissions", sorted(lr.Permissions), sorted(role.Permissions))
+			plan.roleUpdates = append(plan.roleUpdates, roleUpdate{
+				sid:  lr.Sid,
+				body: chat.RoleUpdateParams{Permission: role.Permissions},
+			})
+		}
+	}
+
+	plan.diffService(svc, desired)
+	if dr := desired.DefaultRoles; dr != nil {
+		defaults := []struct{ field, name, live string }{
+			{"default_service_role", dr.Service, svc.DefaultServiceRoleSid},
+			{"default_channel_role", dr.Channel, svc.DefaultChannelRoleSid},
+			{"default_channel_creator_role", dr.ChannelCreator, svc.DefaultChannelCreatorRoleSid},
+		}
+		for _, d := range defaults {
+			if d.name == "" {
+				continue
+			}
+			// a role created by the plan has no sid yet, it never matches the live default
+			sid, ok := plan.roleSids[d.name]
+			if !ok && !created[d.name] {
+				return plan, errors.Errorf("spec: unknown %s %q", d.field, d.name)
+			}
+			if !created[d.name] && sid == d.live {
+				continue
+			}
+			plan.change(ActionUpdate, "service", serviceSid, d.field, d.live, d.name)
+			plan.defaultRoles[d.field] = d.name
+		}
+	}
+	return plan, nil
+}
+
+// Apply creates and updates the roles and then updates the service.
+func (r Reconciler) Apply(ctx context.Context, plan Plan) error {
+	roleSids := make(map[string]string, len(plan.roleSids))
+	for name, sid := range plan.roleSids {
+		roleSids[name] = sid
+	}
+
+	for _, body := range plan.roleCreates {
+		role, err := r.Roles.Create(ctx, plan.ServiceSid, body)
+		if err != nil {
+			return errors.Wrapf(err, "spec: could not create role %q", body.FriendlyName)
+		}
+		roleSids[role.FriendlyName] = role.Sid
+	}
+	for _, u := range plan.roleUpdates {
+		if _, err := r.Roles.Update(ctx, plan.ServiceSid, u.sid, u.body); err != nil {
+			return errors.Wrapf(err, "spec: could not update role %s", u.sid)
+		}
+	}
+
+	if !plan.serviceDirty && len(plan.defaultRoles) == 0 {
+		return nil
+	}
+	body := plan.service
+	for field, name := range plan.defaultRoles {
+		switch field {
+		case "default_service_role":
+			body.DefaultServiceRoleSid = roleSids[name]
+		case "default_channel_role":
+			body.DefaultChannelRoleSid = roleSids[name]
+		case "default_channel_creator_role":
+			body.DefaultChannelCreatorRoleSid = roleSids[name]
+		}
+	}
+	_, err := r.Services.Update(ctx, plan.ServiceSid, body)
+	return errors.Wrap(err, "spec: could not update service")
+}
+
+func (r Reconciler) listRoles(ctx context.Context, serviceSid string) ([]chat.Role, error) {
+	var (
+		roles  []chat.Role
+		params chat.ListParams
+	)
+	for {
+		page, err := r.Roles.List(ctx, serviceSid, params)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, page.Roles...)
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return roles, nil
+		}
+		params = next
+	}
+}
+
+func (p *Plan) diffService(svc chat.Service, desired Service) {
+	body := &p.service
+	p.diffString("friendly_name", svc.FriendlyName, desired.FriendlyName, func() { body.FriendlyName = desired.FriendlyName })
+	p.diffBool("read_status_enabled", svc.ReadStatusEnabled, desired.ReadStatusEnabled, func() { body.ReadStatusEnabled = true })
+	p.diffBool("reachability_enabled", svc.ReachabilityEnabled, desired.ReachabilityEnabled, func() { body.ReachabilityEnabled = true })
+	p.diffInt("typing_indicator_timeout", svc.TypingIndicatorTimeout, desired.TypingIndicatorTimeout, func() {
+		body.TypingIndicatorTimeout = desired.TypingIndicatorTimeout
+	})
+	p.diffInt("consumption_report_interval", svc.ConsumptionReportInterval, desired.ConsumptionReportInterval, func() {
+		body.ConsumptionReportInterval = desired.ConsumptionReportInterval
+	})
+
+	if wh := desired.Webhooks; wh != nil {
+		p.diffString("pre_webhook_url", svc.PreWebhookURL, wh.PreWebhookURL, func() { body.PreWebhookURL = wh.PreWebhookURL })
+		p.diffString("post_webhook_url", svc.PostWebhookURL, wh.PostWebhookURL, func() { body.PostWebhookURL = wh.PostWebhookURL })
+		p.diffString("webhook_method", svc.WebhookMethod, wh.Method, func() { body.WebhookMethod = wh.Method })
+		p.diffInt("pre_webhook_retry_count", svc.PreWebhookRetryCount, wh.PreWebhookRetryCount, func() {
+			body.PreWebhookRetryCount = wh.PreWebhookRetryCount
+		})
+		p.diffInt("post_webhook_retry_count", svc.PostWebhookRetryCount, wh.PostWebhookRetryCount, func() {
+			body.PostWebhookRetryCount = wh.PostWebhookRetryCount
+		})
+		if wh.Filters != nil && !equalSets(svc.WebhookFilters, wh.Filters) {
+			p.change(ActionUpdate, "service", p.ServiceSid, "webhook_filters", sorted(svc.WebhookFilters), sorted(wh.Filters))
+			p.serviceDirty = true
+			body.WebhookFilters = wh.Filters
+		}
+	}
+
+	if l := desired.Limits; l != nil {
+		p.diffInt("limits.channel_members", svc.Limits["channel_members"], l.ChannelMembers, func() {
+			body.Limits.ChannelMembers = l.ChannelMembers
+		})
+		p.diffInt("limits.user_channels", svc.Limits["user_channels"], l.UserChannels, func() {
+			body.Limits.UserChannels = l.UserChannels
+		})
+	}
+
+	if n := desired.Notifications; n != nil {
+		live := svc.Notifications
+		if live == nil {
+			live = &chat.Notifications{}
+		}
+
+		var notif chat.Notifications
+		p.diffBool("notifications.log_enabled", live.LogEnabled, n.LogEnabled, func() { notif.LogEnabled = true })
+		notif.NewMessage = p.diffNotification("new_message", live.NewMessage, n.NewMessage)
+		notif.AddedToChannel = p.diffNotification("added_to_channel", live.AddedToChannel, n.AddedToChannel)
+		notif.InvitedToChannel = p.diffNotification("invited_to_channel", live.InvitedToChannel, n.InvitedToChannel)
+		notif.RemoveFromChannel = p.diffNotification("removed_from_channel", live.RemoveFromChannel, n.RemovedFromChannel)
+		if notif != (chat.Notifications{}) {
+			body.Notifications = &notif
+		}
+	}
+}
+
+// diffNotification returns the params of the changed notification, nil if unchanged.
+func (p *Plan) diffNotification(name string, live *chat.NotificationChannelProperty, desired *Notification) *chat.NotificationChannelProperty {
+	if desired == nil {
+		return nil
+	}
+	if live == nil {
+		live = &chat.NotificationChannelProperty{}
+	}
+
+	var prop *chat.NotificationChannelProperty
+	set := func(fn func(*chat.NotificationChannelProperty)) func() {
+		return func() {
+			if prop == nil {
+				prop = &chat.NotificationChannelProperty{}
+			}
+			fn(prop)
+		}
+	}
+
+	field := "notifications." + name
+	p.diffBool(field+".enabled", live.Enabled, desired.Enabled, set(func(np *chat.NotificationChannelProperty) {
+		np.Enabled = true
+	}))
+	p.diffString(field+".template", live.Template, desired.Template, set(func(np *chat.NotificationChannelProperty) {
+		np.Template = desired.Template
+	}))
+	p.diffBool(field+".badge_count_enabled", live.BadgeCountEnabled, desired.BadgeCountEnabled, set(func(np *chat.NotificationChannelProperty) {
+		np.BadgeCountEnabled = true
+	}))
+	return prop
+}
+
+func sorted(s []string) []string {
+	c := append([]string(nil), s...)
+	sort.Strings(c)
+	return c
+}
+
+func equalSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa, sb := sorted(a), sorted(b)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,213 @@
+package spec
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/smnalex/twilio-go"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+type fakeServices struct {
+	service chat.Service
+	updates []chat.ServiceUpdateParams
+}
+
+func (f *fakeServices) Read(ctx context.Context, serviceSid string) (chat.Service, error) {
+	if serviceSid != f.service.Sid {
+		return chat.Service{}, twilio.ErrTwilioResponse{Status: 404}
+	}
+	return f.service, nil
+}
+
+func (f *fakeServices) Update(ctx context.Context, serviceSid string, body chat.ServiceUpdateParams) (chat.Service, error) {
+	f.updates = append(f.updates, body)
+	return f.service, nil
+}
+
+type fakeRoles struct {
+	roles   []chat.Role
+	creates []chat.RoleCreateParams
+	updates map[string]chat.RoleUpdateParams
+}
+
+// List returns one role per page.
+func (f *fakeRoles) List(ctx context.Context, serviceSid string, params chat.ListParams) (chat.RoleList, error) {
+	var list chat.RoleList
+	if params.Page < len(f.roles) {
+		list.Roles = f.roles[params.Page : params.Page+1]
+	}
+	if params.Page+1 < len(f.roles) {
+		list.Meta.NextPageURL = "https://chat.twilio.com/v2/Services/IS1/Roles?PageSize=1&Page=" + string(rune('0'+params.Page+1))
+	}
+	return list, nil
+}
+
+func (f *fakeRoles) Create(ctx context.Context, serviceSid string, body chat.RoleCreateParams) (chat.Role, error) {
+	f.creates = append(f.creates, body)
+	return chat.Role{Sid: "RLnew", FriendlyName: body.FriendlyName}, nil
+}
+
+func (f *fakeRoles) Update(ctx context.Context, serviceSid, roleSid string, body chat.RoleUpdateParams) (chat.Role, error) {
+	if f.updates == nil {
+		f.updates = make(map[string]chat.RoleUpdateParams)
+	}
+	f.updates[roleSid] = body
+	return chat.Role{Sid: roleSid}, nil
+}
+
+func setup() (*fakeServices, *fakeRoles, Reconciler) {
+	services := &fakeServices{service: chat.Service{
+		Sid:                   "IS1",
+		FriendlyName:          "old",
+		ReadStatusEnabled:     true,
+		DefaultServiceRoleSid: "RL0",
+		DefaultChannelRoleSid: "RL2",
+		WebhookFilters:        []string{"onMessageSent"},
+		Limits:                map[string]int{"channel_members": 100, "user_channels": 250},
+	}}
+	roles := &fakeRoles{roles: []chat.Role{
+		{Sid: "RL1", FriendlyName: "service user", Type: "deployment", Permissions: []string{"createChannel"}},
+		{Sid: "RL2", FriendlyName: "channel user", Type: "channel", Permissions: []string{"sendMessage", "leaveChannel"}},
+	}}
+	return services, roles, Reconciler{Services: services, Roles: roles}
+}
+
+func TestReconcilerPlan(t *testing.T) {
+	t.Run("changes", func(t *testing.T) {
+		_, _, r := setup()
+		disabled, enabled := false, true
+		desired := Service{
+			FriendlyName:        "support",
+			ReadStatusEnabled:   &disabled,
+			ReachabilityEnabled: &enabled,
+			Webhooks:            &Webhooks{Filters: []string{"onMessageSent"}},
+			Limits:              &Limits{ChannelMembers: 200, UserChannels: 250},
+			Notifications: &Notifications{
+				NewMessage: &Notification{Enabled: &enabled},
+			},
+			Roles: []Role{
+				{Name: "agent", Type: "channel", Permissions: []string{"sendMessage"}},
+				{Name: "channel user", Type: "channel", Permissions: []string{"leaveChannel", "sendMessage"}},
+				{Name: "service user", Type: "deployment", Permissions: []string{"createChannel", "joinChannel"}},
+			},
+			DefaultRoles: &DefaultRoles{Channel: "agent", Service: "service user"},
+		}
+
+		plan, err := r.Plan(context.TODO(), "IS1", desired)
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := `+ role "agent" permissions: [sendMessage]
+~ role "service user" permissions: [createChannel] -> [createChannel joinChannel]
+~ service "IS1" friendly_name: "old" -> "support"
+~ service "IS1" reachability_enabled: false -> true
+~ service "IS1" limits.channel_members: 100 -> 200
+~ service "IS1" notifications.new_message.enabled: false -> true
+~ service "IS1" default_service_role: RL0 -> service user
+~ service "IS1" default_channel_role: RL2 -> agent
+! service "IS1" read_status_enabled can't be disabled through the API
+`
+		if got := plan.String(); exp != got {
+			t.Errorf("plan diff %v", cmp.Diff(strings.Split(exp, "\n"), strings.Split(got, "\n")))
+		}
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		_, _, r := setup()
+		desired := Service{
+			FriendlyName: "old",
+			Roles:        []Role{{Name: "channel user", Type: "channel", Permissions: []string{"leaveChannel", "sendMessage"}}},
+			DefaultRoles: &DefaultRoles{Channel: "channel user"},
+		}
+
+		plan, err := r.Plan(context.TODO(), "IS1", desired)
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if !plan.Empty() {
+			t.Errorf("exp empty plan, got %s", plan)
+		}
+		if exp := "service \"IS1\" is up to date\n"; plan.String() != exp {
+			t.Errorf("exp %s, got %s", exp, plan)
+		}
+	})
+
+	t.Run("role type change", func(t *testing.T) {
+		_, _, r := setup()
+		plan, err := r.Plan(context.TODO(), "IS1", Service{Roles: []Role{{Name: "channel user", Type: "deployment"}}})
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if !plan.Empty() || len(plan.Warnings) != 1 {
+			t.Errorf("exp a single warning, got %s", plan)
+		}
+	})
+
+	t.Run("default role created by the plan", func(t *testing.T) {
+		_, _, r := setup()
+		desired := Service{
+			Roles:        []Role{{Name: "creator", Type: "channel", Permissions: []string{"destroyChannel"}}},
+			DefaultRoles: &DefaultRoles{ChannelCreator: "creator"},
+		}
+
+		// the service has no default channel creator role, as the role yet to be created
+		plan, err := r.Plan(context.TODO(), "IS1", desired)
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := "creator"; plan.defaultRoles["default_channel_creator_role"] != exp {
+			t.Errorf("exp default channel creator role %s, got %s", exp, plan)
+		}
+	})
+
+	t.Run("unknown default role", func(t *testing.T) {
+		_, _, r := setup()
+		if _, err := r.Plan(context.TODO(), "IS1", Service{DefaultRoles: &DefaultRoles{Service: "admin"}}); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+
+	t.Run("unknown service", func(t *testing.T) {
+		_, _, r := setup()
+		if _, err := r.Plan(context.TODO(), "IS2", Service{}); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+func TestReconcilerApply(t *testing.T) {
+	services, roles, r := setup()
+	desired := Service{
+		FriendlyName: "support",
+		Roles: []Role{
+			{Name: "agent", Type: "channel", Permissions: []string{"sendMessage"}},
+			{Name: "service user", Type: "deployment", Permissions: []string{"createChannel", "joinChannel"}},
+		},
+		DefaultRoles: &DefaultRoles{Channel: "agent"},
+	}
+
+	plan, err := r.Plan(context.TODO(), "IS1", desired)
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if err := r.Apply(context.TODO(), plan); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+
+	if exp := []chat.RoleCreateParams{{FriendlyName: "agent", Type: "channel", Permission: []string{"sendMessage"}}}; !cmp.Equal(exp, roles.creates) {
+		t.Errorf("role creates diff %v", cmp.Diff(exp, roles.creates))
+	}
+	if exp := (chat.RoleUpdateParams{Permission: []string{"createChannel", "joinChannel"}}); !cmp.Equal(exp, roles.updates["RL1"]) {
+		t.Errorf("role updates diff %v", cmp.Diff(exp, roles.updates["RL1"]))
+	}
+	if exp := 1; len(services.updates) != exp {
+		t.Fatalf("exp %d service update, got %d", exp, len(services.updates))
+	}
+	if got := services.updates[0]; got.FriendlyName != "support" || got.DefaultChannelRoleSid != "RLnew" {
+		t.Errorf("exp service update with friendly name and new default channel role, got %+v", got)
+	}
+}
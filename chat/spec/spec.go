@@ -0,0 +1,120 @@
+// Package spec describes the configuration of Programmable Chat services as code
+// and reconciles it with the live services.
+package spec
+
+import (
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Role types.
+const (
+	RoleTypeChannel    = "channel"
+	RoleTypeDeployment = "deployment"
+)
+
+// Service is the desired configuration of a Programmable Chat service, the zero value
+// of a field leaves the live configuration untouched.
+type Service struct {
+	FriendlyName              string `json:"friendly_name,omitempty" yaml:"friendly_name,omitempty"`
+	ReadStatusEnabled         *bool  `json:"read_status_enabled,omitempty" yaml:"read_status_enabled,omitempty"`
+	ReachabilityEnabled       *bool  `json:"reachability_enabled,omitempty" yaml:"reachability_enabled,omitempty"`
+	TypingIndicatorTimeout    int    `json:"typing_indicator_timeout,omitempty" yaml:"typing_indicator_timeout,omitempty"`
+	ConsumptionReportInterval int    `json:"consumption_report_interval,omitempty" yaml:"consumption_report_interval,omitempty"`
+
+	Webhooks      *Webhooks      `json:"webhooks,omitempty" yaml:"webhooks,omitempty"`
+	Limits        *Limits        `json:"limits,omitempty" yaml:"limits,omitempty"`
+	Notifications *Notifications `json:"notifications,omitempty" yaml:"notifications,omitempty"`
+
+	// Roles are matched by name with the live roles, live roles missing
+	// from the spec are left untouched.
+	Roles        []Role        `json:"roles,omitempty" yaml:"roles,omitempty"`
+	DefaultRoles *DefaultRoles `json:"default_roles,omitempty" yaml:"default_roles,omitempty"`
+}
+
+// Webhooks holds the webhooks configuration of a service.
+type Webhooks struct {
+	PreWebhookURL         string   `json:"pre_webhook_url,omitempty" yaml:"pre_webhook_url,omitempty"`
+	PostWebhookURL        string   `json:"post_webhook_url,omitempty" yaml:"post_webhook_url,omitempty"`
+	Method                string   `json:"method,omitempty" yaml:"method,omitempty"`
+	Filters               []string `json:"filters,omitempty" yaml:"filters,omitempty"`
+	PreWebhookRetryCount  int      `json:"pre_webhook_retry_count,omitempty" yaml:"pre_webhook_retry_count,omitempty"`
+	PostWebhookRetryCount int      `json:"post_webhook_retry_count,omitempty" yaml:"post_webhook_retry_count,omitempty"`
+}
+
+// Limits holds the limits of a service.
+type Limits struct {
+	ChannelMembers int `json:"channel_members,omitempty" yaml:"channel_members,omitempty"`
+	UserChannels   int `json:"user_channels,omitempty" yaml:"user_channels,omitempty"`
+}
+
+// Notifications holds the push notifications configuration of a service.
+type Notifications struct {
+	LogEnabled         *bool         `json:"log_enabled,omitempty" yaml:"log_enabled,omitempty"`
+	NewMessage         *Notification `json:"new_message,omitempty" yaml:"new_message,omitempty"`
+	AddedToChannel     *Notification `json:"added_to_channel,omitempty" yaml:"added_to_channel,omitempty"`
+	InvitedToChannel   *Notification `json:"invited_to_channel,omitempty" yaml:"invited_to_channel,omitempty"`
+	RemovedFromChannel *Notification `json:"removed_from_channel,omitempty" yaml:"removed_from_channel,omitempty"`
+}
+
+// Notification holds the configuration of a push notification type.
+type Notification struct {
+	Enabled           *bool  `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Template          string `json:"template,omitempty" yaml:"template,omitempty"`
+	BadgeCountEnabled *bool  `json:"badge_count_enabled,omitempty" yaml:"badge_count_enabled,omitempty"`
+}
+
+// Role describes a role by its friendly name.
+type Role struct {
+	Name string `json:"name" yaml:"name"`
+
+	// Type is either `channel` or `deployment`.
+	Type        string   `json:"type" yaml:"type"`
+	Permissions []string `json:"permissions" yaml:"permissions"`
+}
+
+// DefaultRoles holds the friendly names of the roles assigned by default, either
+// declared in the spec or existing in the service.
+type DefaultRoles struct {
+	Service        string `json:"service,omitempty" yaml:"service,omitempty"`
+	Channel        string `json:"channel,omitempty" yaml:"channel,omitempty"`
+	ChannelCreator string `json:"channel_creator,omitempty" yaml:"channel_creator,omitempty"`
+}
+
+// Parse decodes and validates a YAML or JSON service spec, unknown fields are rejected.
+func Parse(data []byte) (Service, error) {
+	var s Service
+	if err := yaml.UnmarshalStrict(data, &s); err != nil {
+		return s, errors.Wrap(err, "spec: could not decode service")
+	}
+	return s, s.Validate()
+}
+
+// Load reads and parses the service spec from a YAML or JSON file.
+func Load(path string) (Service, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Service{}, errors.Wrap(err, "spec: could not read service")
+	}
+	return Parse(data)
+}
+
+// Validate checks the roles have unique names and valid types.
+func (s Service) Validate() error {
+	names := make(map[string]bool, len(s.Roles))
+	for _, r := range s.Roles {
+		if r.Name == "" {
+			return errors.New("spec: role without a name")
+		}
+		if names[r.Name] {
+			return errors.Errorf("spec: duplicate role %q", r.Name)
+		}
+		if r.Type != RoleTypeChannel && r.Type != RoleTypeDeployment {
+			return errors.Errorf("spec: role %q has invalid type %q", r.Name, r.Type)
+		}
+		names[r.Name] = true
+	}
+	return nil
+}
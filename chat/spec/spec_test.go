@@ -0,0 +1,74 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		s, err := Load("testdata/service.yaml")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		enabled := true
+		exp := Service{
+			FriendlyName:           "support",
+			ReadStatusEnabled:      &enabled,
+			TypingIndicatorTimeout: 5,
+			Webhooks: &Webhooks{
+				PostWebhookURL: "https://example.com/chat/events",
+				Method:         "POST",
+				Filters:        []string{"onMessageSent", "onChannelAdded"},
+			},
+			Limits: &Limits{ChannelMembers: 200},
+			Notifications: &Notifications{
+				LogEnabled: &enabled,
+				NewMessage: &Notification{Enabled: &enabled, Template: "${USER}: ${MESSAGE}"},
+			},
+			Roles: []Role{
+				{Name: "agent", Type: "channel", Permissions: []string{"sendMessage", "leaveChannel", "editAnyMessage"}},
+				{Name: "channel user", Type: "channel", Permissions: []string{"sendMessage", "leaveChannel"}},
+			},
+			DefaultRoles: &DefaultRoles{Channel: "agent"},
+		}
+		if !cmp.Equal(exp, s) {
+			t.Errorf("spec diff %v", cmp.Diff(exp, s))
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		s, err := Load("testdata/service.json")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := "agent"; s.DefaultRoles.Channel != exp || s.Roles[0].Name != exp {
+			t.Errorf("exp role %s, got %+v", exp, s)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := Load("testdata/missing.yaml"); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+func TestParse(t *testing.T) {
+	tt := map[string]string{
+		"unknown field":  "friendly_nam: support",
+		"invalid yaml":   "roles: [",
+		"role no name":   "roles: [{type: channel}]",
+		"role bad type":  "roles: [{name: a, type: service}]",
+		"duplicate role": "roles: [{name: a, type: channel}, {name: a, type: deployment}]",
+	}
+	for name, data := range tt {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(data)); err == nil {
+				t.Error("exp err, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package chat
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+// Twilio error codes returned when creating a resource that already exists.
+const (
+	codeUserExists    = 50201
+	codeChannelExists = 50307
+	codeMemberExists  = 50404
+)
+
+// ErrMissingUniqueName returned when upserting a channel without a unique name.
+var ErrMissingUniqueName = errors.New("chat: channel upsert requires a unique name")
+
+// Upsert creates the user or updates the existing user with the same identity,
+// an existing user already matching the params is returned without an update.
+func (r UserResource) Upsert(ctx context.Context, serviceSid string, body UserCreateParams) (User, error) {
+	usr, err := r.Read(ctx, serviceSid, body.Identity)
+	if twilio.IsNotFound(err) {
+		usr, err = r.Create(ctx, serviceSid, body)
+		if !isAlreadyExists(err, codeUserExists) {
+			return usr, err
+		}
+		usr, err = r.Read(ctx, serviceSid, body.Identity)
+	}
+	if err != nil {
+		return usr, err
+	}
+
+	if (body.RoleSid == "" || body.RoleSid == usr.RoleSID) &&
+		(body.FriendlyName == "" || body.FriendlyName == usr.FriendlyName) &&
+		(len(body.Attributes) == 0 || twilio.AttributesEqual(body.Attributes, usr.Attributes)) {
+		return usr, nil
+	}
+	return r.Update(ctx, serviceSid, usr.Sid, UserUpdateParams{
+		RoleSid:      body.RoleSid,
+		FriendlyName: body.FriendlyName,
+		Attributes:   body.Attributes,
+	})
+}
+
+// Upsert creates the channel or updates the existing channel with the same unique name,
+// an existing channel already matching the params is returned without an update.
+// The type of an existing channel is never changed.
+func (r ChannelResource) Upsert(ctx context.Context, serviceSid string, body ChannelCreateParams) (Channel, error) {
+	if body.UniqueName == "" {
+		return Channel{}, ErrMissingUniqueName
+	}
+
+	chn, err := r.Read(ctx, serviceSid, body.UniqueName)
+	if twilio.IsNotFound(err) {
+		chn, err = r.Create(ctx, serviceSid, body)
+		if !isAlreadyExists(err, codeChannelExists) {
+			return chn, err
+		}
+		chn, err = r.Read(ctx, serviceSid, body.UniqueName)
+	}
+	if err != nil {
+		return chn, err
+	}
+
+	if (body.FriendlyName == "" || body.FriendlyName == chn.FriendlyName) &&
+		(body.CreatedBy == "" || body.CreatedBy == chn.CreatedBy) &&
+		(len(body.Attributes) == 0 || twilio.AttributesEqual(body.Attributes, chn.Attributes)) {
+		return chn, nil
+	}
+	return r.Update(ctx, serviceSid, chn.Sid, ChannelUpdateParams{
+		FriendlyName: body.FriendlyName,
+		Attributes:   body.Attributes,
+		CreatedBy:    body.CreatedBy,
+	})
+}
+
+// Ensure adds the member to the channel or updates the existing member with the same
+// identity, an existing member already matching the role and attributes is returned
+// without an update.
+func (r MemberResource) Ensure(ctx context.Context, serviceSid, channelSid string, body MemberCreateParams) (Member, error) {
+	mem, err := r.Read(ctx, serviceSid, channelSid, body.Identity)
+	if twilio.IsNotFound(err) {
+		mem, err = r.Add(ctx, serviceSid, channelSid, body)
+		if !isAlreadyExists(err, codeMemberExists) {
+			return mem, err
+		}
+		mem, err = r.Read(ctx, serviceSid, channelSid, body.Identity)
+	}
+	if err != nil {
+		return mem, err
+	}
+
+	if (body.RoleSid == "" || body.RoleSid == mem.RoleSid) &&
+		(len(body.Attributes) == 0 || twilio.AttributesEqual(body.Attributes, mem.Attributes)) {
+		return mem, nil
+	}
+	return r.Update(ctx, serviceSid, channelSid, mem.Sid, MemberUpdateParams{
+		RoleSid:    body.RoleSid,
+		Attributes: body.Attributes,
+	})
+}
+
+func isAlreadyExists(err error, code int) bool {
+	terr, ok := errors.Cause(err).(twilio.ErrTwilioResponse)
+	return ok && (terr.Code == code || terr.Status == http.StatusConflict)
+}
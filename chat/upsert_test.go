@@ -0,0 +1,287 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+var (
+	errNotFound = twilio.ErrTwilioResponse{Status: 404, Code: 20404}
+	errConflict = twilio.ErrTwilioResponse{Status: 409}
+)
+
+func readBody(body io.Reader) string {
+	b, _ := ioutil.ReadAll(body)
+	return string(b)
+}
+
+func TestUserUpsert(t *testing.T) {
+	t.Run("create missing user", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return nil, errNotFound
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			if exp := "/Services/sid/Users"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if got, exp := readBody(body), "Attributes=%7B%22team%22%3A%22support%22%7D&Identity=jing"; exp != got {
+				t.Errorf("exp create body %s, got %s", exp, got)
+			}
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+
+		body := UserCreateParams{Identity: "jing", Attributes: json.RawMessage(`{"team":"support"}`)}
+		usr, err := UserResource{userAPI{client}}.Upsert(context.TODO(), "sid", body)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := "jing"; usr.Identity != exp {
+			t.Errorf("exp user %s, got %s", exp, usr.Identity)
+		}
+	})
+
+	t.Run("update existing user", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Users/jing"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			if exp := "/Services/sid/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if got, _ := ioutil.ReadAll(body); string(got) != "FriendlyName=Jing" {
+				t.Errorf("exp update body, got %s", got)
+			}
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+
+		if _, err := (UserResource{userAPI{client}}).Upsert(context.TODO(), "sid", UserCreateParams{Identity: "jing", FriendlyName: "Jing"}); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("existing user up to date", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+
+		body := UserCreateParams{Identity: "jing", RoleSid: "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"}
+		if _, err := (UserResource{userAPI{client}}).Upsert(context.TODO(), "sid", body); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("create race", func(t *testing.T) {
+		var reads int
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if reads++; reads == 1 {
+				return nil, errNotFound
+			}
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			return nil, twilio.ErrTwilioResponse{Code: codeUserExists}
+		}
+
+		if _, err := (UserResource{userAPI{client}}).Upsert(context.TODO(), "sid", UserCreateParams{Identity: "jing"}); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := 2; reads != exp {
+			t.Errorf("exp %d reads, got %d", exp, reads)
+		}
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return nil, twilio.ErrTwilioResponse{Status: 500}
+		}
+
+		exp := twilio.ErrTwilioResponse{Status: 500}
+		if _, err := (UserResource{userAPI{client}}).Upsert(context.TODO(), "sid", UserCreateParams{Identity: "jing"}); err != exp {
+			t.Errorf("exp err %v, got %v", exp, err)
+		}
+	})
+}
+
+func TestChannelUpsert(t *testing.T) {
+	t.Run("missing unique name", func(t *testing.T) {
+		if _, err := (ChannelResource{}).Upsert(context.TODO(), "sid", ChannelCreateParams{}); err != ErrMissingUniqueName {
+			t.Errorf("exp err %v, got %v", ErrMissingUniqueName, err)
+		}
+	})
+
+	t.Run("create race", func(t *testing.T) {
+		var reads int
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Channels/unique_name"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if reads++; reads == 1 {
+				return nil, errNotFound
+			}
+			return ioutil.ReadFile("fixtures/channel.json")
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			if path != "/Services/sid/Channels" {
+				t.Errorf("exp no update, got %s", path)
+			}
+			if got, exp := readBody(body), "FriendlyName=friendly_name&UniqueName=unique_name"; exp != got {
+				t.Errorf("exp create body %s, got %s", exp, got)
+			}
+			return nil, errConflict
+		}
+
+		body := ChannelCreateParams{UniqueName: "unique_name", FriendlyName: "friendly_name"}
+		chn, err := ChannelResource{channelAPI{client}}.Upsert(context.TODO(), "sid", body)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := "unique_name"; chn.UniqueName != exp {
+			t.Errorf("exp channel %s, got %s", exp, chn.UniqueName)
+		}
+	})
+
+	t.Run("update existing channel", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return ioutil.ReadFile("fixtures/channel.json")
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			if exp := "/Services/sid/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if got, exp := readBody(body), "Attributes=%7B%22new%22%3A+true%7D"; exp != got {
+				t.Errorf("exp update body %s, got %s", exp, got)
+			}
+			return ioutil.ReadFile("fixtures/channel.json")
+		}
+
+		body := ChannelCreateParams{UniqueName: "unique_name", Attributes: json.RawMessage(`{"new": true}`)}
+		if _, err := (ChannelResource{channelAPI{client}}).Upsert(context.TODO(), "sid", body); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestMemberEnsure(t *testing.T) {
+	t.Run("existing member", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Channels/csid/Members/jing"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/member.json")
+		}
+
+		mem, err := MemberResource{memberAPI{client}}.Ensure(context.TODO(), "sid", "csid", MemberCreateParams{Identity: "jing"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := "jing"; mem.Identity != exp {
+			t.Errorf("exp member %s, got %s", exp, mem.Identity)
+		}
+	})
+
+	t.Run("update existing member", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return ioutil.ReadFile("fixtures/member.json")
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			if exp := "/Services/sid/Channels/csid/Members/MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if got, exp := readBody(body), "Attributes=%7B%22team%22%3A%22support%22%7D&RoleSid=RL1"; exp != got {
+				t.Errorf("exp update body %s, got %s", exp, got)
+			}
+			return ioutil.ReadFile("fixtures/member.json")
+		}
+
+		body := MemberCreateParams{Identity: "jing", RoleSid: "RL1", Attributes: json.RawMessage(`{"team":"support"}`)}
+		if _, err := (MemberResource{memberAPI{client}}).Ensure(context.TODO(), "sid", "csid", body); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("existing member matching", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return ioutil.ReadFile("fixtures/member.json")
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			t.Errorf("exp no update, got post to %s", path)
+			return nil, nil
+		}
+
+		body := MemberCreateParams{Identity: "jing", RoleSid: "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", Attributes: json.RawMessage(`{}`)}
+		if _, err := (MemberResource{memberAPI{client}}).Ensure(context.TODO(), "sid", "csid", body); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("add missing member", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return nil, errNotFound
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			if exp := "/Services/sid/Channels/csid/Members"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if got, exp := readBody(body), "Identity=jing&RoleSid=RL1"; exp != got {
+				t.Errorf("exp add body %s, got %s", exp, got)
+			}
+			return ioutil.ReadFile("fixtures/member.json")
+		}
+
+		if _, err := (MemberResource{memberAPI{client}}).Ensure(context.TODO(), "sid", "csid", MemberCreateParams{Identity: "jing", RoleSid: "RL1"}); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("add race", func(t *testing.T) {
+		var reads int
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if reads++; reads == 1 {
+				return nil, errNotFound
+			}
+			return ioutil.ReadFile("fixtures/member.json")
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			return nil, twilio.ErrTwilioResponse{Code: codeMemberExists}
+		}
+
+		if _, err := (MemberResource{memberAPI{client}}).Ensure(context.TODO(), "sid", "csid", MemberCreateParams{Identity: "jing"}); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("add error", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return nil, errNotFound
+		}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			return nil, twilio.ErrTwilioResponse{Status: 400}
+		}
+
+		exp := twilio.ErrTwilioResponse{Status: 400}
+		if _, err := (MemberResource{memberAPI{client}}).Ensure(context.TODO(), "sid", "csid", MemberCreateParams{Identity: "jing"}); err != exp {
+			t.Errorf("exp err %v, got %v", exp, err)
+		}
+	})
+}
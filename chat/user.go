@@ -47,6 +47,12 @@ type User struct {
 	Attributes json.RawMessage `json:"attributes,omitempty"`
 }
 
+// UserList holds a page of users of a service.
+type UserList struct {
+	Users []User `json:"users"`
+	Meta  Meta   `json:"meta"`
+}
+
 // UserCreateParams holds information used in creating a new user.
 // https://www.twilio.com/docs/chat/rest/users#create-a-user
 type UserCreateParams struct {
@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -18,14 +17,18 @@ type userAPI struct {
 // https://www.twilio.com/docs/chat/rest/users#retrieve-a-user
 func (api userAPI) Read(ctx context.Context, serviceSid, identity string) (User, error) {
 	var usr User
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s/Users/%s", serviceSid, identity))
-	if err != nil {
-		return usr, err
-	}
-	err = json.Unmarshal(data, &usr)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Users/%s", serviceSid, identity), &usr)
 	return usr, err
 }
 
+// GET /Services/{Service SID}/Users
+// https://www.twilio.com/docs/chat/rest/users#read-multiple-users
+func (api userAPI) List(ctx context.Context, serviceSid string, params ListParams) (UserList, error) {
+	var users UserList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Users%s", serviceSid, twilio.Query(params)), &users)
+	return users, err
+}
+
 // POST /Services/{Service SID}/Users
 // https://www.twilio.com/docs/chat/rest/users#create-a-user
 func (api userAPI) Create(ctx context.Context, serviceSid string, body UserCreateParams) (User, error) {
@@ -47,10 +50,6 @@ func (api userAPI) Delete(ctx context.Context, serviceSid, identity string) erro
 
 func (api userAPI) post(ctx context.Context, path string, body io.Reader) (User, error) {
 	var usr User
-	data, err := api.client.Post(ctx, path, body)
-	if err != nil {
-		return usr, err
-	}
-	err = json.Unmarshal(data, &usr)
+	err := twilio.PostInto(ctx, api.client, path, body, &usr)
 	return usr, err
 }
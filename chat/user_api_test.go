@@ -45,6 +45,45 @@ func TestUserRead(t *testing.T) {
 	})
 }
 
+func TestUserList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Users?PageSize=1&PageToken=PA"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/users.json")
+		}
+
+		var (
+			exp  = UserList{}
+			f, _ = os.Open("fixtures/users.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		users, err := userAPI{client}.List(context.TODO(), "sid", ListParams{PageSize: 1, PageToken: "PA"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, users) {
+			t.Errorf("response diff %v", cmp.Diff(exp, users))
+		}
+		if exp := "jing"; len(users.Users) != 1 || users.Users[0].Identity != exp {
+			t.Errorf("exp user %s, got %v", exp, users.Users)
+		}
+		if _, ok := users.Meta.Next(); !ok {
+			t.Error("exp a next page")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return userAPI{client}.List(ctx, "sid", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
 func TestUserCreate(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		client := &HTTPClientMock{}
@@ -2,7 +2,6 @@ package chat
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/smnalex/twilio-go"
@@ -16,10 +15,6 @@ type userChannelAPI struct {
 // https://www.twilio.com/docs/chat/rest/user-channels#list-all-user-channels
 func (api userChannelAPI) List(ctx context.Context, serviceSid, userSid string) (UserChannelList, error) {
 	var chanList UserChannelList
-	data, err := api.client.Get(ctx, fmt.Sprintf("/Services/%s/Users/%s/Channels", serviceSid, userSid))
-	if err != nil {
-		return chanList, err
-	}
-	err = json.Unmarshal(data, &chanList)
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Users/%s/Channels", serviceSid, userSid), &chanList)
 	return chanList, err
 }
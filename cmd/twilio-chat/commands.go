@@ -0,0 +1,428 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go/chat"
+	"github.com/smnalex/twilio-go/chat/archive"
+	"github.com/smnalex/twilio-go/chat/migrate"
+)
+
+var (
+	serviceHeader = []string{"SID", "FRIENDLY NAME", "DATE CREATED"}
+	channelHeader = []string{"SID", "UNIQUE NAME", "FRIENDLY NAME", "TYPE", "MEMBERS", "MESSAGES"}
+	messageHeader = []string{"INDEX", "SID", "FROM", "DATE CREATED", "BODY"}
+	roleHeader    = []string{"SID", "FRIENDLY NAME", "TYPE", "PERMISSIONS"}
+	statsHeader   = []string{"ROLES", "USERS", "CHANNELS", "MEMBERS", "MESSAGES", "SKIPPED"}
+)
+
+func serviceRow(s chat.Service) []string {
+	return []string{s.Sid, s.FriendlyName, s.DateCreated}
+}
+
+func channelRow(c chat.Channel) []string {
+	return []string{c.Sid, c.UniqueName, c.FriendlyName, c.Type, strconv.Itoa(c.MembersCount), strconv.Itoa(c.MessagesCount)}
+}
+
+func messageRow(m chat.Message) []string {
+	return []string{strconv.Itoa(m.Index), m.Sid, m.From, m.DateCreated, m.Body}
+}
+
+func roleRow(r chat.Role) []string {
+	return []string{r.Sid, r.FriendlyName, r.Type, strings.Join(r.Permissions, ",")}
+}
+
+func servicesList(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("services ls", flag.ContinueOnError)
+	if _, err := parse(fs, args, 0, false); err != nil {
+		return err
+	}
+
+	var (
+		services []chat.Service
+		rows     [][]string
+		params   chat.ListParams
+	)
+	for {
+		page, err := c.chat.Services.List(ctx, params)
+		if err != nil {
+			return err
+		}
+		for _, s := range page.Services {
+			services = append(services, s)
+			rows = append(rows, serviceRow(s))
+		}
+		next, ok := page.Meta.Next()
+		if !ok {
+			return c.print(services, serviceHeader, rows...)
+		}
+		params = next
+	}
+}
+
+func servicesGet(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("services get", flag.ContinueOnError)
+	pos, err := parse(fs, args, 1, false)
+	if err != nil {
+		return err
+	}
+
+	s, err := c.chat.Services.Read(ctx, pos[0])
+	if err != nil {
+		return err
+	}
+	return c.print(s, serviceHeader, serviceRow(s))
+}
+
+func servicesUpdate(ctx context.Context, c *cli, args []string) error {
+	var (
+		body    chat.ServiceUpdateParams
+		filters stringsFlag
+		fs      = flag.NewFlagSet("services update", flag.ContinueOnError)
+	)
+	fs.StringVar(&body.FriendlyName, "friendly-name", "", "service friendly name")
+	fs.StringVar(&body.PreWebhookURL, "pre-webhook-url", "", "pre-event webhook url")
+	fs.StringVar(&body.PostWebhookURL, "post-webhook-url", "", "post-event webhook url")
+	fs.StringVar(&body.WebhookMethod, "webhook-method", "", "webhook http method")
+	fs.Var(&filters, "webhook-filter", "webhook event filter, repeatable")
+	fs.StringVar(&body.DefaultServiceRoleSid, "default-service-role", "", "default service role sid")
+	fs.StringVar(&body.DefaultChannelRoleSid, "default-channel-role", "", "default channel role sid")
+	fs.StringVar(&body.DefaultChannelCreatorRoleSid, "default-channel-creator-role", "", "default channel creator role sid")
+	fs.BoolVar(&body.ReadStatusEnabled, "read-status", false, "enable read status")
+	fs.BoolVar(&body.ReachabilityEnabled, "reachability", false, "enable reachability")
+	fs.IntVar(&body.TypingIndicatorTimeout, "typing-timeout", 0, "typing indicator timeout in seconds")
+	pos, err := parse(fs, args, 1, false)
+	if err != nil {
+		return err
+	}
+	body.WebhookFilters = filters
+
+	s, err := c.chat.Services.Update(ctx, pos[0], body)
+	if err != nil {
+		return err
+	}
+	return c.print(s, serviceHeader, serviceRow(s))
+}
+
+// servicesMigrate clones a service into another, rerunning the command with the
+// same checkpoint resumes an interrupted migration.
+func servicesMigrate(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("services migrate", flag.ContinueOnError)
+	checkpoint := fs.String("checkpoint", "migrate.json", "checkpoint file")
+	pos, err := parse(fs, args, 2, false)
+	if err != nil {
+		return err
+	}
+
+	stats, err := migrate.New(c.chat, c.chat).Migrate(ctx, pos[0], pos[1], *checkpoint)
+	if err != nil {
+		return err
+	}
+	return c.print(stats, statsHeader, []string{
+		strconv.Itoa(stats.Roles),
+		strconv.Itoa(stats.Users),
+		strconv.Itoa(stats.Channels),
+		strconv.Itoa(stats.Members),
+		strconv.Itoa(stats.Messages),
+		strconv.Itoa(stats.Skipped),
+	})
+}
+
+func channelsList(ctx context.Context, c *cli, args []string) error {
+	var (
+		params chat.ChannelListParams
+		types  stringsFlag
+		fs     = flag.NewFlagSet("channels ls", flag.ContinueOnError)
+	)
+	fs.Var(&types, "type", "channel type public or private, repeatable")
+	pos, err := parse(fs, args, 1, false)
+	if err != nil {
+		return err
+	}
+	params.Type = types
+
+	var (
+		channels []chat.Channel
+		rows     [][]string
+	)
+	for {
+		page, err := c.chat.Channels.List(ctx, pos[0], params)
+		if err != nil {
+			return err
+		}
+		for _, ch := range page.Channels {
+			channels = append(channels, ch)
+			rows = append(rows, channelRow(ch))
+		}
+		next, ok := page.Meta.Next()
+		if !ok {
+			return c.print(channels, channelHeader, rows...)
+		}
+		params.ListParams = next
+	}
+}
+
+func channelsCreate(ctx context.Context, c *cli, args []string) error {
+	var (
+		body  chat.ChannelCreateParams
+		attrs string
+		fs    = flag.NewFlagSet("channels create", flag.ContinueOnError)
+	)
+	fs.StringVar(&body.FriendlyName, "friendly-name", "", "channel friendly name")
+	fs.StringVar(&body.UniqueName, "unique-name", "", "channel unique name")
+	fs.StringVar(&body.Type, "type", "", "channel type public or private")
+	fs.StringVar(&body.CreatedBy, "created-by", "", "identity of the channel creator")
+	fs.StringVar(&attrs, "attributes", "", "channel JSON attributes")
+	pos, err := parse(fs, args, 1, false)
+	if err != nil {
+		return err
+	}
+	if body.Attributes, err = attributes(attrs); err != nil {
+		return err
+	}
+
+	ch, err := c.chat.Channels.Create(ctx, pos[0], body)
+	if err != nil {
+		return err
+	}
+	return c.print(ch, channelHeader, channelRow(ch))
+}
+
+func channelsRemove(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("channels rm", flag.ContinueOnError)
+	workers := fs.Int("workers", chat.DefaultBatchWorkers, "concurrent deletes")
+	pos, err := parse(fs, args, 2, true)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for i, err := range c.chat.Channels.DeleteMany(ctx, pos[0], pos[1:], *workers) {
+		if err != nil {
+			failed = append(failed, pos[i+1]+": "+err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("could not remove channels\n%s", strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+func membersAdd(ctx context.Context, c *cli, args []string) error {
+	var (
+		body  chat.MemberCreateParams
+		attrs string
+		fs    = flag.NewFlagSet("members add", flag.ContinueOnError)
+	)
+	fs.StringVar(&body.RoleSid, "role", "", "member role sid")
+	fs.StringVar(&attrs, "attributes", "", "member JSON attributes")
+	pos, err := parse(fs, args, 3, false)
+	if err != nil {
+		return err
+	}
+	if body.Attributes, err = attributes(attrs); err != nil {
+		return err
+	}
+	body.Identity = pos[2]
+
+	m, err := c.chat.Members.Add(ctx, pos[0], pos[1], body)
+	if err != nil {
+		return err
+	}
+	return c.print(m, []string{"SID", "IDENTITY", "ROLE"}, []string{m.Sid, m.Identity, m.RoleSid})
+}
+
+func membersRemove(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("members rm", flag.ContinueOnError)
+	pos, err := parse(fs, args, 3, false)
+	if err != nil {
+		return err
+	}
+	return c.chat.Members.Delete(ctx, pos[0], pos[1], pos[2])
+}
+
+func messagesSend(ctx context.Context, c *cli, args []string) error {
+	var (
+		body  chat.MessageCreateParams
+		attrs string
+		fs    = flag.NewFlagSet("messages send", flag.ContinueOnError)
+	)
+	fs.StringVar(&body.From, "from", "", "identity of the sender, default system")
+	fs.StringVar(&body.Body, "body", "", "message body")
+	fs.StringVar(&attrs, "attributes", "", "message JSON attributes")
+	pos, err := parse(fs, args, 2, false)
+	if err != nil {
+		return err
+	}
+	if body.Attributes, err = attributes(attrs); err != nil {
+		return err
+	}
+
+	m, err := c.chat.Messages.Send(ctx, pos[0], pos[1], body)
+	if err != nil {
+		return err
+	}
+	return c.print(m, messageHeader, messageRow(m))
+}
+
+// messagesTail prints the last messages of a channel and polls for new messages
+// until the ctx is done.
+func messagesTail(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("messages tail", flag.ContinueOnError)
+	last := fs.Int("n", 10, "number of messages printed initially")
+	interval := fs.Duration("interval", 2*time.Second, "polling interval")
+	pos, err := parse(fs, args, 2, false)
+	if err != nil {
+		return err
+	}
+
+	var (
+		index  = -1
+		header = messageHeader
+		size   = *last
+	)
+	for {
+		msgs, err := messagesAfter(ctx, c, pos[0], pos[1], index, size)
+		if err != nil {
+			return err
+		}
+
+		var rows [][]string
+		for _, m := range msgs {
+			index = m.Index
+			if c.output == "json" {
+				if err := c.print(m, nil); err != nil {
+					return err
+				}
+				continue
+			}
+			rows = append(rows, messageRow(m))
+		}
+		if len(rows) > 0 {
+			if err := c.print(nil, header, rows...); err != nil {
+				return err
+			}
+			header = nil
+		}
+
+		size = 100
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(*interval):
+		}
+	}
+}
+
+// messagesAfter returns by ascending index the messages following index, the API
+// can't list from an index so the pages are read from the newest message back to
+// the last one seen. Without an index, -1, only the newest page of size is read.
+func messagesAfter(ctx context.Context, c *cli, serviceSid, channelSid string, index, size int) ([]chat.Message, error) {
+	var (
+		msgs   []chat.Message
+		params = chat.MessageListParams{ListParams: chat.ListParams{PageSize: size}, Order: "desc"}
+	)
+	for {
+		page, err := c.chat.Messages.List(ctx, serviceSid, channelSid, params)
+		if err != nil {
+			return nil, err
+		}
+
+		next, ok := page.Meta.Next()
+		for _, m := range page.Messages {
+			if m.Index <= index {
+				ok = false
+				break
+			}
+			msgs = append(msgs, m)
+		}
+		if !ok || index < 0 {
+			break
+		}
+		params.ListParams = next
+	}
+
+	for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+		msgs[i], msgs[j] = msgs[j], msgs[i]
+	}
+	return msgs, nil
+}
+
+// messagesExport writes the channel, its members and all its messages as an
+// archive, see chat/archive.
+func messagesExport(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("messages export", flag.ContinueOnError)
+	pos, err := parse(fs, args, 2, false)
+	if err != nil {
+		return err
+	}
+	return archive.NewExporter(c.chat).ExportChannel(ctx, c.out, pos[0], pos[1], 0)
+}
+
+func rolesList(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("roles ls", flag.ContinueOnError)
+	pos, err := parse(fs, args, 1, false)
+	if err != nil {
+		return err
+	}
+
+	var (
+		roles  []chat.Role
+		rows   [][]string
+		params chat.ListParams
+	)
+	for {
+		page, err := c.chat.Roles.List(ctx, pos[0], params)
+		if err != nil {
+			return err
+		}
+		for _, r := range page.Roles {
+			roles = append(roles, r)
+			rows = append(rows, roleRow(r))
+		}
+		next, ok := page.Meta.Next()
+		if !ok {
+			return c.print(roles, roleHeader, rows...)
+		}
+		params = next
+	}
+}
+
+func rolesCreate(ctx context.Context, c *cli, args []string) error {
+	var (
+		body        chat.RoleCreateParams
+		permissions stringsFlag
+		fs          = flag.NewFlagSet("roles create", flag.ContinueOnError)
+	)
+	fs.StringVar(&body.FriendlyName, "friendly-name", "", "role friendly name")
+	fs.StringVar(&body.Type, "type", "channel", "role type channel or deployment")
+	fs.Var(&permissions, "permission", "role permission, repeatable")
+	pos, err := parse(fs, args, 1, false)
+	if err != nil {
+		return err
+	}
+	body.Permission = permissions
+
+	r, err := c.chat.Roles.Create(ctx, pos[0], body)
+	if err != nil {
+		return err
+	}
+	return c.print(r, roleHeader, roleRow(r))
+}
+
+// attributes validates the JSON attributes given as flag.
+func attributes(s string) (json.RawMessage, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if !json.Valid([]byte(s)) {
+		return nil, errors.Errorf("invalid JSON attributes %s", s)
+	}
+	return json.RawMessage(s), nil
+}
@@ -0,0 +1,150 @@
+// Command twilio-chat administers Programmable Chat services.
+//
+// Credentials are loaded from the TWILIO_ACCOUNT_SID, TWILIO_API_KEY, TWILIO_API_SECRET_KEY
+// and TWILIO_API_REGION envs.
+//
+//	twilio-chat [-o table|json] <resource> <command> [flags] [args]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+	"github.com/smnalex/twilio-go/chat"
+)
+
+// command executes a resource command with the remaining args.
+type command func(ctx context.Context, c *cli, args []string) error
+
+var commands = map[string]map[string]command{
+	"services": {
+		"ls":      servicesList,
+		"get":     servicesGet,
+		"update":  servicesUpdate,
+		"migrate": servicesMigrate,
+	},
+	"channels": {
+		"ls":     channelsList,
+		"create": channelsCreate,
+		"rm":     channelsRemove,
+	},
+	"members": {
+		"add": membersAdd,
+		"rm":  membersRemove,
+	},
+	"messages": {
+		"send":   messagesSend,
+		"tail":   messagesTail,
+		"export": messagesExport,
+	},
+	"roles": {
+		"ls":     rolesList,
+		"create": rolesCreate,
+	},
+}
+
+type cli struct {
+	chat   chat.Chat
+	out    io.Writer
+	output string
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	if err := run(ctx, twilio.NewContext(), os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "twilio-chat:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, tctx twilio.Context, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("twilio-chat", flag.ContinueOnError)
+	fs.SetOutput(out)
+	output := fs.String("o", "table", "output format, table or json")
+	fs.Usage = func() { usage(out, fs) }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "table" && *output != "json" {
+		return errors.Errorf("unknown output format %q", *output)
+	}
+
+	args = fs.Args()
+	if len(args) < 2 {
+		fs.Usage()
+		return errors.New("missing resource or command")
+	}
+	cmd, ok := commands[args[0]][args[1]]
+	if !ok {
+		fs.Usage()
+		return errors.Errorf("unknown command %q", strings.Join(args[:2], " "))
+	}
+
+	if tctx.APIKey == "" || tctx.APISecret == "" {
+		return errors.New("TWILIO_API_KEY and TWILIO_API_SECRET_KEY must be set")
+	}
+	client, err := chat.New(tctx)
+	if err != nil {
+		return err
+	}
+	return cmd(ctx, &cli{chat: client, out: out, output: *output}, args[2:])
+}
+
+func usage(out io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintln(out, "usage: twilio-chat [-o table|json] <resource> <command> [flags] [args]")
+	fs.PrintDefaults()
+	fmt.Fprintln(out, "\ncommands:")
+
+	resources := make([]string, 0, len(commands))
+	for r := range commands {
+		resources = append(resources, r)
+	}
+	sort.Strings(resources)
+	for _, r := range resources {
+		cmds := make([]string, 0, len(commands[r]))
+		for c := range commands[r] {
+			cmds = append(cmds, c)
+		}
+		sort.Strings(cmds)
+		fmt.Fprintf(out, "  %s %s\n", r, strings.Join(cmds, "|"))
+	}
+}
+
+// parse parses the flags interleaved with the positional args, it fails
+// unless exactly n positional args are given or at least n with variadic.
+func parse(fs *flag.FlagSet, args []string, n int, variadic bool) ([]string, error) {
+	fs.SetOutput(ioutil.Discard)
+	var pos []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		pos = append(pos, args[0])
+		args = args[1:]
+	}
+
+	if len(pos) < n || (!variadic && len(pos) > n) {
+		return nil, errors.Errorf("%s: expected %d args, got %d", fs.Name(), n, len(pos))
+	}
+	return pos, nil
+}
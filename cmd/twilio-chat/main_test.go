@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+	"github.com/smnalex/twilio-go/chat"
+	"github.com/smnalex/twilio-go/chat/archive"
+)
+
+type requestHandlerFunc func(*http.Request) (*http.Response, error)
+
+func (fn requestHandlerFunc) Do(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+// fixture responds with a chat fixture and records the requests.
+func fixture(t *testing.T, name string, reqs *[]*http.Request) twilio.Context {
+	return twilio.NewContextWithHTTP("AC", "key", "secret", "", requestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+		*reqs = append(*reqs, r)
+		data, err := ioutil.ReadFile("../../chat/fixtures/" + name + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+	}))
+}
+
+func TestRun(t *testing.T) {
+	t.Run("services ls table", func(t *testing.T) {
+		var (
+			reqs []*http.Request
+			out  bytes.Buffer
+		)
+		if err := run(context.TODO(), fixture(t, "services", &reqs), []string{"services", "ls"}, &out); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := "SID                                 FRIENDLY NAME  DATE CREATED\n" +
+			"ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX  friendly_name  2015-07-30T20:00:00Z\n"
+		if got := out.String(); exp != got {
+			t.Errorf("exp output\n%s\ngot\n%s", exp, got)
+		}
+		if exp, got := "/v2/Services", reqs[0].URL.Path; exp != got {
+			t.Errorf("exp path %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("roles ls json", func(t *testing.T) {
+		var (
+			reqs []*http.Request
+			out  bytes.Buffer
+		)
+		if err := run(context.TODO(), fixture(t, "role", &reqs), []string{"-o", "json", "roles", "ls", "IS1"}, &out); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp, got := "null\n", out.String(); exp != got {
+			t.Errorf("exp output %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("channels create with flags after args", func(t *testing.T) {
+		var (
+			reqs []*http.Request
+			out  bytes.Buffer
+		)
+		args := []string{"channels", "create", "IS1", "-unique-name", "support", "-attributes", `{"a":1}`}
+		if err := run(context.TODO(), fixture(t, "channel", &reqs), args, &out); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		body, _ := ioutil.ReadAll(reqs[0].Body)
+		if exp := "Attributes=%7B%22a%22%3A1%7D&UniqueName=support"; string(body) != exp {
+			t.Errorf("exp body %s, got %s", exp, body)
+		}
+		if exp, got := "/v2/Services/IS1/Channels", reqs[0].URL.Path; exp != got {
+			t.Errorf("exp path %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("messages export", func(t *testing.T) {
+		var (
+			reqs []*http.Request
+			out  bytes.Buffer
+		)
+		tctx := twilio.NewContextWithHTTP("AC", "key", "secret", "", requestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+			reqs = append(reqs, r)
+			// the members and messages are listed by the sids of the channel fixture
+			name := "channel"
+			switch path.Base(r.URL.Path) {
+			case "Members":
+				name = "members"
+			case "Messages":
+				name = "messages"
+			}
+			data, err := ioutil.ReadFile("../../chat/fixtures/" + name + ".json")
+			if err != nil {
+				t.Fatal(err)
+			}
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+		}))
+		if err := run(context.TODO(), tctx, []string{"messages", "export", "IS1", "CH1"}, &out); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		var types []string
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			var rec archive.Record
+			if err := json.Unmarshal([]byte(line), &rec); err != nil {
+				t.Fatalf("exp archive record, got %s", line)
+			}
+			types = append(types, rec.Type)
+		}
+		if exp, got := "channel member members message", strings.Join(types, " "); exp != got {
+			t.Errorf("exp records %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("messages tail pages back to the last printed message", func(t *testing.T) {
+		var (
+			out         bytes.Buffer
+			ctx, cancel = context.WithCancel(context.TODO())
+			queries     []string
+			pages       = []string{
+				messagesPage(t, "", 4, 3),
+				// more messages than a page arrived between polls
+				messagesPage(t, "https://chat.twilio.com/v2/Services/IS1/Channels/CH1/Messages?Order=desc&PageSize=100&Page=1&PageToken=PT6", 8, 7),
+				messagesPage(t, "", 6, 5, 4, 3),
+			}
+		)
+		defer cancel()
+		tctx := twilio.NewContextWithHTTP("AC", "key", "secret", "", requestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+			queries = append(queries, r.URL.RawQuery)
+			if len(queries) == len(pages) {
+				cancel()
+			}
+			body := pages[len(queries)-1]
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}))
+
+		args := []string{"messages", "tail", "IS1", "CH1", "-n", "2", "-interval", "1ms"}
+		if err := run(ctx, tctx, args, &out); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		var indexes []string
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n")[1:] {
+			indexes = append(indexes, strings.Fields(line)[0])
+		}
+		if exp, got := "3 4 5 6 7 8", strings.Join(indexes, " "); exp != got {
+			t.Errorf("exp indexes %s, got %s", exp, got)
+		}
+		exp := []string{"Order=desc&PageSize=2", "Order=desc&PageSize=100", "Order=desc&Page=1&PageSize=100&PageToken=PT6"}
+		if strings.Join(exp, " ") != strings.Join(queries, " ") {
+			t.Errorf("exp queries %v, got %v", exp, queries)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var reqs []*http.Request
+		tt := map[string][]string{
+			"no command":       {"services"},
+			"unknown command":  {"services", "rm"},
+			"unknown output":   {"-o", "xml", "services", "ls"},
+			"missing args":     {"members", "add", "IS1", "CH1"},
+			"too many args":    {"services", "get", "IS1", "IS2"},
+			"migrate target":   {"services", "migrate", "IS1"},
+			"invalid attrs":    {"messages", "send", "IS1", "CH1", "-attributes", "{"},
+			"unknown cmd flag": {"roles", "ls", "IS1", "-all"},
+		}
+		for name, args := range tt {
+			if err := run(context.TODO(), fixture(t, "role", &reqs), args, ioutil.Discard); err == nil {
+				t.Errorf("%s: exp err, got none", name)
+			}
+		}
+		if len(reqs) != 0 {
+			t.Errorf("exp no requests, got %d", len(reqs))
+		}
+	})
+
+	t.Run("missing credentials", func(t *testing.T) {
+		tctx := twilio.Context{RequestHandler: http.DefaultClient}
+		if err := run(context.TODO(), tctx, []string{"services", "ls"}, ioutil.Discard); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+// messagesPage returns a page of messages ordered by descending index.
+func messagesPage(t *testing.T, next string, indexes ...int) string {
+	var page chat.MessageList
+	for _, i := range indexes {
+		page.Messages = append(page.Messages, chat.Message{Sid: "IM" + strconv.Itoa(i), Index: i, Body: "hi"})
+	}
+	page.Meta.NextPageURL = next
+	data, err := json.Marshal(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,100 @@
+package conversations
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ConfigurationResource handles interactions with the account, service and
+// webhook Configuration REST API.
+type ConfigurationResource struct {
+	configurationAPI
+}
+
+// Configuration of the account, applies to the conversations of the default service.
+type Configuration struct {
+	AccountSid                 string `json:"account_sid"`
+	DefaultChatServiceSid      string `json:"default_chat_service_sid"`
+	DefaultMessagingServiceSid string `json:"default_messaging_service_sid"`
+
+	// DefaultInactiveTimer and DefaultClosedTimer ISO-8601 durations.
+	DefaultInactiveTimer string `json:"default_inactive_timer"`
+	DefaultClosedTimer   string `json:"default_closed_timer"`
+	URL                  string `json:"url"`
+	Links                struct {
+		Webhooks string `json:"webhooks"`
+	} `json:"links"`
+}
+
+// ConfigurationUpdateParams holds information used in updating the account configuration.
+// https://www.twilio.com/docs/conversations/api/configuration-resource#update-a-configuration-resource
+type ConfigurationUpdateParams struct {
+	DefaultChatServiceSid      string `url:",omitempty"`
+	DefaultMessagingServiceSid string `url:",omitempty"`
+
+	// DefaultInactiveTimer and DefaultClosedTimer ISO-8601 durations, eg. `PT1H`.
+	DefaultInactiveTimer string `url:",omitempty"`
+	DefaultClosedTimer   string `url:",omitempty"`
+}
+
+func (cup ConfigurationUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(cup).Encode())
+}
+
+// ServiceConfiguration holds the default roles and the reachability of a service.
+type ServiceConfiguration struct {
+	ChatServiceSid                    string `json:"chat_service_sid"`
+	DefaultConversationCreatorRoleSid string `json:"default_conversation_creator_role_sid"`
+	DefaultConversationRoleSid        string `json:"default_conversation_role_sid"`
+	DefaultChatServiceRoleSid         string `json:"default_chat_service_role_sid"`
+	ReachabilityEnabled               bool   `json:"reachability_enabled"`
+	URL                               string `json:"url"`
+	Links                             struct {
+		Notifications string `json:"notifications"`
+	} `json:"links"`
+}
+
+// ServiceConfigurationUpdateParams holds information used in updating a service configuration.
+// https://www.twilio.com/docs/conversations/api/service-configuration-resource#update-a-serviceconfiguration-resource
+type ServiceConfigurationUpdateParams struct {
+	DefaultConversationCreatorRoleSid string `url:",omitempty"`
+	DefaultConversationRoleSid        string `url:",omitempty"`
+	DefaultChatServiceRoleSid         string `url:",omitempty"`
+
+	// ReachabilityEnabled nil leaves the reachability unchanged.
+	ReachabilityEnabled *bool `url:",omitempty"`
+}
+
+func (scup ServiceConfigurationUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scup).Encode())
+}
+
+// WebhookSettings holds the webhooks called on the events of the account or of a service.
+type WebhookSettings struct {
+	AccountSid     string `json:"account_sid"`
+	ChatServiceSid string `json:"chat_service_sid"`
+
+	// Target can be webhook or flex, account webhooks only.
+	Target         string   `json:"target"`
+	Method         string   `json:"method"`
+	Filters        []string `json:"filters"`
+	PreWebhookURL  string   `json:"pre_webhook_url"`
+	PostWebhookURL string   `json:"post_webhook_url"`
+	URL            string   `json:"url"`
+}
+
+// WebhookSettingsUpdateParams holds information used in updating the webhook settings.
+// https://www.twilio.com/docs/conversations/api/webhook-configuration-resource#update-a-configurationwebhook-resource
+type WebhookSettingsUpdateParams struct {
+	Target         string   `url:",omitempty"`
+	Method         string   `url:",omitempty"`
+	Filters        []string `url:",omitempty"`
+	PreWebhookURL  string   `url:"PreWebhookUrl,omitempty"`
+	PostWebhookURL string   `url:"PostWebhookUrl,omitempty"`
+}
+
+func (wsup WebhookSettingsUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(wsup).Encode())
+}
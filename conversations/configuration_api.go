@@ -0,0 +1,62 @@
+package conversations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type configurationAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Configuration
+// https://www.twilio.com/docs/conversations/api/configuration-resource#fetch-a-configuration-resource
+func (api configurationAPI) Read(ctx context.Context) (Configuration, error) {
+	var conf Configuration
+	err := twilio.GetInto(ctx, api.client, "/Configuration", &conf)
+	return conf, err
+}
+
+// POST /Configuration
+// https://www.twilio.com/docs/conversations/api/configuration-resource#update-a-configuration-resource
+func (api configurationAPI) Update(ctx context.Context, body ConfigurationUpdateParams) (Configuration, error) {
+	var conf Configuration
+	err := twilio.PostInto(ctx, api.client, "/Configuration", body.encode(), &conf)
+	return conf, err
+}
+
+// GET /Services/{Service SID}/Configuration
+// https://www.twilio.com/docs/conversations/api/service-configuration-resource#fetch-a-serviceconfiguration-resource
+func (api configurationAPI) ReadService(ctx context.Context, serviceSid string) (ServiceConfiguration, error) {
+	var conf ServiceConfiguration
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Configuration", serviceSid), &conf)
+	return conf, err
+}
+
+// POST /Services/{Service SID}/Configuration
+// https://www.twilio.com/docs/conversations/api/service-configuration-resource#update-a-serviceconfiguration-resource
+func (api configurationAPI) UpdateService(ctx context.Context, serviceSid string, body ServiceConfigurationUpdateParams) (ServiceConfiguration, error) {
+	var conf ServiceConfiguration
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Configuration", serviceSid), body.encode(), &conf)
+	return conf, err
+}
+
+// GET /Configuration/Webhooks
+// GET /Services/{Service SID}/Configuration/Webhooks
+// https://www.twilio.com/docs/conversations/api/webhook-configuration-resource#fetch-a-configurationwebhook-resource
+func (api configurationAPI) ReadWebhooks(ctx context.Context, serviceSid string) (WebhookSettings, error) {
+	var hooks WebhookSettings
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Configuration/Webhooks"), &hooks)
+	return hooks, err
+}
+
+// POST /Configuration/Webhooks
+// POST /Services/{Service SID}/Configuration/Webhooks
+// https://www.twilio.com/docs/conversations/api/webhook-configuration-resource#update-a-configurationwebhook-resource
+func (api configurationAPI) UpdateWebhooks(ctx context.Context, serviceSid string, body WebhookSettingsUpdateParams) (WebhookSettings, error) {
+	var hooks WebhookSettings
+	err := twilio.PostInto(ctx, api.client, scoped(serviceSid, "/Configuration/Webhooks"), body.encode(), &hooks)
+	return hooks, err
+}
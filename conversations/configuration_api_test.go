@@ -0,0 +1,235 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfigurationRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Configuration"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/configuration.json")
+		}
+
+		var (
+			exp  Configuration
+			f, _ = os.Open("fixtures/configuration.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (configurationAPI{client}).Read(context.TODO())
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (configurationAPI{client}).Read(ctx)
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConfigurationUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("DefaultClosedTimer=PT10M")
+			)
+
+			if exp := "/Configuration"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/configuration.json")
+		}
+
+		var (
+			exp  Configuration
+			f, _ = os.Open("fixtures/configuration.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (configurationAPI{client}).Update(context.TODO(), ConfigurationUpdateParams{DefaultClosedTimer: "PT10M"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (configurationAPI{client}).Update(ctx, ConfigurationUpdateParams{DefaultClosedTimer: "PT10M"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestConfigurationReadService(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Configuration"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/service_configuration.json")
+		}
+
+		var (
+			exp  ServiceConfiguration
+			f, _ = os.Open("fixtures/service_configuration.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (configurationAPI{client}).ReadService(context.TODO(), "IS1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (configurationAPI{client}).ReadService(ctx, "IS1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConfigurationUpdateService(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("ReachabilityEnabled=false")
+			)
+
+			if exp := "/Services/IS1/Configuration"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/service_configuration.json")
+		}
+
+		var (
+			exp  ServiceConfiguration
+			f, _ = os.Open("fixtures/service_configuration.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (configurationAPI{client}).UpdateService(context.TODO(), "IS1", ServiceConfigurationUpdateParams{ReachabilityEnabled: new(bool)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (configurationAPI{client}).UpdateService(ctx, "IS1", ServiceConfigurationUpdateParams{ReachabilityEnabled: new(bool)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestConfigurationReadWebhooks(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Configuration/Webhooks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/webhook_settings.json")
+		}
+
+		var (
+			exp  WebhookSettings
+			f, _ = os.Open("fixtures/webhook_settings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hooks, err := (configurationAPI{client}).ReadWebhooks(context.TODO(), "")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hooks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hooks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (configurationAPI{client}).ReadWebhooks(ctx, "")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConfigurationUpdateWebhooks(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("PostWebhookUrl=https%3A%2F%2Fexample.com%2Fpost")
+			)
+
+			if exp := "/Services/IS1/Configuration/Webhooks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/webhook_settings.json")
+		}
+
+		var (
+			exp  WebhookSettings
+			f, _ = os.Open("fixtures/webhook_settings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hooks, err := (configurationAPI{client}).UpdateWebhooks(context.TODO(), "IS1", WebhookSettingsUpdateParams{PostWebhookURL: "https://example.com/post"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hooks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hooks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (configurationAPI{client}).UpdateWebhooks(ctx, "IS1", WebhookSettingsUpdateParams{PostWebhookURL: "https://example.com/post"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
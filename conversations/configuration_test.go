@@ -0,0 +1,12 @@
+package conversations
+
+import "testing"
+
+func TestConfigurationParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("UpdateParams", optionalsFn(ConfigurationUpdateParams{}, exp))
+	exp = []byte("")
+	t.Run("ServiceUpdateParams", optionalsFn(ServiceConfigurationUpdateParams{}, exp))
+	exp = []byte("")
+	t.Run("WebhooksUpdateParams", optionalsFn(WebhookSettingsUpdateParams{}, exp))
+}
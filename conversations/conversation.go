@@ -0,0 +1,123 @@
+package conversations
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ConversationResource handles interactions with Conversations REST API.
+type ConversationResource struct {
+	conversationAPI
+}
+
+// Conversation is a thread of messages between chat and messaging participants.
+type Conversation struct {
+	Sid                 string          `json:"sid"`
+	AccountSid          string          `json:"account_sid"`
+	ChatServiceSid      string          `json:"chat_service_sid"`
+	MessagingServiceSid string          `json:"messaging_service_sid"`
+	FriendlyName        string          `json:"friendly_name"`
+	UniqueName          string          `json:"unique_name"`
+	Attributes          json.RawMessage `json:"attributes"`
+
+	// State can be active, inactive or closed.
+	State string `json:"state"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	Timers      Timers `json:"timers"`
+	URL         string `json:"url"`
+	Links       struct {
+		Participants string `json:"participants"`
+		Messages     string `json:"messages"`
+		Webhooks     string `json:"webhooks"`
+	} `json:"links"`
+}
+
+// Timers holds the dates a conversation becomes inactive and closed, ISO-8601 format.
+type Timers struct {
+	DateInactive string `json:"date_inactive"`
+	DateClosed   string `json:"date_closed"`
+}
+
+// ConversationList holds a page of conversations.
+type ConversationList struct {
+	Conversations []Conversation `json:"conversations"`
+	Meta          Meta           `json:"meta"`
+}
+
+// ConversationListParams holds information used in listing conversations.
+type ConversationListParams struct {
+	ListParams
+
+	// StartDate and EndDate filter by the date of creation, ISO-8601 format.
+	StartDate string `url:",omitempty"`
+	EndDate   string `url:",omitempty"`
+
+	// State filters by active, inactive or closed conversations.
+	State string `url:",omitempty"`
+}
+
+func (clp ConversationListParams) query() string {
+	return twilio.Query(clp)
+}
+
+// ConversationCreateParams holds information used in creating a new conversation.
+// https://www.twilio.com/docs/conversations/api/conversation-resource#create-a-conversation-resource
+type ConversationCreateParams struct {
+	FriendlyName        string          `url:",omitempty"`
+	UniqueName          string          `url:",omitempty"`
+	MessagingServiceSid string          `url:",omitempty"`
+	Attributes          json.RawMessage `url:",omitempty"`
+
+	// DateCreated ISO-8601 format. Default current time.
+	DateCreated string `url:",omitempty"`
+
+	// DateUpdated ISO-8601 format. Default null.
+	DateUpdated string `url:",omitempty"`
+
+	// State can be active, inactive or closed. Default active.
+	State string `url:",omitempty"`
+
+	// TimersInactive and TimersClosed ISO-8601 durations after which the
+	// conversation becomes inactive and closed, eg. `PT1H`.
+	TimersInactive string `url:"Timers.Inactive,omitempty"`
+	TimersClosed   string `url:"Timers.Closed,omitempty"`
+}
+
+func (ccp ConversationCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ccp).Encode())
+}
+
+// ConversationUpdateParams holds information used in updating an existing conversation.
+// https://www.twilio.com/docs/conversations/api/conversation-resource#update-conversation
+type ConversationUpdateParams struct {
+	FriendlyName        string          `url:",omitempty"`
+	UniqueName          string          `url:",omitempty"`
+	MessagingServiceSid string          `url:",omitempty"`
+	Attributes          json.RawMessage `url:",omitempty"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `url:",omitempty"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `url:",omitempty"`
+
+	// State can be active, inactive or closed.
+	State string `url:",omitempty"`
+
+	// TimersInactive and TimersClosed ISO-8601 durations after which the
+	// conversation becomes inactive and closed, eg. `PT1H`.
+	TimersInactive string `url:"Timers.Inactive,omitempty"`
+	TimersClosed   string `url:"Timers.Closed,omitempty"`
+}
+
+func (cup ConversationUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(cup).Encode())
+}
@@ -0,0 +1,56 @@
+package conversations
+
+import (
+	"context"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type conversationAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Conversations/{Conversation SID}
+// GET /Conversations/{Unique Name}
+// https://www.twilio.com/docs/conversations/api/conversation-resource#fetch-a-conversation-resource
+func (api conversationAPI) Read(ctx context.Context, serviceSid, identity string) (Conversation, error) {
+	var conv Conversation
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations/%s", identity), &conv)
+	return conv, err
+}
+
+// GET /Conversations
+// https://www.twilio.com/docs/conversations/api/conversation-resource#read-multiple-conversation-resources
+func (api conversationAPI) List(ctx context.Context, serviceSid string, params ConversationListParams) (ConversationList, error) {
+	var convs ConversationList
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations%s", params.query()), &convs)
+	return convs, err
+}
+
+// POST /Conversations
+// https://www.twilio.com/docs/conversations/api/conversation-resource#create-a-conversation-resource
+func (api conversationAPI) Create(ctx context.Context, serviceSid string, body ConversationCreateParams) (Conversation, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations"), body.encode())
+}
+
+// POST /Conversations/{Conversation SID}
+// POST /Conversations/{Unique Name}
+// https://www.twilio.com/docs/conversations/api/conversation-resource#update-conversation
+func (api conversationAPI) Update(ctx context.Context, serviceSid, identity string, body ConversationUpdateParams) (Conversation, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations/%s", identity), body.encode())
+}
+
+// DELETE /Conversations/{Conversation SID}
+// DELETE /Conversations/{Unique Name}
+// https://www.twilio.com/docs/conversations/api/conversation-resource#delete-a-conversation-resource
+func (api conversationAPI) Delete(ctx context.Context, serviceSid, identity string) error {
+	_, err := api.client.Delete(ctx, scoped(serviceSid, "/Conversations/%s", identity))
+	return err
+}
+
+func (api conversationAPI) post(ctx context.Context, path string, body io.Reader) (Conversation, error) {
+	var conv Conversation
+	err := twilio.PostInto(ctx, api.client, path, body, &conv)
+	return conv, err
+}
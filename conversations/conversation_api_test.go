@@ -0,0 +1,188 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConversationRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/conversation.json")
+		}
+
+		var (
+			exp  Conversation
+			f, _ = os.Open("fixtures/conversation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conv, err := (conversationAPI{client}).Read(context.TODO(), "", "CH1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conv) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conv))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conversationAPI{client}).Read(ctx, "", "CH1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConversationList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Conversations?State=active"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/conversations.json")
+		}
+
+		var (
+			exp  ConversationList
+			f, _ = os.Open("fixtures/conversations.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		convs, err := (conversationAPI{client}).List(context.TODO(), "sid", ConversationListParams{State: "active"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, convs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, convs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conversationAPI{client}).List(ctx, "sid", ConversationListParams{State: "active"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConversationCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Timers.Closed=PT1H&UniqueName=support")
+			)
+
+			if exp := "/Conversations"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/conversation.json")
+		}
+
+		var (
+			exp  Conversation
+			f, _ = os.Open("fixtures/conversation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conv, err := (conversationAPI{client}).Create(context.TODO(), "", ConversationCreateParams{UniqueName: "support", TimersClosed: "PT1H"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conv) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conv))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conversationAPI{client}).Create(ctx, "", ConversationCreateParams{UniqueName: "support", TimersClosed: "PT1H"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestConversationUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("State=closed")
+			)
+
+			if exp := "/Services/sid/Conversations/CH1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/conversation.json")
+		}
+
+		var (
+			exp  Conversation
+			f, _ = os.Open("fixtures/conversation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conv, err := (conversationAPI{client}).Update(context.TODO(), "sid", "CH1", ConversationUpdateParams{State: "closed"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conv) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conv))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conversationAPI{client}).Update(ctx, "sid", "CH1", ConversationUpdateParams{State: "closed"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestConversationDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (conversationAPI{client}).Delete(context.TODO(), "", "CH1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (conversationAPI{client}).Delete(ctx, "", "CH1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
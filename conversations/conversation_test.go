@@ -0,0 +1,17 @@
+package conversations
+
+import "testing"
+
+func TestConversationParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("CreateParams", optionalsFn(ConversationCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(ConversationUpdateParams{}, exp))
+}
+
+func TestConversationListParams(t *testing.T) {
+	params := ConversationListParams{ListParams: ListParams{PageSize: 10}, StartDate: "2020-01-01"}
+	if exp, got := "?PageSize=10&StartDate=2020-01-01", params.query(); exp != got {
+		t.Errorf("exp query %s, got %s", exp, got)
+	}
+}
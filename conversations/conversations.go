@@ -0,0 +1,78 @@
+// Package conversations is a client of the Twilio Conversations API, the
+// successor of Programmable Chat.
+//
+// Service scoped resources take the sid of the conversation service as their
+// first argument, an empty sid targets the default conversation service of
+// the account.
+package conversations
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Conversations api interface
+type Conversations struct {
+	Conversations ConversationResource
+	Participants  ParticipantResource
+	Messages      MessageResource
+	Webhooks      WebhookResource
+	Users         UserResource
+	Roles         RoleResource
+	Credentials   CredentialResource
+	Services      ServiceResource
+	Configuration ConfigurationResource
+}
+
+// New returns a conversations instance with a base url set to `https://conversations.twilio.com/v1`
+// if `TWILIO_CONVERSATIONS_HOST` not set.
+func New(tctx twilio.Context) (Conversations, error) {
+	var conversations Conversations
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		conversationsEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return conversations, err
+	}
+
+	{
+		conversations.Conversations = ConversationResource{conversationAPI{client}}
+		conversations.Participants = ParticipantResource{participantAPI{client}}
+		conversations.Messages = MessageResource{messageAPI{client}}
+		conversations.Webhooks = WebhookResource{webhookAPI{client}}
+		conversations.Users = UserResource{userAPI{client}}
+		conversations.Roles = RoleResource{roleAPI{client}}
+		conversations.Credentials = CredentialResource{credentialAPI{client}}
+		conversations.Services = ServiceResource{serviceAPI{client}}
+		conversations.Configuration = ConfigurationResource{configurationAPI{client}}
+	}
+	return conversations, nil
+}
+
+func conversationsEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_CONVERSATIONS_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://conversations.%s.twilio.com/v1", region)
+	} else if url == "" {
+		return "https://conversations.twilio.com/v1"
+	}
+	return url
+}
+
+// scoped prefixes the path with the conversation service, an empty service sid
+// targets the default conversation service.
+func scoped(serviceSid, format string, a ...interface{}) string {
+	path := fmt.Sprintf(format, a...)
+	if serviceSid == "" {
+		return path
+	}
+	return "/Services/" + serviceSid + path
+}
@@ -0,0 +1,62 @@
+package conversations
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("unsuccessful invalid env url", func(t *testing.T) {
+		os.Setenv("TWILIO_CONVERSATIONS_HOST", "%2")
+		if _, err := New(twilio.Context{}); err == nil {
+			t.Errorf("exp parsing err, got none")
+		}
+		os.Unsetenv("TWILIO_CONVERSATIONS_HOST")
+	})
+
+	t.Run("conversations services", func(t *testing.T) {
+		_, err := New(twilio.Context{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestConversationsEndpoint(t *testing.T) {
+	exp := "https://conversations.twilio.com/v1"
+
+	t.Run("default url", func(*testing.T) {
+		if got := conversationsEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("default url with region", func(*testing.T) {
+		exp := "https://conversations.uk.twilio.com/v1"
+		if got := conversationsEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("env url", func(*testing.T) {
+		os.Setenv("TWILIO_CONVERSATIONS_HOST", exp)
+		if got := conversationsEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		if got := conversationsEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		os.Unsetenv("TWILIO_CONVERSATIONS_HOST")
+	})
+}
+
+func TestScoped(t *testing.T) {
+	if exp, got := "/Conversations/CH1", scoped("", "/Conversations/%s", "CH1"); exp != got {
+		t.Errorf("exp path %s, got %s", exp, got)
+	}
+	if exp, got := "/Services/IS1/Conversations/CH1", scoped("IS1", "/Conversations/%s", "CH1"); exp != got {
+		t.Errorf("exp path %s, got %s", exp, got)
+	}
+}
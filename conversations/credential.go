@@ -0,0 +1,68 @@
+package conversations
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// CredentialResource handles interactions with Conversation Credentials REST API.
+type CredentialResource struct {
+	credentialAPI
+}
+
+// Credential holds the secrets of a push notifications channel, APNS, FCM and GCM
+// types are supported.
+type Credential struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+	Type         string `json:"type"`
+	Sandbox      string `json:"sandbox"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// CredentialList holds a page of credentials.
+type CredentialList struct {
+	Credentials []Credential `json:"credentials"`
+	Meta        Meta         `json:"meta"`
+}
+
+// CredentialCreateParams holds information used in creating a new credential.
+// https://www.twilio.com/docs/conversations/api/credential-resource#create-a-credential-resource
+type CredentialCreateParams struct {
+	Type         string
+	FriendlyName string `url:",omitempty"`
+	Certificate  string `url:",omitempty"`
+	PrivateKey   string `url:",omitempty"`
+	Sandbox      bool   `url:",omitempty"`
+	APIKey       string `url:"ApiKey,omitempty"`
+	Secret       string `url:",omitempty"`
+}
+
+func (ccp CredentialCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ccp).Encode())
+}
+
+// CredentialUpdateParams holds information used in updating an existing credential.
+// https://www.twilio.com/docs/conversations/api/credential-resource#update-a-credential-resource
+type CredentialUpdateParams struct {
+	Type         string `url:",omitempty"`
+	FriendlyName string `url:",omitempty"`
+	Certificate  string `url:",omitempty"`
+	PrivateKey   string `url:",omitempty"`
+	Sandbox      bool   `url:",omitempty"`
+	APIKey       string `url:"ApiKey,omitempty"`
+	Secret       string `url:",omitempty"`
+}
+
+func (cup CredentialUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(cup).Encode())
+}
@@ -0,0 +1,54 @@
+package conversations
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type credentialAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Credentials/{Credential SID}
+// https://www.twilio.com/docs/conversations/api/credential-resource#fetch-a-credential-resource
+func (api credentialAPI) Read(ctx context.Context, credentialSid string) (Credential, error) {
+	var cred Credential
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Credentials/%s", credentialSid), &cred)
+	return cred, err
+}
+
+// GET /Credentials
+// https://www.twilio.com/docs/conversations/api/credential-resource#read-multiple-credential-resources
+func (api credentialAPI) List(ctx context.Context, params ListParams) (CredentialList, error) {
+	var creds CredentialList
+	err := twilio.GetInto(ctx, api.client, "/Credentials"+twilio.Query(params), &creds)
+	return creds, err
+}
+
+// POST /Credentials
+// https://www.twilio.com/docs/conversations/api/credential-resource#create-a-credential-resource
+func (api credentialAPI) Create(ctx context.Context, body CredentialCreateParams) (Credential, error) {
+	return api.post(ctx, "/Credentials", body.encode())
+}
+
+// POST /Credentials/{Credential SID}
+// https://www.twilio.com/docs/conversations/api/credential-resource#update-a-credential-resource
+func (api credentialAPI) Update(ctx context.Context, credentialSid string, body CredentialUpdateParams) (Credential, error) {
+	return api.post(ctx, fmt.Sprintf("/Credentials/%s", credentialSid), body.encode())
+}
+
+// DELETE /Credentials/{Credential SID}
+// https://www.twilio.com/docs/conversations/api/credential-resource#delete-a-credential-resource
+func (api credentialAPI) Delete(ctx context.Context, credentialSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Credentials/%s", credentialSid))
+	return err
+}
+
+func (api credentialAPI) post(ctx context.Context, path string, body io.Reader) (Credential, error) {
+	var cred Credential
+	err := twilio.PostInto(ctx, api.client, path, body, &cred)
+	return cred, err
+}
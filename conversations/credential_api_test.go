@@ -0,0 +1,188 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCredentialRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Credentials/CR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/credential.json")
+		}
+
+		var (
+			exp  Credential
+			f, _ = os.Open("fixtures/credential.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		cred, err := (credentialAPI{client}).Read(context.TODO(), "CR1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, cred) {
+			t.Errorf("response diff %v", cmp.Diff(exp, cred))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (credentialAPI{client}).Read(ctx, "CR1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCredentialList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Credentials?PageSize=5"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/credentials.json")
+		}
+
+		var (
+			exp  CredentialList
+			f, _ = os.Open("fixtures/credentials.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		creds, err := (credentialAPI{client}).List(context.TODO(), ListParams{PageSize: 5})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, creds) {
+			t.Errorf("response diff %v", cmp.Diff(exp, creds))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (credentialAPI{client}).List(ctx, ListParams{PageSize: 5})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCredentialCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Secret=secret&Type=fcm")
+			)
+
+			if exp := "/Credentials"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/credential.json")
+		}
+
+		var (
+			exp  Credential
+			f, _ = os.Open("fixtures/credential.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		cred, err := (credentialAPI{client}).Create(context.TODO(), CredentialCreateParams{Type: "fcm", Secret: "secret"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, cred) {
+			t.Errorf("response diff %v", cmp.Diff(exp, cred))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (credentialAPI{client}).Create(ctx, CredentialCreateParams{Type: "fcm", Secret: "secret"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCredentialUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("")
+			)
+
+			if exp := "/Credentials/CR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/credential.json")
+		}
+
+		var (
+			exp  Credential
+			f, _ = os.Open("fixtures/credential.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		cred, err := (credentialAPI{client}).Update(context.TODO(), "CR1", CredentialUpdateParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, cred) {
+			t.Errorf("response diff %v", cmp.Diff(exp, cred))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (credentialAPI{client}).Update(ctx, "CR1", CredentialUpdateParams{})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCredentialDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Credentials/CR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (credentialAPI{client}).Delete(context.TODO(), "CR1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (credentialAPI{client}).Delete(ctx, "CR1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,10 @@
+package conversations
+
+import "testing"
+
+func TestCredentialParamsOptionals(t *testing.T) {
+	exp := []byte("Type=")
+	t.Run("CreateParams", optionalsFn(CredentialCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(CredentialUpdateParams{}, exp))
+}
@@ -0,0 +1,55 @@
+// Package fromchat maps Programmable Chat resources onto their Conversations
+// equivalents and verifies a service after Twilio's Chat to Conversations import.
+package fromchat
+
+import (
+	"github.com/smnalex/twilio-go/chat"
+	"github.com/smnalex/twilio-go/chat/archive"
+	"github.com/smnalex/twilio-go/conversations"
+)
+
+// Conversation returns the params recreating the channel as a conversation.
+func Conversation(chn chat.Channel) conversations.ConversationCreateParams {
+	return conversations.ConversationCreateParams{
+		FriendlyName: chn.FriendlyName,
+		UniqueName:   chn.UniqueName,
+		Attributes:   archive.Attributes(chn.Attributes),
+		DateCreated:  chn.DateCreated,
+		DateUpdated:  chn.DateUpdated,
+	}
+}
+
+// Participant returns the params adding the member as a chat participant, roles
+// maps the chat role sids to the conversation role sids.
+func Participant(mem chat.Member, roles map[string]string) conversations.ParticipantCreateParams {
+	return conversations.ParticipantCreateParams{
+		Identity:    mem.Identity,
+		DateCreated: mem.DateCreated,
+		DateUpdated: mem.DateUpdated,
+		Attributes:  archive.Attributes(mem.Attributes),
+		RoleSid:     roles[mem.RoleSid],
+	}
+}
+
+// Message returns the params sending the message to a conversation, the sender
+// of the chat message becomes the author.
+func Message(msg chat.Message) conversations.MessageCreateParams {
+	return conversations.MessageCreateParams{
+		Author:      msg.From,
+		Body:        msg.Body,
+		DateCreated: msg.DateCreated,
+		DateUpdated: msg.DateUpdated,
+		Attributes:  archive.Attributes(msg.Attributes),
+	}
+}
+
+// User returns the params recreating the user, roles maps the chat role sids to
+// the conversation role sids.
+func User(usr chat.User, roles map[string]string) conversations.UserCreateParams {
+	return conversations.UserCreateParams{
+		Identity:     usr.Identity,
+		FriendlyName: usr.FriendlyName,
+		Attributes:   archive.Attributes(usr.Attributes),
+		RoleSid:      roles[usr.RoleSID],
+	}
+}
@@ -0,0 +1,47 @@
+package fromchat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/smnalex/twilio-go/chat"
+	"github.com/smnalex/twilio-go/conversations"
+)
+
+func TestMappings(t *testing.T) {
+	roles := map[string]string{"RLchat": "RLconv"}
+	attrs := json.RawMessage(`"{\"a\":1}"`)
+
+	t.Run("conversation", func(t *testing.T) {
+		got := Conversation(chat.Channel{FriendlyName: "Support", UniqueName: "support", Attributes: attrs, DateCreated: "2020-01-01T00:00:00Z"})
+		exp := conversations.ConversationCreateParams{FriendlyName: "Support", UniqueName: "support", Attributes: json.RawMessage(`{"a":1}`), DateCreated: "2020-01-01T00:00:00Z"}
+		if !cmp.Equal(exp, got) {
+			t.Errorf("params diff %v", cmp.Diff(exp, got))
+		}
+	})
+
+	t.Run("participant", func(t *testing.T) {
+		got := Participant(chat.Member{Identity: "alice", RoleSid: "RLchat", Attributes: attrs}, roles)
+		exp := conversations.ParticipantCreateParams{Identity: "alice", RoleSid: "RLconv", Attributes: json.RawMessage(`{"a":1}`)}
+		if !cmp.Equal(exp, got) {
+			t.Errorf("params diff %v", cmp.Diff(exp, got))
+		}
+	})
+
+	t.Run("message", func(t *testing.T) {
+		got := Message(chat.Message{From: "alice", Body: "hi", Attributes: json.RawMessage("null")})
+		exp := conversations.MessageCreateParams{Author: "alice", Body: "hi"}
+		if !cmp.Equal(exp, got) {
+			t.Errorf("params diff %v", cmp.Diff(exp, got))
+		}
+	})
+
+	t.Run("user", func(t *testing.T) {
+		got := User(chat.User{Identity: "alice", FriendlyName: "Alice", RoleSID: "RLother"}, roles)
+		exp := conversations.UserCreateParams{Identity: "alice", FriendlyName: "Alice"}
+		if !cmp.Equal(exp, got) {
+			t.Errorf("params diff %v", cmp.Diff(exp, got))
+		}
+	})
+}
@@ -0,0 +1,292 @@
+package fromchat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+	"github.com/smnalex/twilio-go/chat"
+	"github.com/smnalex/twilio-go/chat/archive"
+	"github.com/smnalex/twilio-go/conversations"
+)
+
+// ConversationReader reads conversations, satisfied by conversations.ConversationResource.
+type ConversationReader interface {
+	Read(ctx context.Context, serviceSid, identity string) (conversations.Conversation, error)
+}
+
+// ParticipantLister lists participants, satisfied by conversations.ParticipantResource.
+type ParticipantLister interface {
+	List(ctx context.Context, serviceSid, conversationSid string, params conversations.ListParams) (conversations.ParticipantList, error)
+}
+
+// MessageLister lists messages, satisfied by conversations.MessageResource.
+type MessageLister interface {
+	List(ctx context.Context, serviceSid, conversationSid string, params conversations.MessageListParams) (conversations.MessageList, error)
+}
+
+// Verifier compares the channels of a chat service with the conversations
+// imported from them.
+type Verifier struct {
+	Channels archive.ChannelLister
+	Members  archive.MemberLister
+	Messages archive.MessageLister
+
+	Conversations        ConversationReader
+	Participants         ParticipantLister
+	ConversationMessages MessageLister
+}
+
+// NewVerifier returns a Verifier using the chat and conversations client resources.
+func NewVerifier(c chat.Chat, conv conversations.Conversations) Verifier {
+	return Verifier{
+		Channels:             c.Channels,
+		Members:              c.Members,
+		Messages:             c.Messages,
+		Conversations:        conv.Conversations,
+		Participants:         conv.Participants,
+		ConversationMessages: conv.Messages,
+	}
+}
+
+// Diff fields.
+const (
+	FieldConversation      = "conversation"
+	FieldAttributes        = "attributes"
+	FieldMessages          = "messages"
+	FieldMembers           = "members"
+	FieldMemberAttributes  = "member attributes"
+	FieldMessageAttributes = "message attributes"
+)
+
+// Diff is a difference between a channel and its conversation.
+type Diff struct {
+	ChannelSid string
+	Field      string
+
+	// Key identifies the member identity or message index of the field, if any.
+	Key           string
+	Chat          string
+	Conversations string
+}
+
+func (d Diff) String() string {
+	field := d.Field
+	if d.Key != "" {
+		field += " " + d.Key
+	}
+	return fmt.Sprintf("%s %s: chat %s, conversations %s", d.ChannelSid, field, d.Chat, d.Conversations)
+}
+
+// Report holds the differences found by a verification.
+type Report struct {
+	Channels int
+	Diffs    []Diff
+}
+
+// OK reports whether every channel matches its conversation.
+func (r Report) OK() bool {
+	return len(r.Diffs) == 0
+}
+
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d channels verified, %d differences\n", r.Channels, len(r.Diffs))
+	for _, d := range r.Diffs {
+		fmt.Fprintln(&b, d)
+	}
+	return b.String()
+}
+
+// Verify compares every channel of the chat service with its conversation in the
+// conversation service, an empty conversation service sid targets the default
+// service. Twilio's import keeps the channel sids, conversations are looked up
+// by channel sid and then by unique name.
+func (v Verifier) Verify(ctx context.Context, chatServiceSid, conversationServiceSid string) (Report, error) {
+	var (
+		report Report
+		params chat.ChannelListParams
+	)
+	for {
+		page, err := v.Channels.List(ctx, chatServiceSid, params)
+		if err != nil {
+			return report, errors.Wrap(err, "fromchat: could not list channels")
+		}
+		for _, chn := range page.Channels {
+			diffs, err := v.VerifyChannel(ctx, chn, conversationServiceSid)
+			if err != nil {
+				return report, err
+			}
+			report.Channels++
+			report.Diffs = append(report.Diffs, diffs...)
+		}
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return report, nil
+		}
+		params.ListParams = next
+	}
+}
+
+// VerifyChannel compares the channel attributes, member identities and attributes
+// and message count and attributes with its conversation.
+func (v Verifier) VerifyChannel(ctx context.Context, chn chat.Channel, conversationServiceSid string) ([]Diff, error) {
+	conv, err := v.Conversations.Read(ctx, conversationServiceSid, chn.Sid)
+	if twilio.IsNotFound(err) && chn.UniqueName != "" {
+		conv, err = v.Conversations.Read(ctx, conversationServiceSid, chn.UniqueName)
+	}
+	if twilio.IsNotFound(err) {
+		return []Diff{{ChannelSid: chn.Sid, Field: FieldConversation, Chat: chn.Sid, Conversations: "missing"}}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "fromchat: could not read conversation of %s", chn.Sid)
+	}
+
+	var diffs []Diff
+	if !twilio.AttributesEqual(chn.Attributes, conv.Attributes) {
+		diffs = append(diffs, Diff{ChannelSid: chn.Sid, Field: FieldAttributes, Chat: string(chn.Attributes), Conversations: string(conv.Attributes)})
+	}
+
+	memberDiffs, err := v.verifyMembers(ctx, chn, conversationServiceSid, conv.Sid)
+	if err != nil {
+		return nil, err
+	}
+	messageDiffs, err := v.verifyMessages(ctx, chn, conversationServiceSid, conv.Sid)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(diffs, memberDiffs...), messageDiffs...), nil
+}
+
+func (v Verifier) verifyMembers(ctx context.Context, chn chat.Channel, serviceSid, conversationSid string) ([]Diff, error) {
+	members := make(map[string]json.RawMessage)
+	var params chat.MemberListParams
+	for {
+		page, err := v.Members.List(ctx, chn.ServiceSid, chn.Sid, params)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fromchat: could not list members of %s", chn.Sid)
+		}
+		for _, mem := range page.Members {
+			members[mem.Identity] = mem.Attributes
+		}
+		next, ok := page.Meta.Next()
+		if !ok {
+			break
+		}
+		params.ListParams = next
+	}
+
+	// SMS and WhatsApp participants have no chat member.
+	participants := make(map[string]json.RawMessage)
+	var convParams conversations.ListParams
+	for {
+		page, err := v.Participants.List(ctx, serviceSid, conversationSid, convParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fromchat: could not list participants of %s", conversationSid)
+		}
+		for _, part := range page.Participants {
+			if part.Identity != "" {
+				participants[part.Identity] = part.Attributes
+			}
+		}
+		next, ok := page.Meta.Next()
+		if !ok {
+			break
+		}
+		convParams = next
+	}
+
+	var (
+		diffs          []Diff
+		missing, extra []string
+		chatIdentities = keys(members)
+		convIdentities = keys(participants)
+	)
+	for _, identity := range chatIdentities {
+		attrs, ok := participants[identity]
+		if !ok {
+			missing = append(missing, identity)
+			continue
+		}
+		if !twilio.AttributesEqual(members[identity], attrs) {
+			diffs = append(diffs, Diff{ChannelSid: chn.Sid, Field: FieldMemberAttributes, Key: identity, Chat: string(members[identity]), Conversations: string(attrs)})
+		}
+	}
+	for _, identity := range convIdentities {
+		if _, ok := members[identity]; !ok {
+			extra = append(extra, identity)
+		}
+	}
+	if len(missing) > 0 || len(extra) > 0 {
+		diffs = append([]Diff{{
+			ChannelSid:    chn.Sid,
+			Field:         FieldMembers,
+			Chat:          fmt.Sprintf("%d members, missing [%s]", len(members), strings.Join(missing, " ")),
+			Conversations: fmt.Sprintf("%d participants, extra [%s]", len(participants), strings.Join(extra, " ")),
+		}}, diffs...)
+	}
+	return diffs, nil
+}
+
+// verifyMessages pairs the messages by index, Twilio's import keeps the indexes.
+func (v Verifier) verifyMessages(ctx context.Context, chn chat.Channel, serviceSid, conversationSid string) ([]Diff, error) {
+	messages := make(map[int]json.RawMessage)
+	params := chat.MessageListParams{ListParams: chat.ListParams{PageSize: 100}}
+	for {
+		page, err := v.Messages.List(ctx, chn.ServiceSid, chn.Sid, params)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fromchat: could not list messages of %s", chn.Sid)
+		}
+		for _, msg := range page.Messages {
+			messages[msg.Index] = msg.Attributes
+		}
+		next, ok := page.Meta.Next()
+		if !ok {
+			break
+		}
+		params.ListParams = next
+	}
+
+	var (
+		diffs      []Diff
+		count      int
+		convParams = conversations.MessageListParams{ListParams: conversations.ListParams{PageSize: 100}}
+	)
+	for {
+		page, err := v.ConversationMessages.List(ctx, serviceSid, conversationSid, convParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fromchat: could not list messages of %s", conversationSid)
+		}
+		for _, msg := range page.Messages {
+			count++
+			attrs, ok := messages[msg.Index]
+			if ok && !twilio.AttributesEqual(attrs, msg.Attributes) {
+				diffs = append(diffs, Diff{ChannelSid: chn.Sid, Field: FieldMessageAttributes, Key: fmt.Sprint(msg.Index), Chat: string(attrs), Conversations: string(msg.Attributes)})
+			}
+		}
+		next, ok := page.Meta.Next()
+		if !ok {
+			break
+		}
+		convParams.ListParams = next
+	}
+
+	if count != len(messages) {
+		diffs = append([]Diff{{ChannelSid: chn.Sid, Field: FieldMessages, Chat: fmt.Sprint(len(messages)), Conversations: fmt.Sprint(count)}}, diffs...)
+	}
+	return diffs, nil
+}
+
+func keys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
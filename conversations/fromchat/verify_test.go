@@ -0,0 +1,161 @@
+package fromchat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/smnalex/twilio-go"
+	"github.com/smnalex/twilio-go/chat"
+	"github.com/smnalex/twilio-go/conversations"
+)
+
+type fakeChat struct {
+	channels []chat.Channel
+	members  map[string][]chat.Member
+	messages map[string][]chat.Message
+}
+
+func (f fakeChat) Read(ctx context.Context, serviceSid, identity string) (chat.Channel, error) {
+	return chat.Channel{}, nil
+}
+
+func (f fakeChat) List(ctx context.Context, serviceSid string, params chat.ChannelListParams) (chat.ChannelList, error) {
+	return chat.ChannelList{Channels: f.channels}, nil
+}
+
+type fakeMembers struct{ fakeChat }
+
+func (f fakeMembers) List(ctx context.Context, serviceSid, channelSid string, params chat.MemberListParams) (chat.MemberList, error) {
+	return chat.MemberList{Members: f.members[channelSid]}, nil
+}
+
+type fakeMessages struct{ fakeChat }
+
+func (f fakeMessages) List(ctx context.Context, serviceSid, channelSid string, params chat.MessageListParams) (chat.MessageList, error) {
+	return chat.MessageList{Messages: f.messages[channelSid]}, nil
+}
+
+type fakeConversations struct {
+	conversations map[string]conversations.Conversation
+	participants  map[string][]conversations.Participant
+	messages      map[string][]conversations.Message
+}
+
+func (f fakeConversations) Read(ctx context.Context, serviceSid, identity string) (conversations.Conversation, error) {
+	conv, ok := f.conversations[identity]
+	if !ok {
+		return conv, twilio.ErrTwilioResponse{Status: http.StatusNotFound}
+	}
+	return conv, nil
+}
+
+type fakeParticipants struct{ fakeConversations }
+
+func (f fakeParticipants) List(ctx context.Context, serviceSid, conversationSid string, params conversations.ListParams) (conversations.ParticipantList, error) {
+	return conversations.ParticipantList{Participants: f.participants[conversationSid]}, nil
+}
+
+type fakeConversationMessages struct{ fakeConversations }
+
+func (f fakeConversationMessages) List(ctx context.Context, serviceSid, conversationSid string, params conversations.MessageListParams) (conversations.MessageList, error) {
+	return conversations.MessageList{Messages: f.messages[conversationSid]}, nil
+}
+
+func setup() (fakeChat, fakeConversations) {
+	c := fakeChat{
+		channels: []chat.Channel{
+			{Sid: "CH1", Attributes: json.RawMessage(`"{\"a\":1}"`)},
+			{Sid: "CH2", UniqueName: "renamed"},
+		},
+		members: map[string][]chat.Member{
+			"CH1": {{Identity: "alice"}, {Identity: "bob", Attributes: json.RawMessage(`"{}"`)}},
+			"CH2": {{Identity: "alice"}},
+		},
+		messages: map[string][]chat.Message{
+			"CH1": {{Index: 0}, {Index: 1, Attributes: json.RawMessage(`"{\"b\":2}"`)}},
+		},
+	}
+	conv := fakeConversations{
+		conversations: map[string]conversations.Conversation{
+			"CH1":     {Sid: "CH1", Attributes: json.RawMessage(`{"a":1}`)},
+			"renamed": {Sid: "CHrenamed"},
+		},
+		participants: map[string][]conversations.Participant{
+			"CH1":       {{Identity: "alice"}, {Identity: "bob"}, {MessagingBinding: &conversations.MessagingBinding{Address: "+1555"}}},
+			"CHrenamed": {{Identity: "alice"}},
+		},
+		messages: map[string][]conversations.Message{
+			"CH1": {{Index: 0, Attributes: json.RawMessage(`{}`)}, {Index: 1, Attributes: json.RawMessage(`{"b":2}`)}},
+		},
+	}
+	return c, conv
+}
+
+func verifier(c fakeChat, conv fakeConversations) Verifier {
+	return Verifier{
+		Channels:             c,
+		Members:              fakeMembers{c},
+		Messages:             fakeMessages{c},
+		Conversations:        conv,
+		Participants:         fakeParticipants{conv},
+		ConversationMessages: fakeConversationMessages{conv},
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("matching", func(t *testing.T) {
+		c, conv := setup()
+		report, err := verifier(c, conv).Verify(context.TODO(), "IS1", "")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if !report.OK() || report.Channels != 2 {
+			t.Errorf("exp 2 matching channels, got %v", report)
+		}
+	})
+
+	t.Run("differences", func(t *testing.T) {
+		c, conv := setup()
+		c.channels = append(c.channels, chat.Channel{Sid: "CH3"})
+		conv.participants["CH1"] = []conversations.Participant{{Identity: "alice", Attributes: json.RawMessage(`{"x":1}`)}, {Identity: "carol"}}
+		conv.messages["CH1"] = conv.messages["CH1"][1:]
+		conv.messages["CH1"][0].Attributes = json.RawMessage(`{"b":3}`)
+
+		report, err := verifier(c, conv).Verify(context.TODO(), "IS1", "")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		exp := []Diff{
+			{ChannelSid: "CH1", Field: FieldMembers, Chat: "2 members, missing [bob]", Conversations: "2 participants, extra [carol]"},
+			{ChannelSid: "CH1", Field: FieldMemberAttributes, Key: "alice", Conversations: `{"x":1}`},
+			{ChannelSid: "CH1", Field: FieldMessages, Chat: "2", Conversations: "1"},
+			{ChannelSid: "CH1", Field: FieldMessageAttributes, Key: "1", Chat: `"{\"b\":2}"`, Conversations: `{"b":3}`},
+			{ChannelSid: "CH3", Field: FieldConversation, Chat: "CH3", Conversations: "missing"},
+		}
+		if !cmp.Equal(exp, report.Diffs) {
+			t.Errorf("diffs diff %v", cmp.Diff(exp, report.Diffs))
+		}
+		if exp := "3 channels verified, 5 differences\n"; !strings.HasPrefix(report.String(), exp) {
+			t.Errorf("exp report prefix %q, got %q", exp, report.String())
+		}
+	})
+
+	t.Run("conversation error", func(t *testing.T) {
+		c, conv := setup()
+		v := verifier(c, conv)
+		v.Conversations = failingReader{}
+		if _, err := v.Verify(context.TODO(), "IS1", ""); err == nil {
+			t.Error("exp err, got none")
+		}
+	})
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(ctx context.Context, serviceSid, identity string) (conversations.Conversation, error) {
+	return conversations.Conversation{}, twilio.ErrTwilioResponse{Status: http.StatusInternalServerError}
+}
@@ -0,0 +1,117 @@
+package conversations
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// MessageResource handles interactions with Conversation Messages REST API.
+type MessageResource struct {
+	messageAPI
+}
+
+// Message of a conversation.
+type Message struct {
+	Sid             string          `json:"sid"`
+	AccountSid      string          `json:"account_sid"`
+	ConversationSid string          `json:"conversation_sid"`
+	Body            string          `json:"body"`
+	Media           []Media         `json:"media"`
+	Author          string          `json:"author"`
+	ParticipantSid  string          `json:"participant_sid"`
+	Attributes      json.RawMessage `json:"attributes"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	Index       int    `json:"index"`
+
+	// Delivery summary of the message sent to SMS and WhatsApp participants, nil
+	// for chat only conversations.
+	Delivery *Delivery `json:"delivery"`
+	URL      string    `json:"url"`
+	Links    struct {
+		DeliveryReceipts string `json:"delivery_receipts"`
+	} `json:"links"`
+}
+
+// Media attached to a message.
+type Media struct {
+	Sid         string `json:"sid"`
+	Size        int    `json:"size"`
+	ContentType string `json:"content_type"`
+	Filename    string `json:"filename"`
+}
+
+// Delivery summarises the delivery receipts of a message, the statuses are all, some or none.
+type Delivery struct {
+	Total       int    `json:"total"`
+	Sent        string `json:"sent"`
+	Delivered   string `json:"delivered"`
+	Read        string `json:"read"`
+	Failed      string `json:"failed"`
+	Undelivered string `json:"undelivered"`
+}
+
+// MessageList holds a page of messages of a conversation.
+type MessageList struct {
+	Messages []Message `json:"messages"`
+	Meta     Meta      `json:"meta"`
+}
+
+// MessageListParams holds information used in listing messages.
+type MessageListParams struct {
+	ListParams
+
+	// Order asc or desc by index. Default asc.
+	Order string `url:",omitempty"`
+}
+
+func (mlp MessageListParams) query() string {
+	return twilio.Query(mlp)
+}
+
+// MessageCreateParams holds information used in sending a new message.
+// https://www.twilio.com/docs/conversations/api/conversation-message-resource#create-a-conversationmessage-resource
+type MessageCreateParams struct {
+	// Author identity of the participant, default `system`.
+	Author string `url:",omitempty"`
+	Body   string `url:",omitempty"`
+
+	// DateCreated ISO-8601 format. Default current time.
+	DateCreated string `url:",omitempty"`
+
+	// DateUpdated ISO-8601 format. Default null.
+	DateUpdated string          `url:",omitempty"`
+	Attributes  json.RawMessage `url:",omitempty"`
+
+	// MediaSid of a media uploaded to the Media Content Service.
+	MediaSid string `url:",omitempty"`
+}
+
+func (mcp MessageCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mcp).Encode())
+}
+
+// MessageUpdateParams holds information used in updating an existing message.
+// https://www.twilio.com/docs/conversations/api/conversation-message-resource#update-a-conversationmessage-resource
+type MessageUpdateParams struct {
+	Author string `url:",omitempty"`
+	Body   string `url:",omitempty"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `url:",omitempty"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string          `url:",omitempty"`
+	Attributes  json.RawMessage `url:",omitempty"`
+}
+
+func (mup MessageUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mup).Encode())
+}
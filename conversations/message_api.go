@@ -0,0 +1,53 @@
+package conversations
+
+import (
+	"context"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type messageAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Conversations/{Conversation SID}/Messages/{Message SID}
+// https://www.twilio.com/docs/conversations/api/conversation-message-resource#fetch-a-conversationmessage-resource
+func (api messageAPI) Read(ctx context.Context, serviceSid, conversationSid, messageSid string) (Message, error) {
+	var msg Message
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations/%s/Messages/%s", conversationSid, messageSid), &msg)
+	return msg, err
+}
+
+// GET /Conversations/{Conversation SID}/Messages
+// https://www.twilio.com/docs/conversations/api/conversation-message-resource#read-multiple-conversationmessage-resources
+func (api messageAPI) List(ctx context.Context, serviceSid, conversationSid string, params MessageListParams) (MessageList, error) {
+	var msgs MessageList
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations/%s/Messages%s", conversationSid, params.query()), &msgs)
+	return msgs, err
+}
+
+// POST /Conversations/{Conversation SID}/Messages
+// https://www.twilio.com/docs/conversations/api/conversation-message-resource#create-a-conversationmessage-resource
+func (api messageAPI) Send(ctx context.Context, serviceSid, conversationSid string, body MessageCreateParams) (Message, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations/%s/Messages", conversationSid), body.encode())
+}
+
+// POST /Conversations/{Conversation SID}/Messages/{Message SID}
+// https://www.twilio.com/docs/conversations/api/conversation-message-resource#update-a-conversationmessage-resource
+func (api messageAPI) Update(ctx context.Context, serviceSid, conversationSid, messageSid string, body MessageUpdateParams) (Message, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations/%s/Messages/%s", conversationSid, messageSid), body.encode())
+}
+
+// DELETE /Conversations/{Conversation SID}/Messages/{Message SID}
+// https://www.twilio.com/docs/conversations/api/conversation-message-resource#delete-a-conversationmessage-resource
+func (api messageAPI) Delete(ctx context.Context, serviceSid, conversationSid, messageSid string) error {
+	_, err := api.client.Delete(ctx, scoped(serviceSid, "/Conversations/%s/Messages/%s", conversationSid, messageSid))
+	return err
+}
+
+func (api messageAPI) post(ctx context.Context, path string, body io.Reader) (Message, error) {
+	var msg Message
+	err := twilio.PostInto(ctx, api.client, path, body, &msg)
+	return msg, err
+}
@@ -0,0 +1,188 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMessageRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1/Messages/IM1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Read(context.TODO(), "", "CH1", "IM1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Read(ctx, "", "CH1", "IM1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMessageList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1/Messages?Order=desc"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/messages.json")
+		}
+
+		var (
+			exp  MessageList
+			f, _ = os.Open("fixtures/messages.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msgs, err := (messageAPI{client}).List(context.TODO(), "", "CH1", MessageListParams{Order: "desc"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msgs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msgs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).List(ctx, "", "CH1", MessageListParams{Order: "desc"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMessageSend(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Author=alice&Body=hi")
+			)
+
+			if exp := "/Conversations/CH1/Messages"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Send(context.TODO(), "", "CH1", MessageCreateParams{Author: "alice", Body: "hi"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Send(ctx, "", "CH1", MessageCreateParams{Author: "alice", Body: "hi"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMessageUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("")
+			)
+
+			if exp := "/Services/sid/Conversations/CH1/Messages/IM1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Update(context.TODO(), "sid", "CH1", "IM1", MessageUpdateParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Update(ctx, "sid", "CH1", "IM1", MessageUpdateParams{})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMessageDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1/Messages/IM1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (messageAPI{client}).Delete(context.TODO(), "", "CH1", "IM1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (messageAPI{client}).Delete(ctx, "", "CH1", "IM1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,135 @@
+package conversations
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ParticipantResource handles interactions with Conversation Participants REST API.
+type ParticipantResource struct {
+	participantAPI
+}
+
+// Participant of a conversation, either a chat participant with an Identity or
+// an SMS or WhatsApp participant with a MessagingBinding.
+type Participant struct {
+	Sid              string            `json:"sid"`
+	AccountSid       string            `json:"account_sid"`
+	ConversationSid  string            `json:"conversation_sid"`
+	Identity         string            `json:"identity"`
+	Attributes       json.RawMessage   `json:"attributes"`
+	MessagingBinding *MessagingBinding `json:"messaging_binding"`
+	RoleSid          string            `json:"role_sid"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated          string `json:"date_updated"`
+	LastReadMessageIndex int    `json:"last_read_message_index"`
+	LastReadTimestamp    string `json:"last_read_timestamp"`
+	URL                  string `json:"url"`
+}
+
+// MessagingBinding holds the addresses of a non chat participant.
+type MessagingBinding struct {
+	// Type can be sms or whatsapp.
+	Type string `json:"type"`
+
+	// Address of the participant, ProxyAddress is the Twilio address the participant
+	// exchanges messages with.
+	Address      string `json:"address"`
+	ProxyAddress string `json:"proxy_address"`
+
+	// ProjectedAddress of a chat participant in group MMS.
+	ProjectedAddress string `json:"projected_address"`
+}
+
+// ParticipantList holds a page of participants of a conversation.
+type ParticipantList struct {
+	Participants []Participant `json:"participants"`
+	Meta         Meta          `json:"meta"`
+}
+
+// ParticipantCreateParams holds information used in adding a participant.
+// https://www.twilio.com/docs/conversations/api/conversation-participant-resource#add-a-conversation-participant-sms
+type ParticipantCreateParams struct {
+	// Identity of a chat participant.
+	Identity string `url:",omitempty"`
+
+	// MessagingBindingAddress and MessagingBindingProxyAddress of an SMS or
+	// WhatsApp participant, WhatsApp addresses are prefixed by `whatsapp:`.
+	MessagingBindingAddress          string `url:"MessagingBinding.Address,omitempty"`
+	MessagingBindingProxyAddress     string `url:"MessagingBinding.ProxyAddress,omitempty"`
+	MessagingBindingProjectedAddress string `url:"MessagingBinding.ProjectedAddress,omitempty"`
+
+	// DateCreated ISO-8601 format. Default current time.
+	DateCreated string `url:",omitempty"`
+
+	// DateUpdated ISO-8601 format. Default null.
+	DateUpdated string          `url:",omitempty"`
+	Attributes  json.RawMessage `url:",omitempty"`
+	RoleSid     string          `url:",omitempty"`
+}
+
+func (pcp ParticipantCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(pcp).Encode())
+}
+
+// ChatParticipant returns the params adding a chat participant.
+func ChatParticipant(identity string) ParticipantCreateParams {
+	return ParticipantCreateParams{Identity: identity}
+}
+
+// SMSParticipant returns the params adding an SMS participant, the proxy address
+// is a Twilio phone number.
+func SMSParticipant(address, proxyAddress string) ParticipantCreateParams {
+	return ParticipantCreateParams{
+		MessagingBindingAddress:      address,
+		MessagingBindingProxyAddress: proxyAddress,
+	}
+}
+
+// WhatsAppParticipant returns the params adding a WhatsApp participant, the
+// `whatsapp:` prefix is added to the addresses when missing.
+func WhatsAppParticipant(address, proxyAddress string) ParticipantCreateParams {
+	return ParticipantCreateParams{
+		MessagingBindingAddress:      whatsApp(address),
+		MessagingBindingProxyAddress: whatsApp(proxyAddress),
+	}
+}
+
+func whatsApp(address string) string {
+	if strings.HasPrefix(address, "whatsapp:") {
+		return address
+	}
+	return "whatsapp:" + address
+}
+
+// ParticipantUpdateParams holds information used in updating an existing participant.
+// https://www.twilio.com/docs/conversations/api/conversation-participant-resource#update-a-conversationparticipant-resource
+type ParticipantUpdateParams struct {
+	Identity   string          `url:",omitempty"`
+	Attributes json.RawMessage `url:",omitempty"`
+	RoleSid    string          `url:",omitempty"`
+
+	MessagingBindingProxyAddress     string `url:"MessagingBinding.ProxyAddress,omitempty"`
+	MessagingBindingProjectedAddress string `url:"MessagingBinding.ProjectedAddress,omitempty"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `url:",omitempty"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated          string `url:",omitempty"`
+	LastReadMessageIndex int    `url:",omitempty"`
+
+	// LastReadTimestamp ISO-8601 format.
+	LastReadTimestamp string `url:",omitempty"`
+}
+
+func (pup ParticipantUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(pup).Encode())
+}
@@ -0,0 +1,53 @@
+package conversations
+
+import (
+	"context"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type participantAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Conversations/{Conversation SID}/Participants/{Participant SID}
+// https://www.twilio.com/docs/conversations/api/conversation-participant-resource#fetch-a-conversationparticipant-resource
+func (api participantAPI) Read(ctx context.Context, serviceSid, conversationSid, participantSid string) (Participant, error) {
+	var part Participant
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations/%s/Participants/%s", conversationSid, participantSid), &part)
+	return part, err
+}
+
+// GET /Conversations/{Conversation SID}/Participants
+// https://www.twilio.com/docs/conversations/api/conversation-participant-resource#read-multiple-conversationparticipant-resources
+func (api participantAPI) List(ctx context.Context, serviceSid, conversationSid string, params ListParams) (ParticipantList, error) {
+	var parts ParticipantList
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations/%s/Participants%s", conversationSid, twilio.Query(params)), &parts)
+	return parts, err
+}
+
+// POST /Conversations/{Conversation SID}/Participants
+// https://www.twilio.com/docs/conversations/api/conversation-participant-resource#add-a-conversation-participant-sms
+func (api participantAPI) Add(ctx context.Context, serviceSid, conversationSid string, body ParticipantCreateParams) (Participant, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations/%s/Participants", conversationSid), body.encode())
+}
+
+// POST /Conversations/{Conversation SID}/Participants/{Participant SID}
+// https://www.twilio.com/docs/conversations/api/conversation-participant-resource#update-a-conversationparticipant-resource
+func (api participantAPI) Update(ctx context.Context, serviceSid, conversationSid, participantSid string, body ParticipantUpdateParams) (Participant, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations/%s/Participants/%s", conversationSid, participantSid), body.encode())
+}
+
+// DELETE /Conversations/{Conversation SID}/Participants/{Participant SID}
+// https://www.twilio.com/docs/conversations/api/conversation-participant-resource#delete-a-conversationparticipant-resource
+func (api participantAPI) Delete(ctx context.Context, serviceSid, conversationSid, participantSid string) error {
+	_, err := api.client.Delete(ctx, scoped(serviceSid, "/Conversations/%s/Participants/%s", conversationSid, participantSid))
+	return err
+}
+
+func (api participantAPI) post(ctx context.Context, path string, body io.Reader) (Participant, error) {
+	var part Participant
+	err := twilio.PostInto(ctx, api.client, path, body, &part)
+	return part, err
+}
@@ -0,0 +1,188 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParticipantRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1/Participants/MB1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		part, err := (participantAPI{client}).Read(context.TODO(), "", "CH1", "MB1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, part) {
+			t.Errorf("response diff %v", cmp.Diff(exp, part))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Read(ctx, "", "CH1", "MB1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestParticipantList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Conversations/CH1/Participants?PageSize=10"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/participants.json")
+		}
+
+		var (
+			exp  ParticipantList
+			f, _ = os.Open("fixtures/participants.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		parts, err := (participantAPI{client}).List(context.TODO(), "sid", "CH1", ListParams{PageSize: 10})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, parts) {
+			t.Errorf("response diff %v", cmp.Diff(exp, parts))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).List(ctx, "sid", "CH1", ListParams{PageSize: 10})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestParticipantAdd(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("MessagingBinding.Address=%2B15558675310&MessagingBinding.ProxyAddress=%2B15017122661")
+			)
+
+			if exp := "/Conversations/CH1/Participants"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		part, err := (participantAPI{client}).Add(context.TODO(), "", "CH1", SMSParticipant("+15558675310", "+15017122661"))
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, part) {
+			t.Errorf("response diff %v", cmp.Diff(exp, part))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Add(ctx, "", "CH1", SMSParticipant("+15558675310", "+15017122661"))
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestParticipantUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("LastReadMessageIndex=3")
+			)
+
+			if exp := "/Conversations/CH1/Participants/MB1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		part, err := (participantAPI{client}).Update(context.TODO(), "", "CH1", "MB1", ParticipantUpdateParams{LastReadMessageIndex: 3})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, part) {
+			t.Errorf("response diff %v", cmp.Diff(exp, part))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Update(ctx, "", "CH1", "MB1", ParticipantUpdateParams{LastReadMessageIndex: 3})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestParticipantDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Conversations/CH1/Participants/MB1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (participantAPI{client}).Delete(context.TODO(), "sid", "CH1", "MB1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (participantAPI{client}).Delete(ctx, "sid", "CH1", "MB1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
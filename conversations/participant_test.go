@@ -0,0 +1,39 @@
+package conversations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParticipantParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("CreateParams", optionalsFn(ParticipantCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(ParticipantUpdateParams{}, exp))
+}
+
+func TestParticipantParams(t *testing.T) {
+	tt := map[string]struct {
+		params ParticipantCreateParams
+		exp    ParticipantCreateParams
+	}{
+		"chat": {
+			params: ChatParticipant("alice"),
+			exp:    ParticipantCreateParams{Identity: "alice"},
+		},
+		"sms": {
+			params: SMSParticipant("+15558675310", "+15017122661"),
+			exp:    ParticipantCreateParams{MessagingBindingAddress: "+15558675310", MessagingBindingProxyAddress: "+15017122661"},
+		},
+		"whatsapp": {
+			params: WhatsAppParticipant("+15558675310", "whatsapp:+15017122661"),
+			exp:    ParticipantCreateParams{MessagingBindingAddress: "whatsapp:+15558675310", MessagingBindingProxyAddress: "whatsapp:+15017122661"},
+		},
+	}
+	for name, tc := range tt {
+		if !cmp.Equal(tc.exp, tc.params) {
+			t.Errorf("%s: params diff %v", name, cmp.Diff(tc.exp, tc.params))
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package conversations
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// RoleResource handles interactions with Conversation Roles REST API.
+type RoleResource struct {
+	roleAPI
+}
+
+// Role represents what a user can do within a conversation service, roles are
+// either service scoped or conversation scoped.
+type Role struct {
+	Sid            string   `json:"sid"`
+	AccountSid     string   `json:"account_sid"`
+	ChatServiceSid string   `json:"chat_service_sid"`
+	FriendlyName   string   `json:"friendly_name"`
+	Type           string   `json:"type"`
+	Permissions    []string `json:"permissions"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// RoleList holds a page of roles.
+type RoleList struct {
+	Roles []Role `json:"roles"`
+	Meta  Meta   `json:"meta"`
+}
+
+// RoleCreateParams holds information used in creating a new role.
+// https://www.twilio.com/docs/conversations/api/role-resource#create-a-role-resource
+type RoleCreateParams struct {
+	FriendlyName string
+
+	// Type can be conversation or service.
+	Type string
+
+	// https://www.twilio.com/docs/conversations/api/role-resource#permissions
+	Permission []string
+}
+
+func (rcp RoleCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rcp).Encode())
+}
+
+// RoleUpdateParams holds information used in updating an existing role, the
+// permissions replace the permissions of the role.
+// https://www.twilio.com/docs/conversations/api/role-resource#update-a-role-resource
+type RoleUpdateParams struct {
+	Permission []string
+}
+
+func (rup RoleUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rup).Encode())
+}
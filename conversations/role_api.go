@@ -0,0 +1,53 @@
+package conversations
+
+import (
+	"context"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type roleAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Roles/{Role SID}
+// https://www.twilio.com/docs/conversations/api/role-resource#fetch-a-role-resource
+func (api roleAPI) Read(ctx context.Context, serviceSid, roleSid string) (Role, error) {
+	var role Role
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Roles/%s", roleSid), &role)
+	return role, err
+}
+
+// GET /Roles
+// https://www.twilio.com/docs/conversations/api/role-resource#read-multiple-role-resources
+func (api roleAPI) List(ctx context.Context, serviceSid string, params ListParams) (RoleList, error) {
+	var roles RoleList
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Roles%s", twilio.Query(params)), &roles)
+	return roles, err
+}
+
+// POST /Roles
+// https://www.twilio.com/docs/conversations/api/role-resource#create-a-role-resource
+func (api roleAPI) Create(ctx context.Context, serviceSid string, body RoleCreateParams) (Role, error) {
+	return api.post(ctx, scoped(serviceSid, "/Roles"), body.encode())
+}
+
+// POST /Roles/{Role SID}
+// https://www.twilio.com/docs/conversations/api/role-resource#update-a-role-resource
+func (api roleAPI) Update(ctx context.Context, serviceSid, roleSid string, body RoleUpdateParams) (Role, error) {
+	return api.post(ctx, scoped(serviceSid, "/Roles/%s", roleSid), body.encode())
+}
+
+// DELETE /Roles/{Role SID}
+// https://www.twilio.com/docs/conversations/api/role-resource#delete-a-role-resource
+func (api roleAPI) Delete(ctx context.Context, serviceSid, roleSid string) error {
+	_, err := api.client.Delete(ctx, scoped(serviceSid, "/Roles/%s", roleSid))
+	return err
+}
+
+func (api roleAPI) post(ctx context.Context, path string, body io.Reader) (Role, error) {
+	var role Role
+	err := twilio.PostInto(ctx, api.client, path, body, &role)
+	return role, err
+}
@@ -0,0 +1,188 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRoleRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Roles/RL1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/role.json")
+		}
+
+		var (
+			exp  Role
+			f, _ = os.Open("fixtures/role.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		role, err := (roleAPI{client}).Read(context.TODO(), "", "RL1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, role) {
+			t.Errorf("response diff %v", cmp.Diff(exp, role))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roleAPI{client}).Read(ctx, "", "RL1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRoleList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Roles"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/roles.json")
+		}
+
+		var (
+			exp  RoleList
+			f, _ = os.Open("fixtures/roles.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		roles, err := (roleAPI{client}).List(context.TODO(), "sid", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, roles) {
+			t.Errorf("response diff %v", cmp.Diff(exp, roles))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roleAPI{client}).List(ctx, "sid", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRoleCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("FriendlyName=mod&Permission=sendMessage&Type=conversation")
+			)
+
+			if exp := "/Roles"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/role.json")
+		}
+
+		var (
+			exp  Role
+			f, _ = os.Open("fixtures/role.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		role, err := (roleAPI{client}).Create(context.TODO(), "", RoleCreateParams{FriendlyName: "mod", Type: "conversation", Permission: []string{"sendMessage"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, role) {
+			t.Errorf("response diff %v", cmp.Diff(exp, role))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roleAPI{client}).Create(ctx, "", RoleCreateParams{FriendlyName: "mod", Type: "conversation", Permission: []string{"sendMessage"}})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestRoleUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("")
+			)
+
+			if exp := "/Roles/RL1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/role.json")
+		}
+
+		var (
+			exp  Role
+			f, _ = os.Open("fixtures/role.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		role, err := (roleAPI{client}).Update(context.TODO(), "", "RL1", RoleUpdateParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, role) {
+			t.Errorf("response diff %v", cmp.Diff(exp, role))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roleAPI{client}).Update(ctx, "", "RL1", RoleUpdateParams{})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestRoleDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Roles/RL1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (roleAPI{client}).Delete(context.TODO(), "", "RL1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (roleAPI{client}).Delete(ctx, "", "RL1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
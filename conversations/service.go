@@ -0,0 +1,52 @@
+package conversations
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ServiceResource handles interactions with Conversation Services REST API.
+type ServiceResource struct {
+	serviceAPI
+}
+
+// Service is an isolated container of conversations, users and roles, its settings
+// are managed by the ConfigurationResource.
+type Service struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Conversations            string `json:"conversations"`
+		Users                    string `json:"users"`
+		Roles                    string `json:"roles"`
+		Bindings                 string `json:"bindings"`
+		Configuration            string `json:"configuration"`
+		ParticipantConversations string `json:"participant_conversations"`
+	} `json:"links"`
+}
+
+// ServiceList holds a page of services.
+type ServiceList struct {
+	Services []Service `json:"services"`
+	Meta     Meta      `json:"meta"`
+}
+
+// ServiceCreateParams holds information used in creating a new service.
+// https://www.twilio.com/docs/conversations/api/service-resource#create-a-service-resource
+type ServiceCreateParams struct {
+	FriendlyName string
+}
+
+func (scp ServiceCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scp).Encode())
+}
@@ -0,0 +1,43 @@
+package conversations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type serviceAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}
+// https://www.twilio.com/docs/conversations/api/service-resource#fetch-a-service-resource
+func (api serviceAPI) Read(ctx context.Context, serviceSid string) (Service, error) {
+	var svc Service
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s", serviceSid), &svc)
+	return svc, err
+}
+
+// GET /Services
+// https://www.twilio.com/docs/conversations/api/service-resource#read-multiple-service-resources
+func (api serviceAPI) List(ctx context.Context, params ListParams) (ServiceList, error) {
+	var svcs ServiceList
+	err := twilio.GetInto(ctx, api.client, "/Services"+twilio.Query(params), &svcs)
+	return svcs, err
+}
+
+// POST /Services
+// https://www.twilio.com/docs/conversations/api/service-resource#create-a-service-resource
+func (api serviceAPI) Create(ctx context.Context, body ServiceCreateParams) (Service, error) {
+	var svc Service
+	err := twilio.PostInto(ctx, api.client, "/Services", body.encode(), &svc)
+	return svc, err
+}
+
+// DELETE /Services/{Service SID}
+// https://www.twilio.com/docs/conversations/api/service-resource#delete-a-service-resource
+func (api serviceAPI) Delete(ctx context.Context, serviceSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s", serviceSid))
+	return err
+}
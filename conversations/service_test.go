@@ -0,0 +1,8 @@
+package conversations
+
+import "testing"
+
+func TestServiceParamsOptionals(t *testing.T) {
+	exp := []byte("FriendlyName=")
+	t.Run("CreateParams", optionalsFn(ServiceCreateParams{}, exp))
+}
@@ -0,0 +1,70 @@
+package conversations
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// UserResource handles interactions with Conversation Users REST API.
+type UserResource struct {
+	userAPI
+}
+
+// User of a conversation service, represented by an Identity as provided by the developer.
+type User struct {
+	Sid            string          `json:"sid"`
+	AccountSid     string          `json:"account_sid"`
+	ChatServiceSid string          `json:"chat_service_sid"`
+	RoleSid        string          `json:"role_sid"`
+	Identity       string          `json:"identity"`
+	FriendlyName   string          `json:"friendly_name"`
+	Attributes     json.RawMessage `json:"attributes"`
+
+	// IsOnline and IsNotifiable are false unless reachability is enabled on the service.
+	IsOnline     bool `json:"is_online"`
+	IsNotifiable bool `json:"is_notifiable"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		UserConversations string `json:"user_conversations"`
+	} `json:"links"`
+}
+
+// UserList holds a page of users.
+type UserList struct {
+	Users []User `json:"users"`
+	Meta  Meta   `json:"meta"`
+}
+
+// UserCreateParams holds information used in creating a new user.
+// https://www.twilio.com/docs/conversations/api/user-resource#create-a-user-resource
+type UserCreateParams struct {
+	Identity     string
+	FriendlyName string          `url:",omitempty"`
+	Attributes   json.RawMessage `url:",omitempty"`
+	RoleSid      string          `url:",omitempty"`
+}
+
+func (ucp UserCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ucp).Encode())
+}
+
+// UserUpdateParams holds information used in updating an existing user.
+// https://www.twilio.com/docs/conversations/api/user-resource#update-a-user-resource
+type UserUpdateParams struct {
+	FriendlyName string          `url:",omitempty"`
+	Attributes   json.RawMessage `url:",omitempty"`
+	RoleSid      string          `url:",omitempty"`
+}
+
+func (uup UserUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(uup).Encode())
+}
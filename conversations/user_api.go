@@ -0,0 +1,56 @@
+package conversations
+
+import (
+	"context"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type userAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Users/{User SID}
+// GET /Users/{Identity}
+// https://www.twilio.com/docs/conversations/api/user-resource#fetch-a-user-resource
+func (api userAPI) Read(ctx context.Context, serviceSid, identity string) (User, error) {
+	var usr User
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Users/%s", identity), &usr)
+	return usr, err
+}
+
+// GET /Users
+// https://www.twilio.com/docs/conversations/api/user-resource#read-multiple-user-resources
+func (api userAPI) List(ctx context.Context, serviceSid string, params ListParams) (UserList, error) {
+	var users UserList
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Users%s", twilio.Query(params)), &users)
+	return users, err
+}
+
+// POST /Users
+// https://www.twilio.com/docs/conversations/api/user-resource#create-a-user-resource
+func (api userAPI) Create(ctx context.Context, serviceSid string, body UserCreateParams) (User, error) {
+	return api.post(ctx, scoped(serviceSid, "/Users"), body.encode())
+}
+
+// POST /Users/{User SID}
+// POST /Users/{Identity}
+// https://www.twilio.com/docs/conversations/api/user-resource#update-a-user-resource
+func (api userAPI) Update(ctx context.Context, serviceSid, identity string, body UserUpdateParams) (User, error) {
+	return api.post(ctx, scoped(serviceSid, "/Users/%s", identity), body.encode())
+}
+
+// DELETE /Users/{User SID}
+// DELETE /Users/{Identity}
+// https://www.twilio.com/docs/conversations/api/user-resource#delete-a-user-resource
+func (api userAPI) Delete(ctx context.Context, serviceSid, identity string) error {
+	_, err := api.client.Delete(ctx, scoped(serviceSid, "/Users/%s", identity))
+	return err
+}
+
+func (api userAPI) post(ctx context.Context, path string, body io.Reader) (User, error) {
+	var usr User
+	err := twilio.PostInto(ctx, api.client, path, body, &usr)
+	return usr, err
+}
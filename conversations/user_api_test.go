@@ -0,0 +1,188 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUserRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Users/admin"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+
+		var (
+			exp  User
+			f, _ = os.Open("fixtures/user.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		usr, err := (userAPI{client}).Read(context.TODO(), "", "admin")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, usr) {
+			t.Errorf("response diff %v", cmp.Diff(exp, usr))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (userAPI{client}).Read(ctx, "", "admin")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestUserList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/sid/Users"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/users.json")
+		}
+
+		var (
+			exp  UserList
+			f, _ = os.Open("fixtures/users.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		users, err := (userAPI{client}).List(context.TODO(), "sid", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, users) {
+			t.Errorf("response diff %v", cmp.Diff(exp, users))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (userAPI{client}).List(ctx, "sid", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestUserCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Identity=admin")
+			)
+
+			if exp := "/Users"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+
+		var (
+			exp  User
+			f, _ = os.Open("fixtures/user.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		usr, err := (userAPI{client}).Create(context.TODO(), "", UserCreateParams{Identity: "admin"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, usr) {
+			t.Errorf("response diff %v", cmp.Diff(exp, usr))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (userAPI{client}).Create(ctx, "", UserCreateParams{Identity: "admin"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestUserUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("RoleSid=RL1")
+			)
+
+			if exp := "/Users/admin"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/user.json")
+		}
+
+		var (
+			exp  User
+			f, _ = os.Open("fixtures/user.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		usr, err := (userAPI{client}).Update(context.TODO(), "", "admin", UserUpdateParams{RoleSid: "RL1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, usr) {
+			t.Errorf("response diff %v", cmp.Diff(exp, usr))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (userAPI{client}).Update(ctx, "", "admin", UserUpdateParams{RoleSid: "RL1"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestUserDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Users/admin"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (userAPI{client}).Delete(context.TODO(), "", "admin"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (userAPI{client}).Delete(ctx, "", "admin")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
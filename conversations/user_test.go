@@ -0,0 +1,10 @@
+package conversations
+
+import "testing"
+
+func TestUserParamsOptionals(t *testing.T) {
+	exp := []byte("Identity=")
+	t.Run("CreateParams", optionalsFn(UserCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(UserUpdateParams{}, exp))
+}
@@ -0,0 +1,83 @@
+package conversations
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// WebhookResource handles interactions with Conversation Webhooks REST API.
+type WebhookResource struct {
+	webhookAPI
+}
+
+// Webhook scoped to a single conversation.
+type Webhook struct {
+	Sid             string `json:"sid"`
+	AccountSid      string `json:"account_sid"`
+	ChatServiceSid  string `json:"chat_service_sid"`
+	ConversationSid string `json:"conversation_sid"`
+
+	// Target can be webhook, trigger or studio.
+	Target        string               `json:"target"`
+	Configuration WebhookConfiguration `json:"configuration"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// WebhookConfiguration of a conversation webhook.
+type WebhookConfiguration struct {
+	URL      string   `json:"url"`
+	Method   string   `json:"method"`
+	Filters  []string `json:"filters"`
+	Triggers []string `json:"triggers"`
+	FlowSid  string   `json:"flow_sid"`
+
+	// ReplayAfter message index from which the studio flow replays the messages.
+	ReplayAfter int `json:"replay_after"`
+}
+
+// WebhookList holds a page of webhooks of a conversation.
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
+	Meta     Meta      `json:"meta"`
+}
+
+// WebhookCreateParams holds information used in creating a new webhook.
+// https://www.twilio.com/docs/conversations/api/conversation-scoped-webhook-resource#create-a-conversationscopedwebhook-resource
+type WebhookCreateParams struct {
+	// Target can be webhook, trigger or studio.
+	Target string
+
+	ConfigurationURL      string   `url:"Configuration.Url,omitempty"`
+	ConfigurationMethod   string   `url:"Configuration.Method,omitempty"`
+	ConfigurationFilters  []string `url:"Configuration.Filters,omitempty"`
+	ConfigurationTriggers []string `url:"Configuration.Triggers,omitempty"`
+	ConfigurationFlowSid  string   `url:"Configuration.FlowSid,omitempty"`
+
+	ConfigurationReplayAfter int `url:"Configuration.ReplayAfter,omitempty"`
+}
+
+func (wcp WebhookCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(wcp).Encode())
+}
+
+// WebhookUpdateParams holds information used in updating an existing webhook.
+// https://www.twilio.com/docs/conversations/api/conversation-scoped-webhook-resource#update-a-conversationscopedwebhook-resource
+type WebhookUpdateParams struct {
+	ConfigurationURL      string   `url:"Configuration.Url,omitempty"`
+	ConfigurationMethod   string   `url:"Configuration.Method,omitempty"`
+	ConfigurationFilters  []string `url:"Configuration.Filters,omitempty"`
+	ConfigurationTriggers []string `url:"Configuration.Triggers,omitempty"`
+	ConfigurationFlowSid  string   `url:"Configuration.FlowSid,omitempty"`
+}
+
+func (wup WebhookUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(wup).Encode())
+}
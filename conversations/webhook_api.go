@@ -0,0 +1,53 @@
+package conversations
+
+import (
+	"context"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type webhookAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Conversations/{Conversation SID}/Webhooks/{Webhook SID}
+// https://www.twilio.com/docs/conversations/api/conversation-scoped-webhook-resource#fetch-a-conversationscopedwebhook-resource
+func (api webhookAPI) Read(ctx context.Context, serviceSid, conversationSid, webhookSid string) (Webhook, error) {
+	var hook Webhook
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations/%s/Webhooks/%s", conversationSid, webhookSid), &hook)
+	return hook, err
+}
+
+// GET /Conversations/{Conversation SID}/Webhooks
+// https://www.twilio.com/docs/conversations/api/conversation-scoped-webhook-resource#read-multiple-conversationscopedwebhook-resources
+func (api webhookAPI) List(ctx context.Context, serviceSid, conversationSid string, params ListParams) (WebhookList, error) {
+	var hooks WebhookList
+	err := twilio.GetInto(ctx, api.client, scoped(serviceSid, "/Conversations/%s/Webhooks%s", conversationSid, twilio.Query(params)), &hooks)
+	return hooks, err
+}
+
+// POST /Conversations/{Conversation SID}/Webhooks
+// https://www.twilio.com/docs/conversations/api/conversation-scoped-webhook-resource#create-a-conversationscopedwebhook-resource
+func (api webhookAPI) Create(ctx context.Context, serviceSid, conversationSid string, body WebhookCreateParams) (Webhook, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations/%s/Webhooks", conversationSid), body.encode())
+}
+
+// POST /Conversations/{Conversation SID}/Webhooks/{Webhook SID}
+// https://www.twilio.com/docs/conversations/api/conversation-scoped-webhook-resource#update-a-conversationscopedwebhook-resource
+func (api webhookAPI) Update(ctx context.Context, serviceSid, conversationSid, webhookSid string, body WebhookUpdateParams) (Webhook, error) {
+	return api.post(ctx, scoped(serviceSid, "/Conversations/%s/Webhooks/%s", conversationSid, webhookSid), body.encode())
+}
+
+// DELETE /Conversations/{Conversation SID}/Webhooks/{Webhook SID}
+// https://www.twilio.com/docs/conversations/api/conversation-scoped-webhook-resource#delete-a-conversationscopedwebhook-resource
+func (api webhookAPI) Delete(ctx context.Context, serviceSid, conversationSid, webhookSid string) error {
+	_, err := api.client.Delete(ctx, scoped(serviceSid, "/Conversations/%s/Webhooks/%s", conversationSid, webhookSid))
+	return err
+}
+
+func (api webhookAPI) post(ctx context.Context, path string, body io.Reader) (Webhook, error) {
+	var hook Webhook
+	err := twilio.PostInto(ctx, api.client, path, body, &hook)
+	return hook, err
+}
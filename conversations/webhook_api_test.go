@@ -0,0 +1,188 @@
+package conversations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWebhookRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1/Webhooks/WH1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/webhook.json")
+		}
+
+		var (
+			exp  Webhook
+			f, _ = os.Open("fixtures/webhook.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hook, err := (webhookAPI{client}).Read(context.TODO(), "", "CH1", "WH1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hook) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hook))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (webhookAPI{client}).Read(ctx, "", "CH1", "WH1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWebhookList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1/Webhooks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/webhooks.json")
+		}
+
+		var (
+			exp  WebhookList
+			f, _ = os.Open("fixtures/webhooks.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hooks, err := (webhookAPI{client}).List(context.TODO(), "", "CH1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hooks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hooks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (webhookAPI{client}).List(ctx, "", "CH1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWebhookCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Configuration.Filters=onMessageAdded&Configuration.Filters=onMessageRemoved&Configuration.Url=https%3A%2F%2Fexample.com&Target=webhook")
+			)
+
+			if exp := "/Conversations/CH1/Webhooks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/webhook.json")
+		}
+
+		var (
+			exp  Webhook
+			f, _ = os.Open("fixtures/webhook.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hook, err := (webhookAPI{client}).Create(context.TODO(), "", "CH1", WebhookCreateParams{Target: "webhook", ConfigurationURL: "https://example.com", ConfigurationFilters: []string{"onMessageAdded", "onMessageRemoved"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hook) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hook))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (webhookAPI{client}).Create(ctx, "", "CH1", WebhookCreateParams{Target: "webhook", ConfigurationURL: "https://example.com", ConfigurationFilters: []string{"onMessageAdded", "onMessageRemoved"}})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWebhookUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Configuration.Method=GET")
+			)
+
+			if exp := "/Conversations/CH1/Webhooks/WH1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/webhook.json")
+		}
+
+		var (
+			exp  Webhook
+			f, _ = os.Open("fixtures/webhook.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hook, err := (webhookAPI{client}).Update(context.TODO(), "", "CH1", "WH1", WebhookUpdateParams{ConfigurationMethod: "GET"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hook) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hook))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (webhookAPI{client}).Update(ctx, "", "CH1", "WH1", WebhookUpdateParams{ConfigurationMethod: "GET"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWebhookDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conversations/CH1/Webhooks/WH1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (webhookAPI{client}).Delete(context.TODO(), "", "CH1", "WH1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (webhookAPI{client}).Delete(ctx, "", "CH1", "WH1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
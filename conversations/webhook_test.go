@@ -0,0 +1,10 @@
+package conversations
+
+import "testing"
+
+func TestWebhookParamsOptionals(t *testing.T) {
+	exp := []byte("Target=")
+	t.Run("CreateParams", optionalsFn(WebhookCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(WebhookUpdateParams{}, exp))
+}
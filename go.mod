@@ -5,4 +5,5 @@ go 1.13
 require (
 	github.com/google/go-cmp v0.3.0
 	github.com/pkg/errors v0.8.1
+	gopkg.in/yaml.v2 v2.2.8
 )
@@ -1,12 +1,14 @@
 package twilio
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -18,47 +20,263 @@ type HTTPClient interface {
 	Delete(context.Context, string) ([]byte, error)
 }
 
+// Decoder is implemented by the HTTPClients decoding the JSON response stream into v,
+// instead of reading the whole body first. The client of NewHTTPClient implements it.
+type Decoder interface {
+	GetInto(ctx context.Context, path string, v interface{}) error
+	PostInto(ctx context.Context, path string, body io.Reader, v interface{}) error
+}
+
+// GetInto decodes the JSON response of a GET request into v, through the Decoder of
+// the client if implemented.
+func GetInto(ctx context.Context, client HTTPClient, path string, v interface{}) error {
+	if d, ok := client.(Decoder); ok {
+		return d.GetInto(ctx, path, v)
+	}
+	data, err := client.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// PostInto decodes the JSON response of a POST request into v, through the Decoder
+// of the client if implemented.
+func PostInto(ctx context.Context, client HTTPClient, path string, body io.Reader, v interface{}) error {
+	if d, ok := client.(Decoder); ok {
+		return d.PostInto(ctx, path, body, v)
+	}
+	data, err := client.Post(ctx, path, body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Streamer is implemented by the HTTPClients returning the unread response body, eg.
+// of media downloads. The client of NewHTTPClient implements it.
+type Streamer interface {
+	// GetStream returns the unread response body, not limited by the max body
+	// size. The caller must close it.
+	GetStream(ctx context.Context, path string) (io.ReadCloser, error)
+}
+
+// GetStream returns the response body of a GET request through the Streamer of the
+// client if implemented, otherwise the body is read by Get first. The caller must
+// close it.
+func GetStream(ctx context.Context, client HTTPClient, path string) (io.ReadCloser, error) {
+	if s, ok := client.(Streamer); ok {
+		return s.GetStream(ctx, path)
+	}
+	data, err := client.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+// HeaderClient is implemented by the HTTPClients sending extra request headers, eg.
+// the `If-Match` revision of optimistic updates. The client of NewHTTPClient implements it.
+type HeaderClient interface {
+	PostWithHeader(ctx context.Context, path string, header http.Header, body io.Reader) ([]byte, error)
+	DeleteWithHeader(ctx context.Context, path string, header http.Header) ([]byte, error)
+}
+
+// ErrHeaderNotSupported returned when sending request headers through an HTTPClient
+// not implementing HeaderClient, rather than dropping them silently.
+var ErrHeaderNotSupported = errors.New("httpclient: client can't send request headers")
+
+// PostWithHeader sends a POST request with the header through the HeaderClient of
+// the client. Without header it is a plain Post.
+func PostWithHeader(ctx context.Context, client HTTPClient, path string, header http.Header, body io.Reader) ([]byte, error) {
+	if len(header) == 0 {
+		return client.Post(ctx, path, body)
+	}
+	if h, ok := client.(HeaderClient); ok {
+		return h.PostWithHeader(ctx, path, header, body)
+	}
+	return nil, ErrHeaderNotSupported
+}
+
+// DeleteWithHeader sends a DELETE request with the header through the HeaderClient
+// of the client. Without header it is a plain Delete.
+func DeleteWithHeader(ctx context.Context, client HTTPClient, path string, header http.Header) ([]byte, error) {
+	if len(header) == 0 {
+		return client.Delete(ctx, path)
+	}
+	if h, ok := client.(HeaderClient); ok {
+		return h.DeleteWithHeader(ctx, path, header)
+	}
+	return nil, ErrHeaderNotSupported
+}
+
+// DefaultMaxBodySize is the size limit of a response body unless overridden with `WithMaxBodySize`.
+const DefaultMaxBodySize = 32 << 20
+
+// ErrBodyTooLarge returned when a response body exceeds the max body size.
+var ErrBodyTooLarge = errors.New("httpclient: response body exceeds the max body size")
+
 // RequestHandler defines the method required for an HTTP client to execute requests.
 // An *http.Client satisfies this interface.
 type RequestHandler interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
+// ClientOption configures an optional behaviour of the HTTPClient.
+type ClientOption func(*httpClient)
+
+// WithLogger logs every request made by the HTTPClient, a nil logger disables logging.
+func WithLogger(logger Logger) ClientOption {
+	return func(client *httpClient) {
+		client.logger = logger
+	}
+}
+
+// WithMaxBodySize limits the size of the response bodies read by the HTTPClient,
+// zero sets the `DefaultMaxBodySize` and a negative size disables the limit.
+func WithMaxBodySize(size int64) ClientOption {
+	return func(client *httpClient) {
+		if size == 0 {
+			size = DefaultMaxBodySize
+		}
+		client.maxBodySize = size
+	}
+}
+
+// WithRedactedParams replaces the `DefaultRedactedParams` whose values are hidden
+// from the request logs, no params disables redaction.
+func WithRedactedParams(params ...string) ClientOption {
+	return func(client *httpClient) {
+		client.redacted = redactedKeys(params)
+	}
+}
+
+// WithStreamRequestHandler sets the RequestHandler of the streamed responses, by
+// default the RequestHandler of the client without the `Timeout` of an *http.Client
+// as it bounds the reading of the whole body. The ctx still cancels the stream.
+func WithStreamRequestHandler(rh RequestHandler) ClientOption {
+	return func(client *httpClient) {
+		client.streamHandler = rh
+	}
+}
+
 type httpClient struct {
 	url       *url.URL
 	apiKey    string
 	apiSecret string
+	logger    Logger
+	redacted  map[string]bool
+
+	maxBodySize   int64
+	streamHandler RequestHandler
 	RequestHandler
 }
 
 // NewHTTPClient returns a new HTTPClient customised for making Twilio http requests.
-func NewHTTPClient(apiKey, apiSecret, baseURL string, rh RequestHandler) (HTTPClient, error) {
+func NewHTTPClient(apiKey, apiSecret, baseURL string, rh RequestHandler, opts ...ClientOption) (HTTPClient, error) {
 	url, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not parse url")
 	}
 
-	return &httpClient{
+	client := &httpClient{
 		url:            url,
 		apiKey:         apiKey,
 		apiSecret:      apiSecret,
+		maxBodySize:    DefaultMaxBodySize,
 		RequestHandler: rh,
-	}, nil
+	}
+	WithRedactedParams(DefaultRedactedParams...)(client)
+	for _, opt := range opts {
+		opt(client)
+	}
+	if client.streamHandler == nil {
+		client.streamHandler = withoutTimeout(rh)
+	}
+	return client, nil
+}
+
+// withoutTimeout returns a copy of an *http.Client without its `Timeout`, sharing
+// its transport.
+func withoutTimeout(rh RequestHandler) RequestHandler {
+	c, ok := rh.(*http.Client)
+	if !ok || c.Timeout == 0 {
+		return rh
+	}
+	stream := *c
+	stream.Timeout = 0
+	return &stream
 }
 
 func (client *httpClient) Get(ctx context.Context, path string) ([]byte, error) {
-	return client.request(ctx, http.MethodGet, path, nil)
+	return client.request(ctx, http.MethodGet, path, nil, nil)
 }
 
 func (client *httpClient) Post(ctx context.Context, path string, body io.Reader) ([]byte, error) {
-	return client.request(ctx, http.MethodPost, path, body)
+	return client.request(ctx, http.MethodPost, path, nil, body)
 }
 
 func (client *httpClient) Delete(ctx context.Context, path string) ([]byte, error) {
-	return client.request(ctx, http.MethodDelete, path, nil)
+	return client.request(ctx, http.MethodDelete, path, nil, nil)
+}
+
+func (client *httpClient) PostWithHeader(ctx context.Context, path string, header http.Header, body io.Reader) ([]byte, error) {
+	return client.request(ctx, http.MethodPost, path, header, body)
+}
+
+func (client *httpClient) DeleteWithHeader(ctx context.Context, path string, header http.Header) ([]byte, error) {
+	return client.request(ctx, http.MethodDelete, path, header, nil)
+}
+
+func (client *httpClient) GetInto(ctx context.Context, path string, v interface{}) error {
+	return client.decode(ctx, http.MethodGet, path, nil, v)
+}
+
+func (client *httpClient) PostInto(ctx context.Context, path string, body io.Reader, v interface{}) error {
+	return client.decode(ctx, http.MethodPost, path, body, v)
+}
+
+func (client *httpClient) GetStream(ctx context.Context, path string) (io.ReadCloser, error) {
+	resp, err := client.do(ctx, client.streamHandler, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (client *httpClient) request(ctx context.Context, method, path string, header http.Header, body io.Reader) ([]byte, error) {
+	resp, err := client.do(ctx, client.RequestHandler, method, path, header, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(client.limit(resp.Body))
+}
+
+func (client *httpClient) decode(ctx context.Context, method, path string, body io.Reader, v interface{}) error {
+	resp, err := client.do(ctx, client.RequestHandler, method, path, nil, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return json.NewDecoder(client.limit(resp.Body)).Decode(v)
 }
 
-func (client *httpClient) request(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+// do executes the request with rh and the extra header, the caller must close the body
+// of successful responses.
+func (client *httpClient) do(ctx context.Context, rh RequestHandler, method, path string, header http.Header, body io.Reader) (*http.Response, error) {
+	var params url.Values
+	if client.logger != nil && body != nil {
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "httpclient: could not read request body")
+		}
+		params, _ = url.ParseQuery(string(data))
+		body = bytes.NewReader(data)
+	}
+
 	req, err := http.NewRequest(method, client.url.String()+path, body)
 	if err != nil {
 		return nil, errors.Wrap(err, "httpclient: could not create request")
@@ -67,21 +285,63 @@ func (client *httpClient) request(ctx context.Context, method, path string, body
 	{
 		req.SetBasicAuth(client.apiKey, client.apiSecret)
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		for k, v := range header {
+			req.Header[k] = v
+		}
 		req = req.WithContext(ctx)
 	}
 
-	resp, err := client.Do(req)
+	start := time.Now()
+	resp, err := rh.Do(req)
 	if err != nil {
+		client.log(method, path, params, start, 0, err)
 		return nil, errors.Wrapf(err, "httpclient: could not get a response for %s", req.URL)
 	}
-	defer resp.Body.Close()
 
 	statusCode := resp.StatusCode
 	if statusCode >= http.StatusBadRequest {
-		return nil, decodeErr(resp.Body)
+		defer resp.Body.Close()
+		err := decodeErr(client.limit(resp.Body))
+		client.log(method, path, params, start, statusCode, err)
+		return nil, err
 	}
 
-	return ioutil.ReadAll(resp.Body)
+	client.log(method, path, params, start, statusCode, nil)
+	return resp, nil
+}
+
+// limit guards against response bodies larger than the max body size.
+func (client *httpClient) limit(r io.Reader) io.Reader {
+	if client.maxBodySize < 0 {
+		return r
+	}
+	return &limitedReader{r, client.maxBodySize}
+}
+
+func (client *httpClient) log(method, path string, params url.Values, start time.Time, status int, err error) {
+	if client.logger == nil {
+		return
+	}
+
+	keyvals := []interface{}{
+		"method", method,
+		"path", templatePath(path),
+		"status", status,
+		"duration", time.Since(start),
+	}
+	if len(params) > 0 {
+		keyvals = append(keyvals, "params", redactParams(params, client.redacted))
+	}
+	if err == nil {
+		client.logger.Info("twilio request", keyvals...)
+		return
+	}
+
+	if terr, ok := err.(ErrTwilioResponse); ok {
+		keyvals = append(keyvals, "code", terr.Code)
+	}
+	keyvals = append(keyvals, "error", err)
+	client.logger.Error("twilio request failed", keyvals...)
 }
 
 func decodeErr(b io.Reader) error {
@@ -91,3 +351,26 @@ func decodeErr(b io.Reader) error {
 	}
 	return err
 }
+
+// limitedReader reads at most n bytes from r, reading past that returns
+// ErrBodyTooLarge instead of io.EOF.
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		// only the bytes within the limit are returned, none once it was exceeded
+		if n += int(l.n); n < 0 {
+			n = 0
+		}
+		return n, ErrBodyTooLarge
+	}
+	return n, err
+}
@@ -3,10 +3,13 @@ package twilio
 import (
 	"bytes"
 	"context"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -206,3 +209,406 @@ func (m *mockRequestHandler) Do(r *http.Request) (*http.Response, error) {
 	m.requestInvoked = true
 	return m.requestHandlerFunc(r)
 }
+
+func TestRequestLogging(t *testing.T) {
+	t.Run("successful request", func(t *testing.T) {
+		logger := &mockLogger{}
+		mockedRequestHandler = &mockRequestHandler{}
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			reqBody, _ := ioutil.ReadAll(r.Body)
+			if exp := "Body=hello&To=CH1"; string(reqBody) != exp {
+				t.Errorf("exp req body %s, got %s", exp, reqBody)
+			}
+			body := ioutil.NopCloser(strings.NewReader("{}"))
+			return &http.Response{StatusCode: 201, Body: body}, nil
+		}
+		client, _ := NewHTTPClient(acc, auth, baseURL, mockedRequestHandler, WithLogger(logger))
+
+		if _, err := client.Post(ctx, "/Services/IS0123456789abcdef0123456789abcdef/Channels/CH0123456789abcdef0123456789abcdef/Messages", strings.NewReader("Body=hello&To=CH1")); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp, got := "twilio request", logger.info; exp != got {
+			t.Errorf("exp info msg %s, got %s", exp, got)
+		}
+		exp := map[string]interface{}{
+			"method": http.MethodPost,
+			"path":   "/Services/{id}/Channels/{id}/Messages",
+			"status": 201,
+			"params": "Body=REDACTED&To=CH1",
+		}
+		for k, v := range exp {
+			if got := logger.keyvals[k]; !cmp.Equal(v, got) {
+				t.Errorf("exp %s %v, got %v", k, v, got)
+			}
+		}
+		if _, ok := logger.keyvals["duration"]; !ok {
+			t.Error("exp duration to be logged")
+		}
+	})
+
+	t.Run("unsuccessful request status 4xx", func(t *testing.T) {
+		logger := &mockLogger{}
+		mockedRequestHandler = &mockRequestHandler{}
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader(`{"code": 20404, "status": 404}`))
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		}
+		client, _ := NewHTTPClient(acc, auth, baseURL, mockedRequestHandler, WithLogger(logger))
+
+		if _, err := client.Get(ctx, "/Services/IS0123456789abcdef0123456789abcdef?PageSize=1"); err == nil {
+			t.Fatal("exp err, got none")
+		}
+		if exp, got := "twilio request failed", logger.err; exp != got {
+			t.Errorf("exp error msg %s, got %s", exp, got)
+		}
+		if exp, got := 20404, logger.keyvals["code"]; exp != got {
+			t.Errorf("exp code %v, got %v", exp, got)
+		}
+		if exp, got := "/Services/{id}", logger.keyvals["path"]; exp != got {
+			t.Errorf("exp path %v, got %v", exp, got)
+		}
+	})
+
+	t.Run("redaction disabled", func(t *testing.T) {
+		logger := &mockLogger{}
+		mockedRequestHandler = &mockRequestHandler{}
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader("{}"))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		}
+		client, _ := NewHTTPClient(acc, auth, baseURL, mockedRequestHandler, WithLogger(logger), WithRedactedParams())
+
+		client.Post(ctx, "/Credentials", strings.NewReader("Secret=s"))
+		if exp, got := "Secret=s", logger.keyvals["params"]; exp != got {
+			t.Errorf("exp params %v, got %v", exp, got)
+		}
+	})
+}
+
+type mockLogger struct {
+	info, err string
+	keyvals   map[string]interface{}
+}
+
+func (m *mockLogger) Info(msg string, keyvals ...interface{}) {
+	m.info = msg
+	m.store(keyvals)
+}
+
+func (m *mockLogger) Error(msg string, keyvals ...interface{}) {
+	m.err = msg
+	m.store(keyvals)
+}
+
+func (m *mockLogger) store(keyvals []interface{}) {
+	m.keyvals = make(map[string]interface{})
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		m.keyvals[keyvals[i].(string)] = keyvals[i+1]
+	}
+}
+
+func TestGetInto(t *testing.T) {
+	t.Run("successful request", func(t *testing.T) {
+		setup()
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			if exp, got := http.MethodGet, r.Method; exp != got {
+				t.Errorf("exp method %s, got %s", exp, got)
+			}
+			body := ioutil.NopCloser(strings.NewReader(`{"sid": "IS1"}`))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		}
+
+		var got struct{ Sid string }
+		if err := GetInto(ctx, client, "/get", &got); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := "IS1"; got.Sid != exp {
+			t.Errorf("exp sid %s, got %s", exp, got.Sid)
+		}
+	})
+
+	t.Run("unsuccessful request status 4xx", func(t *testing.T) {
+		setup()
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader("{}"))
+			return &http.Response{StatusCode: 400, Body: body}, nil
+		}
+
+		exp := ErrTwilioResponse{}
+		if err := GetInto(ctx, client, "/get", &struct{}{}); err != exp {
+			t.Errorf("exp err %v, got %v", exp, err)
+		}
+	})
+}
+
+func TestPostInto(t *testing.T) {
+	setup()
+	mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+		if exp, got := http.MethodPost, r.Method; exp != got {
+			t.Errorf("exp method %s, got %s", exp, got)
+		}
+		reqBody, _ := ioutil.ReadAll(r.Body)
+		if exp := "FriendlyName=f"; string(reqBody) != exp {
+			t.Errorf("exp req body %s, got %s", exp, reqBody)
+		}
+		body := ioutil.NopCloser(strings.NewReader(`{"friendly_name": "f"}`))
+		return &http.Response{StatusCode: 201, Body: body}, nil
+	}
+
+	var got struct {
+		FriendlyName string `json:"friendly_name"`
+	}
+	if err := PostInto(ctx, client, "/post", strings.NewReader("FriendlyName=f"), &got); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if exp := "f"; got.FriendlyName != exp {
+		t.Errorf("exp friendly name %s, got %s", exp, got.FriendlyName)
+	}
+}
+
+// bytesClient is an HTTPClient without the Decoder methods.
+type bytesClient struct {
+	body []byte
+	err  error
+}
+
+func (c bytesClient) Get(context.Context, string) ([]byte, error) { return c.body, c.err }
+func (c bytesClient) Post(context.Context, string, io.Reader) ([]byte, error) {
+	return c.body, c.err
+}
+func (c bytesClient) Delete(context.Context, string) ([]byte, error) { return c.body, c.err }
+
+func TestIntoWithoutDecoder(t *testing.T) {
+	var got struct{ Sid string }
+	if err := GetInto(ctx, bytesClient{body: []byte(`{"sid": "IS1"}`)}, "/get", &got); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if exp := "IS1"; got.Sid != exp {
+		t.Errorf("exp sid %s, got %s", exp, got.Sid)
+	}
+
+	exp := ErrTwilioResponse{Status: 404}
+	if err := PostInto(ctx, bytesClient{err: exp}, "/post", nil, &got); err != exp {
+		t.Errorf("exp err %v, got %v", exp, err)
+	}
+	if err := PostInto(ctx, bytesClient{body: []byte("{")}, "/post", nil, &got); err == nil {
+		t.Error("exp parsing err, got nil")
+	}
+}
+
+func TestMaxBodySize(t *testing.T) {
+	var (
+		body    = `{"sid": "IS1"}`
+		handler = &mockRequestHandler{
+			requestHandlerFunc: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			},
+		}
+	)
+
+	t.Run("body within limit", func(t *testing.T) {
+		client, _ := NewHTTPClient(acc, auth, baseURL, handler, WithMaxBodySize(int64(len(body))))
+		if _, err := client.Get(ctx, "/get"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if err := GetInto(ctx, client, "/get", &struct{}{}); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("body exceeds limit", func(t *testing.T) {
+		client, _ := NewHTTPClient(acc, auth, baseURL, handler, WithMaxBodySize(int64(len(body)-1)))
+		if _, err := client.Get(ctx, "/get"); err != ErrBodyTooLarge {
+			t.Errorf("exp err %v, got %v", ErrBodyTooLarge, err)
+		}
+		if err := GetInto(ctx, client, "/get", &struct{}{}); err != ErrBodyTooLarge {
+			t.Errorf("exp err %v, got %v", ErrBodyTooLarge, err)
+		}
+	})
+
+	t.Run("limit disabled", func(t *testing.T) {
+		client, _ := NewHTTPClient(acc, auth, baseURL, handler, WithMaxBodySize(-1))
+		if _, err := client.Get(ctx, "/get"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestGetStream(t *testing.T) {
+	t.Run("successful request", func(t *testing.T) {
+		setup()
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader("RIFF...WAVE"))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		}
+		client, _ := NewHTTPClient(acc, auth, baseURL, mockedRequestHandler, WithMaxBodySize(4))
+
+		body, err := GetStream(ctx, client, "/Recordings/RE1.wav")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		defer body.Close()
+
+		got, err := ioutil.ReadAll(body)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := "RIFF...WAVE"; string(got) != exp {
+			t.Errorf("exp body %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("unsuccessful request status 4xx", func(t *testing.T) {
+		setup()
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader(`{"code": 20404, "status": 404}`))
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		}
+
+		exp := ErrTwilioResponse{Code: 20404, Status: 404}
+		if _, err := GetStream(ctx, client, "/Recordings/RE1.wav"); err != exp {
+			t.Errorf("exp err %v, got %v", exp, err)
+		}
+	})
+}
+
+func TestGetStreamWithoutStreamer(t *testing.T) {
+	body, err := GetStream(ctx, bytesClient{body: []byte("RIFF...WAVE")}, "/Recordings/RE1.wav")
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	defer body.Close()
+	if got, _ := ioutil.ReadAll(body); string(got) != "RIFF...WAVE" {
+		t.Errorf("exp body RIFF...WAVE, got %s", got)
+	}
+
+	exp := ErrTwilioResponse{Status: 404}
+	if _, err := GetStream(ctx, bytesClient{err: exp}, "/Recordings/RE1.wav"); err != exp {
+		t.Errorf("exp err %v, got %v", exp, err)
+	}
+}
+
+func TestGetStreamTimeout(t *testing.T) {
+	// the body is streamed for longer than the timeout of the client
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("RIFF"))
+		w.(http.Flusher).Flush()
+		time.Sleep(150 * time.Millisecond)
+		w.Write([]byte("...WAVE"))
+	}))
+	defer srv.Close()
+	timeout := &http.Client{Timeout: 50 * time.Millisecond}
+
+	t.Run("stream without the client timeout", func(t *testing.T) {
+		client, _ := NewHTTPClient(acc, auth, srv.URL, timeout)
+		body, err := GetStream(ctx, client, "/Recordings/RE1.wav")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		defer body.Close()
+
+		got, err := ioutil.ReadAll(body)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := "RIFF...WAVE"; string(got) != exp {
+			t.Errorf("exp body %s, got %s", exp, got)
+		}
+		if timeout.Timeout == 0 {
+			t.Error("exp the timeout of the client unchanged")
+		}
+	})
+
+	t.Run("get with the client timeout", func(t *testing.T) {
+		client, _ := NewHTTPClient(acc, auth, srv.URL, timeout)
+		if _, err := client.Get(ctx, "/Recordings/RE1.wav"); err == nil {
+			t.Error("exp timeout err, got nil")
+		}
+	})
+
+	t.Run("stream request handler", func(t *testing.T) {
+		client, _ := NewHTTPClient(acc, auth, srv.URL, http.DefaultClient, WithStreamRequestHandler(timeout))
+		body, err := GetStream(ctx, client, "/Recordings/RE1.wav")
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		defer body.Close()
+		if _, err := ioutil.ReadAll(body); err == nil {
+			t.Error("exp timeout err, got nil")
+		}
+	})
+}
+
+func TestWithHeader(t *testing.T) {
+	setup()
+	mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+		if exp, got := "3", r.Header.Get("If-Match"); exp != got {
+			t.Errorf("exp If-Match %s, got %s", exp, got)
+		}
+		if exp, got := "application/x-www-form-urlencoded", r.Header.Get("Content-Type"); exp != got {
+			t.Errorf("exp Content-Type %s, got %s", exp, got)
+		}
+		body := ioutil.NopCloser(strings.NewReader("{}"))
+		return &http.Response{StatusCode: 200, Body: body}, nil
+	}
+
+	header := http.Header{"If-Match": []string{"3"}}
+	if _, err := PostWithHeader(ctx, client, "/Documents/ET1", header, nil); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if _, err := DeleteWithHeader(ctx, client, "/Documents/ET1", header); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+
+	// the header is not carried by the context of later requests
+	mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+		if got := r.Header.Get("If-Match"); got != "" {
+			t.Errorf("exp no If-Match, got %s", got)
+		}
+		body := ioutil.NopCloser(strings.NewReader("{}"))
+		return &http.Response{StatusCode: 200, Body: body}, nil
+	}
+	if _, err := client.Post(ctx, "/Documents/ET1", nil); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+}
+
+func TestWithHeaderNotSupported(t *testing.T) {
+	header := http.Header{"If-Match": []string{"3"}}
+	if _, err := PostWithHeader(ctx, bytesClient{}, "/Documents/ET1", header, nil); err != ErrHeaderNotSupported {
+		t.Errorf("exp err %v, got %v", ErrHeaderNotSupported, err)
+	}
+	if _, err := DeleteWithHeader(ctx, bytesClient{}, "/Documents/ET1", header); err != ErrHeaderNotSupported {
+		t.Errorf("exp err %v, got %v", ErrHeaderNotSupported, err)
+	}
+
+	// without header the request is sent by the plain client methods
+	if _, err := PostWithHeader(ctx, bytesClient{body: []byte("{}")}, "/Documents/ET1", nil, nil); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if _, err := DeleteWithHeader(ctx, bytesClient{}, "/Documents/ET1", http.Header{}); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+}
+
+func TestLimitedReader(t *testing.T) {
+	r := &limitedReader{strings.NewReader("abcdef"), 4}
+
+	p := make([]byte, 8)
+	n, err := r.Read(p)
+	if err != ErrBodyTooLarge {
+		t.Errorf("exp err %v, got %v", ErrBodyTooLarge, err)
+	}
+	if exp := "abcd"; string(p[:n]) != exp {
+		t.Errorf("exp read %s, got %s", exp, p[:n])
+	}
+
+	// reading past the limit again must not return a negative count
+	n, err = r.Read(p)
+	if err != ErrBodyTooLarge {
+		t.Errorf("exp err %v, got %v", ErrBodyTooLarge, err)
+	}
+	if n != 0 {
+		t.Errorf("exp 0 bytes read, got %d", n)
+	}
+}
@@ -0,0 +1,79 @@
+package twilio
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Logger is implemented by structured loggers taking a message followed by
+// alternating key/value pairs. A *slog.Logger satisfies this interface.
+type Logger interface {
+	Info(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+// Redacted replaces sensitive values in logs.
+const Redacted = "REDACTED"
+
+// DefaultRedactedParams request parameters whose values are never logged unless
+// overridden with `WithRedactedParams`, they hold credential secrets, verification codes
+// and message bodies.
+// A name matches the last segment of nested params case-insensitively, eg. `Secret`
+// redacts `Binding.Secret`.
+var DefaultRedactedParams = []string{"ApiKey", "Secret", "PrivateKey", "Body", "Code", "AuthPayload", "CustomCode"}
+
+var (
+	sidPattern      = regexp.MustCompile(`^[A-Z]{2}[0-9a-fA-F]{32}$`)
+	resourcePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+)
+
+// templatePath strips the query and replaces the resource identifiers of a path, eg.
+// `/Services/IS…/Channels/CH…` becomes `/Services/{id}/Channels/{id}`. Sids, phone
+// numbers, indexes and the other segments not named like a resource are identifiers.
+func templatePath(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		name := strings.TrimSuffix(segment, ".json")
+		if isIdentifier(name) {
+			segments[i] = "{id}" + segment[len(name):]
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isIdentifier(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	return sidPattern.MatchString(segment) || segment == "Twilio.CURRENT" || !resourcePattern.MatchString(segment)
+}
+
+// redactedKeys returns the set of redacted param names, matched by redactParams.
+func redactedKeys(params []string) map[string]bool {
+	keys := make(map[string]bool, len(params))
+	for _, p := range params {
+		keys[strings.ToLower(p)] = true
+	}
+	return keys
+}
+
+// redactParams returns the encoded params with the values of the redacted keys replaced,
+// nested keys like `Binding.Secret` are matched by their last segment.
+func redactParams(params url.Values, redacted map[string]bool) string {
+	safe := make(url.Values, len(params))
+	for k, v := range params {
+		name := k
+		if i := strings.LastIndexByte(k, '.'); i >= 0 {
+			name = k[i+1:]
+		}
+		if redacted[strings.ToLower(name)] {
+			v = []string{Redacted}
+		}
+		safe[k] = v
+	}
+	return safe.Encode()
+}
@@ -0,0 +1,69 @@
+package twilio
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestTemplatePath(t *testing.T) {
+	const (
+		is = "IS0123456789abcdef0123456789abcdef"
+		ch = "CH0123456789abcdef0123456789abcdef"
+		ac = "AC0123456789ABCDEF0123456789ABCDEF"
+	)
+	tt := map[string]string{
+		"":                "/",
+		"/Services":       "/Services",
+		"/Services/" + is: "/Services/{id}",
+		"/Services/" + is + "/Channels/" + ch + "/Members": "/Services/{id}/Channels/{id}/Members",
+		"/Services/" + is + "/Users/user@example.com":      "/Services/{id}/Users/{id}",
+		"/Services/" + is + "/Channels?PageSize=50":        "/Services/{id}/Channels",
+		"/Accounts/" + ac + "/Messages.json?To=+44700":     "/Accounts/{id}/Messages.json",
+		"/Accounts/" + ac + "/Calls/" + ch + ".json":       "/Accounts/{id}/Calls/{id}.json",
+		"/Calls/" + ch + "/Recordings/Twilio.CURRENT.json": "/Calls/{id}/Recordings/{id}.json",
+		"/a2p/BrandRegistrations/" + ch:                    "/a2p/BrandRegistrations/{id}",
+		"/Services/" + is + "/Compliance/Usa2p/" + ch:      "/Services/{id}/Compliance/Usa2p/{id}",
+		"/Configuration/Webhooks":                          "/Configuration/Webhooks",
+		"/PhoneNumbers/+15555550100":                       "/PhoneNumbers/{id}",
+		"/Services/" + is + "/Lists/" + ch + "/Items/12":   "/Services/{id}/Lists/{id}/Items/{id}",
+	}
+	for path, exp := range tt {
+		if got := templatePath(path); exp != got {
+			t.Errorf("exp template %s for %s, got %s", exp, path, got)
+		}
+	}
+}
+
+func TestRedactParams(t *testing.T) {
+	params := url.Values{"Body": {"hi"}, "Secret": {"s"}, "From": {"me"}}
+
+	exp := "Body=REDACTED&From=me&Secret=REDACTED"
+	if got := redactParams(params, redactedKeys([]string{"Body", "Secret"})); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+	if got := params.Get("Body"); got != "hi" {
+		t.Errorf("exp params to be left untouched, got %s", got)
+	}
+}
+
+func TestRedactNestedParams(t *testing.T) {
+	type params struct {
+		FactorType string
+		Binding    struct {
+			Secret string
+		}
+		Config struct {
+			APIKey string `url:"ApiKey"`
+			Region string
+		}
+	}
+	p := params{FactorType: "totp"}
+	p.Binding.Secret = "TOPSECRET"
+	p.Config.APIKey = "KEY"
+	p.Config.Region = "us1"
+
+	exp := "Binding.Secret=REDACTED&Config.ApiKey=REDACTED&Config.Region=us1&FactorType=totp"
+	if got := redactParams(Values(p), redactedKeys([]string{"secret", "APIKEY"})); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
@@ -0,0 +1,48 @@
+// Package lookup is a client of the Twilio Lookup v2 API, validating phone numbers
+// and querying the data packages of their line, carrier and owner.
+package lookup
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Lookup lookup v2 interface
+type Lookup struct {
+	PhoneNumbers PhoneNumberResource
+}
+
+// New returns a lookup instance with a base url set to `https://lookups.twilio.com/v2`
+// if `TWILIO_LOOKUPS_HOST` not set.
+func New(tctx twilio.Context) (Lookup, error) {
+	var lookup Lookup
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		lookupsEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return lookup, err
+	}
+
+	{
+		lookup.PhoneNumbers = PhoneNumberResource{phoneNumberAPI{client}}
+	}
+	return lookup, nil
+}
+
+func lookupsEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_LOOKUPS_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://lookups.%s.twilio.com/v2", region)
+	} else if url == "" {
+		return "https://lookups.twilio.com/v2"
+	}
+	return url
+}
@@ -0,0 +1,53 @@
+package lookup
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("unsuccessful invalid env url", func(t *testing.T) {
+		os.Setenv("TWILIO_LOOKUPS_HOST", "%2")
+		if _, err := New(twilio.Context{}); err == nil {
+			t.Errorf("exp parsing err, got none")
+		}
+		os.Unsetenv("TWILIO_LOOKUPS_HOST")
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		_, err := New(twilio.Context{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestLookupsEndpoint(t *testing.T) {
+	exp := "https://lookups.twilio.com/v2"
+
+	t.Run("default url", func(*testing.T) {
+		if got := lookupsEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("default url with region", func(*testing.T) {
+		exp := "https://lookups.uk.twilio.com/v2"
+		if got := lookupsEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("env url", func(*testing.T) {
+		os.Setenv("TWILIO_LOOKUPS_HOST", exp)
+		if got := lookupsEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		if got := lookupsEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		os.Unsetenv("TWILIO_LOOKUPS_HOST")
+	})
+}
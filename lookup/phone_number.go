@@ -0,0 +1,164 @@
+package lookup
+
+import (
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// PhoneNumberResource handles interactions with Lookup Phone Numbers REST API.
+type PhoneNumberResource struct {
+	phoneNumberAPI
+}
+
+// Data packages selected with the Fields of a lookup.
+const (
+	FieldLineTypeIntelligence = "line_type_intelligence"
+	FieldCallerName           = "caller_name"
+	FieldSimSwap              = "sim_swap"
+	FieldCallForwarding       = "call_forwarding"
+	FieldIdentityMatch        = "identity_match"
+)
+
+// Line types of the line type intelligence package.
+const (
+	LineTypeMobile       = "mobile"
+	LineTypeLandline     = "landline"
+	LineTypeFixedVoip    = "fixedVoip"
+	LineTypeNonFixedVoip = "nonFixedVoip"
+	LineTypePersonal     = "personal"
+	LineTypeTollFree     = "tollFree"
+	LineTypePremium      = "premium"
+	LineTypeSharedCost   = "sharedCost"
+	LineTypeUAN          = "uan"
+	LineTypeVoicemail    = "voicemail"
+	LineTypePager        = "pager"
+	LineTypeUnknown      = "unknown"
+)
+
+// PhoneNumber is the result of a lookup, the basic validation is free and the
+// data packages are set only when selected with Fields.
+type PhoneNumber struct {
+	PhoneNumber        string `json:"phone_number"`
+	NationalFormat     string `json:"national_format"`
+	CountryCode        string `json:"country_code"`
+	CallingCountryCode string `json:"calling_country_code"`
+
+	// Valid is false for impossible numbers, the reasons are in ValidationErrors,
+	// eg. TOO_SHORT or INVALID_COUNTRY_CODE.
+	Valid            bool     `json:"valid"`
+	ValidationErrors []string `json:"validation_errors"`
+
+	LineTypeIntelligence *LineTypeIntelligence `json:"line_type_intelligence"`
+	CallerName           *CallerName           `json:"caller_name"`
+	SimSwap              *SimSwap              `json:"sim_swap"`
+	CallForwarding       *CallForwarding       `json:"call_forwarding"`
+	IdentityMatch        *IdentityMatch        `json:"identity_match"`
+	URL                  string                `json:"url"`
+}
+
+// LineTypeIntelligence is the line type and carrier of a number.
+// https://www.twilio.com/docs/lookup/v2-api/line-type-intelligence
+type LineTypeIntelligence struct {
+	Type              string `json:"type"`
+	CarrierName       string `json:"carrier_name"`
+	MobileCountryCode string `json:"mobile_country_code"`
+	MobileNetworkCode string `json:"mobile_network_code"`
+	ErrorCode         int    `json:"error_code"`
+}
+
+// CallerName is the CNAM of a US number.
+// https://www.twilio.com/docs/lookup/v2-api/cnam
+type CallerName struct {
+	CallerName string `json:"caller_name"`
+
+	// CallerType can be BUSINESS, CONSUMER or UNDETERMINED.
+	CallerType string `json:"caller_type"`
+	ErrorCode  int    `json:"error_code"`
+}
+
+// SimSwap holds the last change of the SIM of a mobile number.
+// https://www.twilio.com/docs/lookup/v2-api/sim-swap
+type SimSwap struct {
+	LastSimSwap struct {
+		// LastSimSwapDate ISO-8601 format, only available in some countries.
+		LastSimSwapDate string `json:"last_sim_swap_date"`
+
+		// SwappedPeriod ISO-8601 duration, eg. PT24H.
+		SwappedPeriod   string `json:"swapped_period"`
+		SwappedInPeriod bool   `json:"swapped_in_period"`
+	} `json:"last_sim_swap"`
+	CarrierName       string `json:"carrier_name"`
+	MobileCountryCode string `json:"mobile_country_code"`
+	MobileNetworkCode string `json:"mobile_network_code"`
+	ErrorCode         int    `json:"error_code"`
+}
+
+// CallForwarding reports whether unconditional call forwarding is enabled.
+// https://www.twilio.com/docs/lookup/v2-api/call-forwarding
+type CallForwarding struct {
+	CallForwardingStatus bool `json:"call_forwarding_status"`
+	ErrorCode            int  `json:"error_code"`
+}
+
+// IdentityMatch compares the identity of the owner of a number with the
+// IdentityMatch params, each field is exact_match, high_partial_match,
+// partial_match, no_match or no_data_available.
+// https://www.twilio.com/docs/lookup/v2-api/identity-match
+type IdentityMatch struct {
+	FirstNameMatch      string `json:"first_name_match"`
+	LastNameMatch       string `json:"last_name_match"`
+	AddressLinesMatch   string `json:"address_lines_match"`
+	CityMatch           string `json:"city_match"`
+	StateMatch          string `json:"state_match"`
+	PostalCodeMatch     string `json:"postal_code_match"`
+	AddressCountryMatch string `json:"address_country_match"`
+	NationalIDMatch     string `json:"national_id_match"`
+	DateOfBirthMatch    string `json:"date_of_birth_match"`
+
+	// SummaryScore 0 to 100, the higher the more likely the identity matches.
+	SummaryScore int    `json:"summary_score"`
+	ErrorCode    int    `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// PhoneNumberParams holds information used in looking up a phone number.
+// https://www.twilio.com/docs/lookup/v2-api#making-a-request
+type PhoneNumberParams struct {
+	// Fields the data packages returned, see the Field constants.
+	Fields []string `url:"-"`
+
+	// CountryCode ISO-3166 alpha-2 hint of a number in national format.
+	CountryCode string `url:",omitempty"`
+
+	// IdentityMatchParams the identity compared by the identity match package.
+	IdentityMatchParams
+}
+
+// IdentityMatchParams holds the identity compared with the owner of a number.
+type IdentityMatchParams struct {
+	FirstName          string `url:",omitempty"`
+	LastName           string `url:",omitempty"`
+	AddressLine1       string `url:",omitempty"`
+	AddressLine2       string `url:",omitempty"`
+	City               string `url:",omitempty"`
+	State              string `url:",omitempty"`
+	PostalCode         string `url:",omitempty"`
+	AddressCountryCode string `url:",omitempty"`
+	NationalID         string `url:"NationalId,omitempty"`
+
+	// DateOfBirth YYYYMMDD format.
+	DateOfBirth string `url:",omitempty"`
+}
+
+// query returns the encoded params prefixed by `?`, the fields are joined by commas.
+func (p PhoneNumberParams) query() string {
+	values := twilio.Values(p)
+	if len(p.Fields) > 0 {
+		values.Set("Fields", strings.Join(p.Fields, ","))
+	}
+	if q := values.Encode(); q != "" {
+		return "?" + q
+	}
+	return ""
+}
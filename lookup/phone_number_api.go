@@ -0,0 +1,22 @@
+package lookup
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type phoneNumberAPI struct {
+	client twilio.HTTPClient
+}
+
+// Read validates a phone number in E.164 or national format, with the CountryCode
+// hint, and returns the data packages selected in params.Fields.
+// GET /PhoneNumbers/{Phone Number}
+// https://www.twilio.com/docs/lookup/v2-api#making-a-request
+func (api phoneNumberAPI) Read(ctx context.Context, phoneNumber string, params PhoneNumberParams) (PhoneNumber, error) {
+	var number PhoneNumber
+	err := twilio.GetInto(ctx, api.client, "/PhoneNumbers/"+url.PathEscape(phoneNumber)+params.query(), &number)
+	return number, err
+}
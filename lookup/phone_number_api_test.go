@@ -0,0 +1,62 @@
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPhoneNumberRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/PhoneNumbers/+14159929960?Fields=line_type_intelligence%2Ccaller_name"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/phone_number.json")
+		}
+
+		var (
+			exp  PhoneNumber
+			f, _ = os.Open("fixtures/phone_number.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		params := PhoneNumberParams{Fields: []string{FieldLineTypeIntelligence, FieldCallerName}}
+		number, err := (phoneNumberAPI{client}).Read(context.TODO(), "+14159929960", params)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, number) {
+			t.Errorf("response diff %v", cmp.Diff(exp, number))
+		}
+		if exp, got := LineTypeNonFixedVoip, number.LineTypeIntelligence.Type; exp != got {
+			t.Errorf("exp line type %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("national format", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/PhoneNumbers/%28415%29%20992-9960?CountryCode=US"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/phone_number.json")
+		}
+
+		if _, err := (phoneNumberAPI{client}).Read(context.TODO(), "(415) 992-9960", PhoneNumberParams{CountryCode: "US"}); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (phoneNumberAPI{client}).Read(ctx, "+14159929960", PhoneNumberParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
@@ -0,0 +1,17 @@
+package lookup
+
+import "testing"
+
+func TestPhoneNumberParamsQuery(t *testing.T) {
+	if exp, got := "", (PhoneNumberParams{}).query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+
+	params := PhoneNumberParams{
+		Fields:              []string{FieldIdentityMatch},
+		IdentityMatchParams: IdentityMatchParams{FirstName: "Jane", NationalID: "1", DateOfBirth: "19900101"},
+	}
+	if exp, got := "?DateOfBirth=19900101&Fields=identity_match&FirstName=Jane&NationalId=1", params.query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
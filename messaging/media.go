@@ -0,0 +1,44 @@
+package messaging
+
+import "github.com/smnalex/twilio-go"
+
+// MediaResource handles interactions with the Media of Messages REST API.
+type MediaResource struct {
+	mediaAPI
+}
+
+// Media attached to an MMS or WhatsApp message.
+type Media struct {
+	Sid         string `json:"sid"`
+	AccountSid  string `json:"account_sid"`
+	ParentSid   string `json:"parent_sid"`
+	ContentType string `json:"content_type"`
+
+	// DateCreated RFC 2822 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated RFC 2822 format.
+	DateUpdated string `json:"date_updated"`
+	URI         string `json:"uri"`
+}
+
+// MediaList holds a page of media of a message.
+type MediaList struct {
+	MediaList []Media `json:"media_list"`
+	Meta
+}
+
+// MediaListParams holds information used in listing media.
+type MediaListParams struct {
+	ListParams
+
+	// DateCreated, DateCreatedBefore and DateCreatedAfter filter by the date of
+	// creation, YYYY-MM-DD format.
+	DateCreated       string `url:",omitempty"`
+	DateCreatedBefore string `url:"DateCreated<,omitempty"`
+	DateCreatedAfter  string `url:"DateCreated>,omitempty"`
+}
+
+func (mlp MediaListParams) query() string {
+	return twilio.Query(mlp)
+}
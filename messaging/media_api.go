@@ -0,0 +1,42 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type mediaAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Messages/{Message SID}/Media/{Media SID}.json
+// https://www.twilio.com/docs/messaging/api/media-resource#fetch-a-media-resource
+func (api mediaAPI) Read(ctx context.Context, messageSid, mediaSid string) (Media, error) {
+	var media Media
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Messages/%s/Media/%s.json", messageSid, mediaSid), &media)
+	return media, err
+}
+
+// Content returns the media file, limited by the max body size of the client.
+// GET /Accounts/{Account SID}/Messages/{Message SID}/Media/{Media SID}
+// https://www.twilio.com/docs/messaging/api/media-resource#fetch-a-media-resource
+func (api mediaAPI) Content(ctx context.Context, messageSid, mediaSid string) ([]byte, error) {
+	return api.client.Get(ctx, fmt.Sprintf("/Messages/%s/Media/%s", messageSid, mediaSid))
+}
+
+// GET /Accounts/{Account SID}/Messages/{Message SID}/Media.json
+// https://www.twilio.com/docs/messaging/api/media-resource#read-multiple-media-resources
+func (api mediaAPI) List(ctx context.Context, messageSid string, params MediaListParams) (MediaList, error) {
+	var media MediaList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Messages/%s/Media.json%s", messageSid, params.query()), &media)
+	return media, err
+}
+
+// DELETE /Accounts/{Account SID}/Messages/{Message SID}/Media/{Media SID}.json
+// https://www.twilio.com/docs/messaging/api/media-resource#delete-a-media-resource
+func (api mediaAPI) Delete(ctx context.Context, messageSid, mediaSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Messages/%s/Media/%s.json", messageSid, mediaSid))
+	return err
+}
@@ -0,0 +1,125 @@
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMediaRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Messages/SM1/Media/ME1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/media.json")
+		}
+
+		var (
+			exp  Media
+			f, _ = os.Open("fixtures/media.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		media, err := (mediaAPI{client}).Read(context.TODO(), "SM1", "ME1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, media) {
+			t.Errorf("response diff %v", cmp.Diff(exp, media))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mediaAPI{client}).Read(ctx, "SM1", "ME1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMediaList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Messages/SM1/Media.json?DateCreated%3C=2020-01-01"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/media_list.json")
+		}
+
+		var (
+			exp  MediaList
+			f, _ = os.Open("fixtures/media_list.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		media, err := (mediaAPI{client}).List(context.TODO(), "SM1", MediaListParams{DateCreatedBefore: "2020-01-01"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, media) {
+			t.Errorf("response diff %v", cmp.Diff(exp, media))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mediaAPI{client}).List(ctx, "SM1", MediaListParams{DateCreatedBefore: "2020-01-01"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMediaDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Messages/SM1/Media/ME1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (mediaAPI{client}).Delete(context.TODO(), "SM1", "ME1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (mediaAPI{client}).Delete(ctx, "SM1", "ME1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestMediaContent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Messages/SM1/Media/ME1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return []byte("jpeg"), nil
+		}
+
+		content, err := (mediaAPI{client}).Content(context.TODO(), "SM1", "ME1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp := []byte("jpeg"); !bytes.Equal(exp, content) {
+			t.Errorf("exp content %s, got %s", exp, content)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package messaging
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// MessageResource handles interactions with Messages REST API.
+type MessageResource struct {
+	messageAPI
+}
+
+// Message statuses.
+const (
+	StatusAccepted           = "accepted"
+	StatusScheduled          = "scheduled"
+	StatusCanceled           = "canceled"
+	StatusQueued             = "queued"
+	StatusSending            = "sending"
+	StatusSent               = "sent"
+	StatusFailed             = "failed"
+	StatusDelivered          = "delivered"
+	StatusUndelivered        = "undelivered"
+	StatusReceiving          = "receiving"
+	StatusReceived           = "received"
+	StatusRead               = "read"
+	StatusPartiallyDelivered = "partially_delivered"
+)
+
+// Message is an inbound or outbound SMS, MMS or WhatsApp message.
+type Message struct {
+	Sid                 string `json:"sid"`
+	AccountSid          string `json:"account_sid"`
+	MessagingServiceSid string `json:"messaging_service_sid"`
+	APIVersion          string `json:"api_version"`
+	To                  string `json:"to"`
+	From                string `json:"from"`
+	Body                string `json:"body"`
+
+	// Direction can be inbound, outbound-api, outbound-call or outbound-reply.
+	Direction string `json:"direction"`
+	Status    string `json:"status"`
+
+	// NumMedia and NumSegments are returned as strings.
+	NumMedia    string `json:"num_media"`
+	NumSegments string `json:"num_segments"`
+
+	// ErrorCode is set for failed and undelivered messages.
+	ErrorCode    int    `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+	Price        string `json:"price"`
+	PriceUnit    string `json:"price_unit"`
+
+	// DateCreated RFC 2822 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated RFC 2822 format.
+	DateUpdated string `json:"date_updated"`
+
+	// DateSent RFC 2822 format.
+	DateSent        string `json:"date_sent"`
+	URI             string `json:"uri"`
+	SubresourceURIs struct {
+		Media    string `json:"media"`
+		Feedback string `json:"feedback"`
+	} `json:"subresource_uris"`
+}
+
+// MessageList holds a page of messages.
+type MessageList struct {
+	Messages []Message `json:"messages"`
+	Meta
+}
+
+// MessageListParams holds information used in listing messages.
+// https://www.twilio.com/docs/messaging/api/message-resource#read-multiple-message-resources
+type MessageListParams struct {
+	ListParams
+
+	To   string `url:",omitempty"`
+	From string `url:",omitempty"`
+
+	// DateSent, DateSentBefore and DateSentAfter filter by the date sent, YYYY-MM-DD format.
+	DateSent       string `url:",omitempty"`
+	DateSentBefore string `url:"DateSent<,omitempty"`
+	DateSentAfter  string `url:"DateSent>,omitempty"`
+}
+
+func (mlp MessageListParams) query() string {
+	return twilio.Query(mlp)
+}
+
+// MessageCreateParams holds information used in sending a new message, either
+// From or MessagingServiceSid and either Body, MediaURL or ContentSid are required.
+// https://www.twilio.com/docs/messaging/api/message-resource#create-a-message-resource
+type MessageCreateParams struct {
+	To                  string
+	From                string   `url:",omitempty"`
+	MessagingServiceSid string   `url:",omitempty"`
+	Body                string   `url:",omitempty"`
+	MediaURL            []string `url:"MediaUrl,omitempty"`
+
+	// ContentSid and ContentVariables send a Content API template.
+	ContentSid       string          `url:",omitempty"`
+	ContentVariables json.RawMessage `url:",omitempty"`
+
+	// StatusCallback url receiving the status changes of the message.
+	StatusCallback string `url:",omitempty"`
+	ApplicationSid string `url:",omitempty"`
+	MaxPrice       string `url:",omitempty"`
+
+	// ValidityPeriod seconds the message may stay queued, 1 to 14400.
+	ValidityPeriod  int  `url:",omitempty"`
+	ProvideFeedback bool `url:",omitempty"`
+	SmartEncoded    bool `url:",omitempty"`
+	SendAsMms       bool `url:",omitempty"`
+
+	// ScheduleType `fixed` schedules the message at SendAt, ISO-8601 format.
+	// Scheduled messages require a MessagingServiceSid.
+	ScheduleType string `url:",omitempty"`
+	SendAt       string `url:",omitempty"`
+}
+
+func (mcp MessageCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mcp).Encode())
+}
+
+// MessageUpdateParams holds information used in updating an existing message,
+// see `Redact` and `Cancel`.
+// https://www.twilio.com/docs/messaging/api/message-resource#update-a-message-resource
+type MessageUpdateParams struct {
+	Body string `url:",omitempty"`
+
+	// Status `canceled` cancels a scheduled message.
+	Status string `url:",omitempty"`
+}
+
+func (mup MessageUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mup).Encode())
+}
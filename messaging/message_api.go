@@ -0,0 +1,69 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type messageAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Messages/{Message SID}.json
+// https://www.twilio.com/docs/messaging/api/message-resource#fetch-a-message-resource
+func (api messageAPI) Read(ctx context.Context, messageSid string) (Message, error) {
+	var msg Message
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Messages/%s.json", messageSid), &msg)
+	return msg, err
+}
+
+// GET /Accounts/{Account SID}/Messages.json
+// https://www.twilio.com/docs/messaging/api/message-resource#read-multiple-message-resources
+func (api messageAPI) List(ctx context.Context, params MessageListParams) (MessageList, error) {
+	var msgs MessageList
+	err := twilio.GetInto(ctx, api.client, "/Messages.json"+params.query(), &msgs)
+	return msgs, err
+}
+
+// POST /Accounts/{Account SID}/Messages.json
+// https://www.twilio.com/docs/messaging/api/message-resource#create-a-message-resource
+func (api messageAPI) Send(ctx context.Context, body MessageCreateParams) (Message, error) {
+	return api.post(ctx, "/Messages.json", body.encode())
+}
+
+// POST /Accounts/{Account SID}/Messages/{Message SID}.json
+// https://www.twilio.com/docs/messaging/api/message-resource#update-a-message-resource
+func (api messageAPI) Update(ctx context.Context, messageSid string, body MessageUpdateParams) (Message, error) {
+	return api.post(ctx, fmt.Sprintf("/Messages/%s.json", messageSid), body.encode())
+}
+
+// Redact removes the body of a sent or received message.
+// POST /Accounts/{Account SID}/Messages/{Message SID}.json
+// https://www.twilio.com/docs/messaging/api/message-resource#redact-a-message
+func (api messageAPI) Redact(ctx context.Context, messageSid string) (Message, error) {
+	return api.post(ctx, fmt.Sprintf("/Messages/%s.json", messageSid), strings.NewReader("Body="))
+}
+
+// Cancel cancels a scheduled message.
+// POST /Accounts/{Account SID}/Messages/{Message SID}.json
+// https://www.twilio.com/docs/messaging/features/message-scheduling#cancel-a-scheduled-message
+func (api messageAPI) Cancel(ctx context.Context, messageSid string) (Message, error) {
+	return api.Update(ctx, messageSid, MessageUpdateParams{Status: StatusCanceled})
+}
+
+// DELETE /Accounts/{Account SID}/Messages/{Message SID}.json
+// https://www.twilio.com/docs/messaging/api/message-resource#delete-a-message-resource
+func (api messageAPI) Delete(ctx context.Context, messageSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Messages/%s.json", messageSid))
+	return err
+}
+
+func (api messageAPI) post(ctx context.Context, path string, body io.Reader) (Message, error) {
+	var msg Message
+	err := twilio.PostInto(ctx, api.client, path, body, &msg)
+	return msg, err
+}
@@ -0,0 +1,270 @@
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMessageRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Messages/SM1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Read(context.TODO(), "SM1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Read(ctx, "SM1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMessageList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Messages.json?From=%2B987654321&PageSize=2"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/messages.json")
+		}
+
+		var (
+			exp  MessageList
+			f, _ = os.Open("fixtures/messages.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msgs, err := (messageAPI{client}).List(context.TODO(), MessageListParams{From: "+987654321", ListParams: ListParams{PageSize: 2}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msgs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msgs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).List(ctx, MessageListParams{From: "+987654321", ListParams: ListParams{PageSize: 2}})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMessageSend(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Body=Hi+there&From=%2B14155552345&To=%2B14155552345")
+			)
+
+			if exp := "/Messages.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Send(context.TODO(), MessageCreateParams{To: "+14155552345", From: "+14155552345", Body: "Hi there"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Send(ctx, MessageCreateParams{To: "+14155552345", From: "+14155552345", Body: "Hi there"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMessageUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Body=edited")
+			)
+
+			if exp := "/Messages/SM1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Update(context.TODO(), "SM1", MessageUpdateParams{Body: "edited"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Update(ctx, "SM1", MessageUpdateParams{Body: "edited"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMessageRedact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Body=")
+			)
+
+			if exp := "/Messages/SM1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Redact(context.TODO(), "SM1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Redact(ctx, "SM1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMessageCancel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=canceled")
+			)
+
+			if exp := "/Messages/SM1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/message.json")
+		}
+
+		var (
+			exp  Message
+			f, _ = os.Open("fixtures/message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (messageAPI{client}).Cancel(context.TODO(), "SM1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (messageAPI{client}).Cancel(ctx, "SM1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMessageDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Messages/SM1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (messageAPI{client}).Delete(context.TODO(), "SM1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (messageAPI{client}).Delete(ctx, "SM1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
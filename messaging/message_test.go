@@ -0,0 +1,50 @@
+package messaging
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+type optionals interface {
+	encode() io.Reader
+}
+
+var optionalsFn = func(m optionals, exp []byte) func(*testing.T) {
+	return func(t *testing.T) {
+		got, err := ioutil.ReadAll(m.encode())
+		if err != nil {
+			t.Errorf("exp parsing err, got %v", err)
+		}
+		if !bytes.Equal(got, exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	}
+}
+
+func TestMessageParamsOptionals(t *testing.T) {
+	exp := []byte("To=")
+	t.Run("CreateParams", optionalsFn(MessageCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(MessageUpdateParams{}, exp))
+}
+
+func TestMessageCreateParams(t *testing.T) {
+	params := MessageCreateParams{
+		To:                  "+15558675310",
+		MessagingServiceSid: "MG1",
+		MediaURL:            []string{"https://example.com/a.jpg", "https://example.com/b.jpg"},
+		ScheduleType:        "fixed",
+		SendAt:              "2021-11-30T20:36:27Z",
+	}
+	exp := []byte("MediaUrl=https%3A%2F%2Fexample.com%2Fa.jpg&MediaUrl=https%3A%2F%2Fexample.com%2Fb.jpg&MessagingServiceSid=MG1&ScheduleType=fixed&SendAt=2021-11-30T20%3A36%3A27Z&To=%2B15558675310")
+	t.Run("encode", optionalsFn(params, exp))
+}
+
+func TestMessageListParams(t *testing.T) {
+	params := MessageListParams{To: "+123", DateSentAfter: "2020-01-01"}
+	if exp, got := "?DateSent%3E=2020-01-01&To=%2B123", params.query(); exp != got {
+		t.Errorf("exp query %s, got %s", exp, got)
+	}
+}
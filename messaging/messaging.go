@@ -0,0 +1,58 @@
+// Package messaging is a client of the Twilio Programmable Messaging API, sending
+// and tracking SMS, MMS and WhatsApp messages of an account.
+package messaging
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+// ErrMissingAccountSID returned when the context holds no account sid, the
+// messages API is scoped by account.
+var ErrMissingAccountSID = errors.New("messaging: missing account sid")
+
+// Messaging programmable messaging interface
+type Messaging struct {
+	Messages MessageResource
+	Media    MediaResource
+}
+
+// New returns a messaging instance with a base url set to `https://api.twilio.com/2010-04-01`
+// if `TWILIO_API_HOST` not set, scoped by the account sid of the context.
+func New(tctx twilio.Context) (Messaging, error) {
+	var messaging Messaging
+	if tctx.AccountSID == "" {
+		return messaging, ErrMissingAccountSID
+	}
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		fmt.Sprintf("%s/Accounts/%s", apiEndpointForRegion(tctx.Region), tctx.AccountSID),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return messaging, err
+	}
+
+	{
+		messaging.Messages = MessageResource{messageAPI{client}}
+		messaging.Media = MediaResource{mediaAPI{client}}
+	}
+	return messaging, nil
+}
+
+func apiEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_API_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://api.%s.twilio.com/2010-04-01", region)
+	} else if url == "" {
+		return "https://api.twilio.com/2010-04-01"
+	}
+	return url
+}
@@ -0,0 +1,10 @@
+package messaging
+
+import "github.com/smnalex/twilio-go"
+
+// Meta stores the paging information of a list, the 2010-04-01 API returns it
+// alongside the resources.
+type Meta = twilio.Meta2010
+
+// ListParams holds the paging information used in listing resources.
+type ListParams = twilio.ListParams
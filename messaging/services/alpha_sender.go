@@ -0,0 +1,48 @@
+package services
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// AlphaSenderResource handles interactions with the AlphaSenders of Messaging Services REST API.
+type AlphaSenderResource struct {
+	alphaSenderAPI
+}
+
+// AlphaSender is an alphanumeric sender ID in the sender pool of a service.
+type AlphaSender struct {
+	Sid         string `json:"sid"`
+	AccountSid  string `json:"account_sid"`
+	ServiceSid  string `json:"service_sid"`
+	AlphaSender string `json:"alpha_sender"`
+
+	// Capabilities of the sender, eg. SMS, MMS and Voice.
+	Capabilities []string `json:"capabilities"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// AlphaSenderList holds a page of alpha senders of a service.
+type AlphaSenderList struct {
+	AlphaSenders []AlphaSender `json:"alpha_senders"`
+	Meta         Meta          `json:"meta"`
+}
+
+// AlphaSenderCreateParams holds information used in adding an alphanumeric sender ID to a service.
+// https://www.twilio.com/docs/messaging/api/alphasender-resource#create-a-alphasender-resource
+type AlphaSenderCreateParams struct {
+	// AlphaSender up to 11 characters, letters, digits and spaces.
+	AlphaSender string
+}
+
+func (p AlphaSenderCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(p).Encode())
+}
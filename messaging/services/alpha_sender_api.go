@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type alphaSenderAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/AlphaSenders/{AlphaSender SID}
+// https://www.twilio.com/docs/messaging/api/alphasender-resource#fetch-a-alphasender-resource
+func (api alphaSenderAPI) Read(ctx context.Context, serviceSid, sid string) (AlphaSender, error) {
+	var sender AlphaSender
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/AlphaSenders/%s", serviceSid, sid), &sender)
+	return sender, err
+}
+
+// GET /Services/{Service SID}/AlphaSenders
+// https://www.twilio.com/docs/messaging/api/alphasender-resource#read-multiple-alphasender-resources
+func (api alphaSenderAPI) List(ctx context.Context, serviceSid string, params ListParams) (AlphaSenderList, error) {
+	var senders AlphaSenderList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/AlphaSenders%s", serviceSid, twilio.Query(params)), &senders)
+	return senders, err
+}
+
+// POST /Services/{Service SID}/AlphaSenders
+// https://www.twilio.com/docs/messaging/api/alphasender-resource#create-a-alphasender-resource
+func (api alphaSenderAPI) Add(ctx context.Context, serviceSid string, body AlphaSenderCreateParams) (AlphaSender, error) {
+	var sender AlphaSender
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/AlphaSenders", serviceSid), body.encode(), &sender)
+	return sender, err
+}
+
+// DELETE /Services/{Service SID}/AlphaSenders/{AlphaSender SID}
+// https://www.twilio.com/docs/messaging/api/alphasender-resource#delete-a-alphasender-resource
+func (api alphaSenderAPI) Delete(ctx context.Context, serviceSid, sid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/AlphaSenders/%s", serviceSid, sid))
+	return err
+}
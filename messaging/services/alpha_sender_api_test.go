@@ -0,0 +1,147 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAlphaSenderRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/AlphaSenders/XX1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/alpha_sender.json")
+		}
+
+		var (
+			exp  AlphaSender
+			f, _ = os.Open("fixtures/alpha_sender.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		sender, err := (alphaSenderAPI{client}).Read(context.TODO(), "MG1", "XX1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, sender) {
+			t.Errorf("response diff %v", cmp.Diff(exp, sender))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (alphaSenderAPI{client}).Read(ctx, "MG1", "XX1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestAlphaSenderList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/AlphaSenders?PageToken=PA1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/alpha_senders.json")
+		}
+
+		var (
+			exp  AlphaSenderList
+			f, _ = os.Open("fixtures/alpha_senders.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		senders, err := (alphaSenderAPI{client}).List(context.TODO(), "MG1", ListParams{PageToken: "PA1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, senders) {
+			t.Errorf("response diff %v", cmp.Diff(exp, senders))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (alphaSenderAPI{client}).List(ctx, "MG1", ListParams{PageToken: "PA1"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestAlphaSenderAdd(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AlphaSender=Twilio")
+			)
+
+			if exp := "/Services/MG1/AlphaSenders"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/alpha_sender.json")
+		}
+
+		var (
+			exp  AlphaSender
+			f, _ = os.Open("fixtures/alpha_sender.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		sender, err := (alphaSenderAPI{client}).Add(context.TODO(), "MG1", AlphaSenderCreateParams{AlphaSender: "Twilio"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, sender) {
+			t.Errorf("response diff %v", cmp.Diff(exp, sender))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (alphaSenderAPI{client}).Add(ctx, "MG1", AlphaSenderCreateParams{AlphaSender: "Twilio"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestAlphaSenderDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/AlphaSenders/XX1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (alphaSenderAPI{client}).Delete(context.TODO(), "MG1", "XX1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (alphaSenderAPI{client}).Delete(ctx, "MG1", "XX1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Brand registration statuses.
+const (
+	BrandStatusPending  = "PENDING"
+	BrandStatusApproved = "APPROVED"
+	BrandStatusFailed   = "FAILED"
+	BrandStatusInReview = "IN_REVIEW"
+	BrandStatusDeleted  = "DELETED"
+)
+
+// BrandRegistrationResource handles interactions with the A2P Brand Registrations REST API.
+type BrandRegistrationResource struct {
+	brandRegistrationAPI
+}
+
+// BrandRegistration is the registration of a business with The Campaign Registry,
+// required before registering a US A2P 10DLC campaign.
+type BrandRegistration struct {
+	Sid                      string `json:"sid"`
+	AccountSid               string `json:"account_sid"`
+	CustomerProfileBundleSid string `json:"customer_profile_bundle_sid"`
+	A2PProfileBundleSid      string `json:"a2p_profile_bundle_sid"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+
+	// BrandType can be STANDARD, STARTER or SOLE_PROPRIETOR.
+	BrandType string `json:"brand_type"`
+	Status    string `json:"status"`
+
+	// TCRID the brand identifier assigned by The Campaign Registry.
+	TCRID               string   `json:"tcr_id"`
+	FailureReason       string   `json:"failure_reason"`
+	BrandScore          int      `json:"brand_score"`
+	BrandFeedback       []string `json:"brand_feedback"`
+	IdentityStatus      string   `json:"identity_status"`
+	Russell3000         bool     `json:"russell_3000"`
+	GovernmentEntity    bool     `json:"government_entity"`
+	TaxExemptStatus     string   `json:"tax_exempt_status"`
+	SkipAutomaticSecVet bool     `json:"skip_automatic_sec_vet"`
+	Mock                bool     `json:"mock"`
+	URL                 string   `json:"url"`
+	Links               struct {
+		BrandVettings              string `json:"brand_vettings"`
+		BrandRegistrationOtps      string `json:"brand_registration_otps"`
+		SmsBrandRegistrationOtps   string `json:"sms_brand_registration_otps"`
+		EmailBrandRegistrationOtps string `json:"email_brand_registration_otps"`
+	} `json:"links"`
+}
+
+// BrandRegistrationList holds a page of brand registrations.
+type BrandRegistrationList struct {
+	Data []BrandRegistration `json:"data"`
+	Meta Meta                `json:"meta"`
+}
+
+// BrandRegistrationCreateParams holds information used in registering a brand.
+// https://www.twilio.com/docs/messaging/api/brand-registration-resource#create-a-brandregistrations-resource
+type BrandRegistrationCreateParams struct {
+	CustomerProfileBundleSid string
+	A2PProfileBundleSid      string `url:"A2PProfileBundleSid"`
+	BrandType                string `url:",omitempty"`
+
+	// Mock registers a brand without submitting it to The Campaign Registry, for testing.
+	Mock                bool `url:",omitempty"`
+	SkipAutomaticSecVet bool `url:",omitempty"`
+}
+
+func (p BrandRegistrationCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(p).Encode())
+}
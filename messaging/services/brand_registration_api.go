@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type brandRegistrationAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /a2p/BrandRegistrations/{BrandRegistration SID}
+// https://www.twilio.com/docs/messaging/api/brand-registration-resource#fetch-a-specific-brandregistrations-resource
+func (api brandRegistrationAPI) Read(ctx context.Context, sid string) (BrandRegistration, error) {
+	var brand BrandRegistration
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/a2p/BrandRegistrations/%s", sid), &brand)
+	return brand, err
+}
+
+// GET /a2p/BrandRegistrations
+// https://www.twilio.com/docs/messaging/api/brand-registration-resource#read-multiple-brandregistrations-resources
+func (api brandRegistrationAPI) List(ctx context.Context, params ListParams) (BrandRegistrationList, error) {
+	var brands BrandRegistrationList
+	err := twilio.GetInto(ctx, api.client, "/a2p/BrandRegistrations"+twilio.Query(params), &brands)
+	return brands, err
+}
+
+// POST /a2p/BrandRegistrations
+// https://www.twilio.com/docs/messaging/api/brand-registration-resource#create-a-brandregistrations-resource
+func (api brandRegistrationAPI) Create(ctx context.Context, body BrandRegistrationCreateParams) (BrandRegistration, error) {
+	var brand BrandRegistration
+	err := twilio.PostInto(ctx, api.client, "/a2p/BrandRegistrations", body.encode(), &brand)
+	return brand, err
+}
+
+// Resubmit a failed brand registration once its bundles have been corrected.
+// POST /a2p/BrandRegistrations/{BrandRegistration SID}
+// https://www.twilio.com/docs/messaging/api/brand-registration-resource#update-a-brandregistrations-resource
+func (api brandRegistrationAPI) Resubmit(ctx context.Context, sid string) (BrandRegistration, error) {
+	var brand BrandRegistration
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/a2p/BrandRegistrations/%s", sid), http.NoBody, &brand)
+	return brand, err
+}
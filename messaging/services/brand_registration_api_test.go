@@ -0,0 +1,161 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBrandRegistrationRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/a2p/BrandRegistrations/BN1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/brand_registration.json")
+		}
+
+		var (
+			exp  BrandRegistration
+			f, _ = os.Open("fixtures/brand_registration.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		brand, err := (brandRegistrationAPI{client}).Read(context.TODO(), "BN1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, brand) {
+			t.Errorf("response diff %v", cmp.Diff(exp, brand))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (brandRegistrationAPI{client}).Read(ctx, "BN1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestBrandRegistrationList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/a2p/BrandRegistrations"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/brand_registrations.json")
+		}
+
+		var (
+			exp  BrandRegistrationList
+			f, _ = os.Open("fixtures/brand_registrations.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		brands, err := (brandRegistrationAPI{client}).List(context.TODO(), ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, brands) {
+			t.Errorf("response diff %v", cmp.Diff(exp, brands))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (brandRegistrationAPI{client}).List(ctx, ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestBrandRegistrationCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("A2PProfileBundleSid=BU1&CustomerProfileBundleSid=BU0")
+			)
+
+			if exp := "/a2p/BrandRegistrations"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/brand_registration.json")
+		}
+
+		var (
+			exp  BrandRegistration
+			f, _ = os.Open("fixtures/brand_registration.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		brand, err := (brandRegistrationAPI{client}).Create(context.TODO(), BrandRegistrationCreateParams{CustomerProfileBundleSid: "BU0", A2PProfileBundleSid: "BU1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, brand) {
+			t.Errorf("response diff %v", cmp.Diff(exp, brand))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (brandRegistrationAPI{client}).Create(ctx, BrandRegistrationCreateParams{CustomerProfileBundleSid: "BU0", A2PProfileBundleSid: "BU1"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestBrandRegistrationResubmit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("")
+			)
+
+			if exp := "/a2p/BrandRegistrations/BN1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/brand_registration.json")
+		}
+
+		var (
+			exp  BrandRegistration
+			f, _ = os.Open("fixtures/brand_registration.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		brand, err := (brandRegistrationAPI{client}).Resubmit(context.TODO(), "BN1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, brand) {
+			t.Errorf("response diff %v", cmp.Diff(exp, brand))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (brandRegistrationAPI{client}).Resubmit(ctx, "BN1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
@@ -0,0 +1,8 @@
+package services
+
+import "testing"
+
+func TestBrandRegistrationParamsOptionals(t *testing.T) {
+	exp := []byte("A2PProfileBundleSid=&CustomerProfileBundleSid=")
+	t.Run("CreateParams", optionalsFn(BrandRegistrationCreateParams{}, exp))
+}
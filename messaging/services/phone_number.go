@@ -0,0 +1,51 @@
+package services
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// PhoneNumberResource handles interactions with the PhoneNumbers of Messaging Services REST API.
+type PhoneNumberResource struct {
+	phoneNumberAPI
+}
+
+// PhoneNumber is a phone number of the account in the sender pool of a service.
+type PhoneNumber struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+
+	// PhoneNumber E.164 format.
+	PhoneNumber string `json:"phone_number"`
+	CountryCode string `json:"country_code"`
+
+	// Capabilities of the sender, eg. SMS, MMS and Voice.
+	Capabilities []string `json:"capabilities"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// PhoneNumberList holds a page of phone numbers of a service.
+type PhoneNumberList struct {
+	PhoneNumbers []PhoneNumber `json:"phone_numbers"`
+	Meta         Meta          `json:"meta"`
+}
+
+// PhoneNumberCreateParams holds information used in adding a phone number of the account to a service.
+// https://www.twilio.com/docs/messaging/api/phonenumber-resource#create-a-phonenumber-resource
+type PhoneNumberCreateParams struct {
+	// PhoneNumberSid of the incoming phone number added to the pool.
+	PhoneNumberSid string
+}
+
+func (p PhoneNumberCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(p).Encode())
+}
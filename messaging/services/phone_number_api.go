@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type phoneNumberAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/PhoneNumbers/{PhoneNumber SID}
+// https://www.twilio.com/docs/messaging/api/phonenumber-resource#fetch-a-phonenumber-resource
+func (api phoneNumberAPI) Read(ctx context.Context, serviceSid, sid string) (PhoneNumber, error) {
+	var num PhoneNumber
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/PhoneNumbers/%s", serviceSid, sid), &num)
+	return num, err
+}
+
+// GET /Services/{Service SID}/PhoneNumbers
+// https://www.twilio.com/docs/messaging/api/phonenumber-resource#read-multiple-phonenumber-resources
+func (api phoneNumberAPI) List(ctx context.Context, serviceSid string, params ListParams) (PhoneNumberList, error) {
+	var nums PhoneNumberList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/PhoneNumbers%s", serviceSid, twilio.Query(params)), &nums)
+	return nums, err
+}
+
+// POST /Services/{Service SID}/PhoneNumbers
+// https://www.twilio.com/docs/messaging/api/phonenumber-resource#create-a-phonenumber-resource
+func (api phoneNumberAPI) Add(ctx context.Context, serviceSid string, body PhoneNumberCreateParams) (PhoneNumber, error) {
+	var num PhoneNumber
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/PhoneNumbers", serviceSid), body.encode(), &num)
+	return num, err
+}
+
+// DELETE /Services/{Service SID}/PhoneNumbers/{PhoneNumber SID}
+// https://www.twilio.com/docs/messaging/api/phonenumber-resource#delete-a-phonenumber-resource
+func (api phoneNumberAPI) Delete(ctx context.Context, serviceSid, sid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/PhoneNumbers/%s", serviceSid, sid))
+	return err
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPhoneNumberRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/PhoneNumbers/XX1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/phone_number.json")
+		}
+
+		var (
+			exp  PhoneNumber
+			f, _ = os.Open("fixtures/phone_number.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		sender, err := (phoneNumberAPI{client}).Read(context.TODO(), "MG1", "XX1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, sender) {
+			t.Errorf("response diff %v", cmp.Diff(exp, sender))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (phoneNumberAPI{client}).Read(ctx, "MG1", "XX1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestPhoneNumberList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/PhoneNumbers?PageToken=PA1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/phone_numbers.json")
+		}
+
+		var (
+			exp  PhoneNumberList
+			f, _ = os.Open("fixtures/phone_numbers.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		senders, err := (phoneNumberAPI{client}).List(context.TODO(), "MG1", ListParams{PageToken: "PA1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, senders) {
+			t.Errorf("response diff %v", cmp.Diff(exp, senders))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (phoneNumberAPI{client}).List(ctx, "MG1", ListParams{PageToken: "PA1"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestPhoneNumberAdd(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("PhoneNumberSid=PN1")
+			)
+
+			if exp := "/Services/MG1/PhoneNumbers"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/phone_number.json")
+		}
+
+		var (
+			exp  PhoneNumber
+			f, _ = os.Open("fixtures/phone_number.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		sender, err := (phoneNumberAPI{client}).Add(context.TODO(), "MG1", PhoneNumberCreateParams{PhoneNumberSid: "PN1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, sender) {
+			t.Errorf("response diff %v", cmp.Diff(exp, sender))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (phoneNumberAPI{client}).Add(ctx, "MG1", PhoneNumberCreateParams{PhoneNumberSid: "PN1"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestPhoneNumberDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/PhoneNumbers/XX1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (phoneNumberAPI{client}).Delete(context.TODO(), "MG1", "XX1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (phoneNumberAPI{client}).Delete(ctx, "MG1", "XX1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ServiceResource handles interactions with Messaging Services REST API.
+type ServiceResource struct {
+	serviceAPI
+}
+
+// Service is a container of senders sharing the same configuration, messages sent
+// through a service pick a sender from its pool.
+type Service struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated               string `json:"date_updated"`
+	InboundRequestURL         string `json:"inbound_request_url"`
+	InboundMethod             string `json:"inbound_method"`
+	FallbackURL               string `json:"fallback_url"`
+	FallbackMethod            string `json:"fallback_method"`
+	StatusCallback            string `json:"status_callback"`
+	StickySender              bool   `json:"sticky_sender"`
+	MmsConverter              bool   `json:"mms_converter"`
+	SmartEncoding             bool   `json:"smart_encoding"`
+	ScanMessageContent        string `json:"scan_message_content"`
+	FallbackToLongCode        bool   `json:"fallback_to_long_code"`
+	AreaCodeGeomatch          bool   `json:"area_code_geomatch"`
+	SynchronousValidation     bool   `json:"synchronous_validation"`
+	ValidityPeriod            int    `json:"validity_period"`
+	Usecase                   string `json:"usecase"`
+	UsAppToPersonRegistered   bool   `json:"us_app_to_person_registered"`
+	UseInboundWebhookOnNumber bool   `json:"use_inbound_webhook_on_number"`
+	URL                       string `json:"url"`
+	Links                     struct {
+		PhoneNumbers          string `json:"phone_numbers"`
+		ShortCodes            string `json:"short_codes"`
+		AlphaSenders          string `json:"alpha_senders"`
+		Messages              string `json:"messages"`
+		UsAppToPerson         string `json:"us_app_to_person"`
+		UsAppToPersonUsecases string `json:"us_app_to_person_usecases"`
+		ChannelSenders        string `json:"channel_senders"`
+	} `json:"links"`
+}
+
+// ServiceList holds a page of services.
+type ServiceList struct {
+	Services []Service `json:"services"`
+	Meta     Meta      `json:"meta"`
+}
+
+// ServiceCreateParams holds information used in creating a new service, the
+// features enabled by default are pointers so they can be disabled.
+// https://www.twilio.com/docs/messaging/api/service-resource#create-a-service-resource
+type ServiceCreateParams struct {
+	FriendlyName      string
+	InboundRequestURL string `url:"InboundRequestUrl,omitempty"`
+	InboundMethod     string `url:",omitempty"`
+	FallbackURL       string `url:"FallbackUrl,omitempty"`
+	FallbackMethod    string `url:",omitempty"`
+	StatusCallback    string `url:",omitempty"`
+
+	StickySender              *bool `url:",omitempty"`
+	MmsConverter              *bool `url:",omitempty"`
+	SmartEncoding             *bool `url:",omitempty"`
+	FallbackToLongCode        *bool `url:",omitempty"`
+	AreaCodeGeomatch          *bool `url:",omitempty"`
+	SynchronousValidation     *bool `url:",omitempty"`
+	UseInboundWebhookOnNumber *bool `url:",omitempty"`
+
+	// ScanMessageContent can be inherit, enable or disable.
+	ScanMessageContent string `url:",omitempty"`
+
+	// ValidityPeriod seconds a message may stay queued, 1 to 14400.
+	ValidityPeriod int `url:",omitempty"`
+
+	// Usecase of the service, eg. notifications, marketing or verification.
+	Usecase string `url:",omitempty"`
+}
+
+func (scp ServiceCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scp).Encode())
+}
+
+// ServiceUpdateParams holds information used in updating an existing service.
+// https://www.twilio.com/docs/messaging/api/service-resource#update-a-service-resource
+type ServiceUpdateParams struct {
+	FriendlyName      string `url:",omitempty"`
+	InboundRequestURL string `url:"InboundRequestUrl,omitempty"`
+	InboundMethod     string `url:",omitempty"`
+	FallbackURL       string `url:"FallbackUrl,omitempty"`
+	FallbackMethod    string `url:",omitempty"`
+	StatusCallback    string `url:",omitempty"`
+
+	StickySender              *bool `url:",omitempty"`
+	MmsConverter              *bool `url:",omitempty"`
+	SmartEncoding             *bool `url:",omitempty"`
+	FallbackToLongCode        *bool `url:",omitempty"`
+	AreaCodeGeomatch          *bool `url:",omitempty"`
+	SynchronousValidation     *bool `url:",omitempty"`
+	UseInboundWebhookOnNumber *bool `url:",omitempty"`
+
+	ScanMessageContent string `url:",omitempty"`
+	ValidityPeriod     int    `url:",omitempty"`
+	Usecase            string `url:",omitempty"`
+}
+
+func (sup ServiceUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(sup).Encode())
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServiceRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/service.json")
+		}
+
+		var (
+			exp  Service
+			f, _ = os.Open("fixtures/service.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		svc, err := (serviceAPI{client}).Read(context.TODO(), "MG1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, svc) {
+			t.Errorf("response diff %v", cmp.Diff(exp, svc))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (serviceAPI{client}).Read(ctx, "MG1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestServiceList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services?PageSize=1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/services.json")
+		}
+
+		var (
+			exp  ServiceList
+			f, _ = os.Open("fixtures/services.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		svcs, err := (serviceAPI{client}).List(context.TODO(), ListParams{PageSize: 1})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, svcs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, svcs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (serviceAPI{client}).List(ctx, ListParams{PageSize: 1})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestServiceCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("FriendlyName=Notifications&Usecase=notifications")
+			)
+
+			if exp := "/Services"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/service.json")
+		}
+
+		var (
+			exp  Service
+			f, _ = os.Open("fixtures/service.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		svc, err := (serviceAPI{client}).Create(context.TODO(), ServiceCreateParams{FriendlyName: "Notifications", Usecase: "notifications"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, svc) {
+			t.Errorf("response diff %v", cmp.Diff(exp, svc))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (serviceAPI{client}).Create(ctx, ServiceCreateParams{FriendlyName: "Notifications", Usecase: "notifications"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestServiceUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("StatusCallback=https%3A%2F%2Fwww.example.com%2Fstatus")
+			)
+
+			if exp := "/Services/MG1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/service.json")
+		}
+
+		var (
+			exp  Service
+			f, _ = os.Open("fixtures/service.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		svc, err := (serviceAPI{client}).Update(context.TODO(), "MG1", ServiceUpdateParams{StatusCallback: "https://www.example.com/status"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, svc) {
+			t.Errorf("response diff %v", cmp.Diff(exp, svc))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (serviceAPI{client}).Update(ctx, "MG1", ServiceUpdateParams{StatusCallback: "https://www.example.com/status"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestServiceDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (serviceAPI{client}).Delete(context.TODO(), "MG1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (serviceAPI{client}).Delete(ctx, "MG1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+type optionals interface {
+	encode() io.Reader
+}
+
+var optionalsFn = func(m optionals, exp []byte) func(*testing.T) {
+	return func(t *testing.T) {
+		got, err := ioutil.ReadAll(m.encode())
+		if err != nil {
+			t.Errorf("exp parsing err, got %v", err)
+		}
+		if !bytes.Equal(got, exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	}
+}
+
+func TestServiceParamsOptionals(t *testing.T) {
+	exp := []byte("FriendlyName=")
+	t.Run("CreateParams", optionalsFn(ServiceCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(ServiceUpdateParams{}, exp))
+
+	disabled := false
+	exp = []byte("FriendlyName=&StickySender=false")
+	t.Run("CreateParams disabled feature", optionalsFn(ServiceCreateParams{StickySender: &disabled}, exp))
+}
+
+func TestSenderParamsOptionals(t *testing.T) {
+	t.Run("PhoneNumberCreateParams", optionalsFn(PhoneNumberCreateParams{}, []byte("PhoneNumberSid=")))
+	t.Run("ShortCodeCreateParams", optionalsFn(ShortCodeCreateParams{}, []byte("ShortCodeSid=")))
+	t.Run("AlphaSenderCreateParams", optionalsFn(AlphaSenderCreateParams{}, []byte("AlphaSender=")))
+}
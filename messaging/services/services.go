@@ -0,0 +1,58 @@
+// Package services is a client of the Twilio Messaging v1 API, managing messaging
+// services, their sender pools and the US A2P 10DLC compliance registrations.
+package services
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Services messaging v1 interface
+type Services struct {
+	Services           ServiceResource
+	PhoneNumbers       PhoneNumberResource
+	ShortCodes         ShortCodeResource
+	AlphaSenders       AlphaSenderResource
+	BrandRegistrations BrandRegistrationResource
+	UsAppToPerson      UsAppToPersonResource
+}
+
+// New returns a messaging services instance with a base url set to `https://messaging.twilio.com/v1`
+// if `TWILIO_MESSAGING_HOST` not set.
+func New(tctx twilio.Context) (Services, error) {
+	var services Services
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		messagingEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return services, err
+	}
+
+	{
+		services.Services = ServiceResource{serviceAPI{client}}
+		services.PhoneNumbers = PhoneNumberResource{phoneNumberAPI{client}}
+		services.ShortCodes = ShortCodeResource{shortCodeAPI{client}}
+		services.AlphaSenders = AlphaSenderResource{alphaSenderAPI{client}}
+		services.BrandRegistrations = BrandRegistrationResource{brandRegistrationAPI{client}}
+		services.UsAppToPerson = UsAppToPersonResource{usAppToPersonAPI{client}}
+	}
+	return services, nil
+}
+
+func messagingEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_MESSAGING_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://messaging.%s.twilio.com/v1", region)
+	} else if url == "" {
+		return "https://messaging.twilio.com/v1"
+	}
+	return url
+}
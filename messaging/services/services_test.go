@@ -0,0 +1,53 @@
+package services
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("unsuccessful invalid env url", func(t *testing.T) {
+		os.Setenv("TWILIO_MESSAGING_HOST", "%2")
+		if _, err := New(twilio.Context{}); err == nil {
+			t.Errorf("exp parsing err, got none")
+		}
+		os.Unsetenv("TWILIO_MESSAGING_HOST")
+	})
+
+	t.Run("messaging services", func(t *testing.T) {
+		_, err := New(twilio.Context{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestMessagingEndpoint(t *testing.T) {
+	exp := "https://messaging.twilio.com/v1"
+
+	t.Run("default url", func(*testing.T) {
+		if got := messagingEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("default url with region", func(*testing.T) {
+		exp := "https://messaging.uk.twilio.com/v1"
+		if got := messagingEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("env url", func(*testing.T) {
+		os.Setenv("TWILIO_MESSAGING_HOST", exp)
+		if got := messagingEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		if got := messagingEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		os.Unsetenv("TWILIO_MESSAGING_HOST")
+	})
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ShortCodeResource handles interactions with the ShortCodes of Messaging Services REST API.
+type ShortCodeResource struct {
+	shortCodeAPI
+}
+
+// ShortCode is a short code of the account in the sender pool of a service.
+type ShortCode struct {
+	Sid         string `json:"sid"`
+	AccountSid  string `json:"account_sid"`
+	ServiceSid  string `json:"service_sid"`
+	ShortCode   string `json:"short_code"`
+	CountryCode string `json:"country_code"`
+
+	// Capabilities of the sender, eg. SMS, MMS and Voice.
+	Capabilities []string `json:"capabilities"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// ShortCodeList holds a page of short codes of a service.
+type ShortCodeList struct {
+	ShortCodes []ShortCode `json:"short_codes"`
+	Meta       Meta        `json:"meta"`
+}
+
+// ShortCodeCreateParams holds information used in adding a short code of the account to a service.
+// https://www.twilio.com/docs/messaging/api/shortcode-resource#create-a-shortcode-resource
+type ShortCodeCreateParams struct {
+	// ShortCodeSid of the shortcode added to the pool.
+	ShortCodeSid string
+}
+
+func (p ShortCodeCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(p).Encode())
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type shortCodeAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/ShortCodes/{ShortCode SID}
+// https://www.twilio.com/docs/messaging/api/shortcode-resource#fetch-a-shortcode-resource
+func (api shortCodeAPI) Read(ctx context.Context, serviceSid, sid string) (ShortCode, error) {
+	var code ShortCode
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/ShortCodes/%s", serviceSid, sid), &code)
+	return code, err
+}
+
+// GET /Services/{Service SID}/ShortCodes
+// https://www.twilio.com/docs/messaging/api/shortcode-resource#read-multiple-shortcode-resources
+func (api shortCodeAPI) List(ctx context.Context, serviceSid string, params ListParams) (ShortCodeList, error) {
+	var codes ShortCodeList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/ShortCodes%s", serviceSid, twilio.Query(params)), &codes)
+	return codes, err
+}
+
+// POST /Services/{Service SID}/ShortCodes
+// https://www.twilio.com/docs/messaging/api/shortcode-resource#create-a-shortcode-resource
+func (api shortCodeAPI) Add(ctx context.Context, serviceSid string, body ShortCodeCreateParams) (ShortCode, error) {
+	var code ShortCode
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/ShortCodes", serviceSid), body.encode(), &code)
+	return code, err
+}
+
+// DELETE /Services/{Service SID}/ShortCodes/{ShortCode SID}
+// https://www.twilio.com/docs/messaging/api/shortcode-resource#delete-a-shortcode-resource
+func (api shortCodeAPI) Delete(ctx context.Context, serviceSid, sid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/ShortCodes/%s", serviceSid, sid))
+	return err
+}
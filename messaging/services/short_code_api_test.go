@@ -0,0 +1,147 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestShortCodeRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/ShortCodes/XX1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/short_code.json")
+		}
+
+		var (
+			exp  ShortCode
+			f, _ = os.Open("fixtures/short_code.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		sender, err := (shortCodeAPI{client}).Read(context.TODO(), "MG1", "XX1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, sender) {
+			t.Errorf("response diff %v", cmp.Diff(exp, sender))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (shortCodeAPI{client}).Read(ctx, "MG1", "XX1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestShortCodeList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/ShortCodes?PageToken=PA1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/short_codes.json")
+		}
+
+		var (
+			exp  ShortCodeList
+			f, _ = os.Open("fixtures/short_codes.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		senders, err := (shortCodeAPI{client}).List(context.TODO(), "MG1", ListParams{PageToken: "PA1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, senders) {
+			t.Errorf("response diff %v", cmp.Diff(exp, senders))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (shortCodeAPI{client}).List(ctx, "MG1", ListParams{PageToken: "PA1"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestShortCodeAdd(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("ShortCodeSid=SC1")
+			)
+
+			if exp := "/Services/MG1/ShortCodes"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/short_code.json")
+		}
+
+		var (
+			exp  ShortCode
+			f, _ = os.Open("fixtures/short_code.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		sender, err := (shortCodeAPI{client}).Add(context.TODO(), "MG1", ShortCodeCreateParams{ShortCodeSid: "SC1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, sender) {
+			t.Errorf("response diff %v", cmp.Diff(exp, sender))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (shortCodeAPI{client}).Add(ctx, "MG1", ShortCodeCreateParams{ShortCodeSid: "SC1"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestShortCodeDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/ShortCodes/XX1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (shortCodeAPI{client}).Delete(context.TODO(), "MG1", "XX1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (shortCodeAPI{client}).Delete(ctx, "MG1", "XX1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
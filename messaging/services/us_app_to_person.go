@@ -0,0 +1,115 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// UsAppToPersonResource handles interactions with the US A2P 10DLC campaigns of
+// Messaging Services REST API.
+type UsAppToPersonResource struct {
+	usAppToPersonAPI
+}
+
+// UsAppToPerson is a US A2P 10DLC campaign registered for a service, it links the
+// service senders to a brand registration.
+type UsAppToPerson struct {
+	Sid                  string `json:"sid"`
+	AccountSid           string `json:"account_sid"`
+	MessagingServiceSid  string `json:"messaging_service_sid"`
+	BrandRegistrationSid string `json:"brand_registration_sid"`
+	Description          string `json:"description"`
+	MessageFlow          string `json:"message_flow"`
+
+	// MessageSamples between 1 and 5 examples of messages sent by the campaign.
+	MessageSamples         []string        `json:"message_samples"`
+	UsAppToPersonUsecase   string          `json:"us_app_to_person_usecase"`
+	HasEmbeddedLinks       bool            `json:"has_embedded_links"`
+	HasEmbeddedPhone       bool            `json:"has_embedded_phone"`
+	SubscriberOptIn        bool            `json:"subscriber_opt_in"`
+	AgeGated               bool            `json:"age_gated"`
+	DirectLending          bool            `json:"direct_lending"`
+	CampaignStatus         string          `json:"campaign_status"`
+	CampaignID             string          `json:"campaign_id"`
+	IsExternallyRegistered bool            `json:"is_externally_registered"`
+	RateLimits             json.RawMessage `json:"rate_limits"`
+	OptInMessage           string          `json:"opt_in_message"`
+	OptOutMessage          string          `json:"opt_out_message"`
+	HelpMessage            string          `json:"help_message"`
+	OptInKeywords          []string        `json:"opt_in_keywords"`
+	OptOutKeywords         []string        `json:"opt_out_keywords"`
+	HelpKeywords           []string        `json:"help_keywords"`
+	Mock                   bool            `json:"mock"`
+	Errors                 json.RawMessage `json:"errors"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// UsAppToPersonList holds a page of campaigns of a service.
+type UsAppToPersonList struct {
+	Compliance []UsAppToPerson `json:"compliance"`
+	Meta       Meta            `json:"meta"`
+}
+
+// UsAppToPersonCreateParams holds information used in registering a campaign,
+// the embedded links and phone flags are required so they are always sent.
+// https://www.twilio.com/docs/messaging/api/usapptoperson-resource#create-a-usapptoperson-resource
+type UsAppToPersonCreateParams struct {
+	BrandRegistrationSid string
+	Description          string
+	MessageFlow          string
+	MessageSamples       []string
+	UsAppToPersonUsecase string
+	HasEmbeddedLinks     bool
+	HasEmbeddedPhone     bool
+
+	OptInMessage   string   `url:",omitempty"`
+	OptOutMessage  string   `url:",omitempty"`
+	HelpMessage    string   `url:",omitempty"`
+	OptInKeywords  []string `url:",omitempty"`
+	OptOutKeywords []string `url:",omitempty"`
+	HelpKeywords   []string `url:",omitempty"`
+
+	SubscriberOptIn *bool `url:",omitempty"`
+	AgeGated        *bool `url:",omitempty"`
+	DirectLending   *bool `url:",omitempty"`
+}
+
+func (p UsAppToPersonCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(p).Encode())
+}
+
+// UsAppToPersonUpdateParams holds information used in updating a campaign.
+// https://www.twilio.com/docs/messaging/api/usapptoperson-resource#update-a-usapptoperson-resource
+type UsAppToPersonUpdateParams struct {
+	Description    string   `url:",omitempty"`
+	MessageFlow    string   `url:",omitempty"`
+	MessageSamples []string `url:",omitempty"`
+
+	HasEmbeddedLinks *bool `url:",omitempty"`
+	HasEmbeddedPhone *bool `url:",omitempty"`
+	AgeGated         *bool `url:",omitempty"`
+	DirectLending    *bool `url:",omitempty"`
+}
+
+func (p UsAppToPersonUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(p).Encode())
+}
+
+// UsAppToPersonUsecases lists the campaign use cases available to a brand.
+type UsAppToPersonUsecases struct {
+	UsAppToPersonUsecases []struct {
+		Code                 string `json:"code"`
+		Name                 string `json:"name"`
+		Description          string `json:"description"`
+		PostApprovalRequired bool   `json:"post_approval_required"`
+	} `json:"us_app_to_person_usecases"`
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type usAppToPersonAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Compliance/Usa2p/{UsAppToPerson SID}
+// https://www.twilio.com/docs/messaging/api/usapptoperson-resource#fetch-a-usapptoperson-resource
+func (api usAppToPersonAPI) Read(ctx context.Context, serviceSid, sid string) (UsAppToPerson, error) {
+	var campaign UsAppToPerson
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Compliance/Usa2p/%s", serviceSid, sid), &campaign)
+	return campaign, err
+}
+
+// GET /Services/{Service SID}/Compliance/Usa2p
+// https://www.twilio.com/docs/messaging/api/usapptoperson-resource#read-multiple-usapptoperson-resources
+func (api usAppToPersonAPI) List(ctx context.Context, serviceSid string, params ListParams) (UsAppToPersonList, error) {
+	var campaigns UsAppToPersonList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Compliance/Usa2p%s", serviceSid, twilio.Query(params)), &campaigns)
+	return campaigns, err
+}
+
+// POST /Services/{Service SID}/Compliance/Usa2p
+// https://www.twilio.com/docs/messaging/api/usapptoperson-resource#create-a-usapptoperson-resource
+func (api usAppToPersonAPI) Create(ctx context.Context, serviceSid string, body UsAppToPersonCreateParams) (UsAppToPerson, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Compliance/Usa2p", serviceSid), body.encode())
+}
+
+// POST /Services/{Service SID}/Compliance/Usa2p/{UsAppToPerson SID}
+// https://www.twilio.com/docs/messaging/api/usapptoperson-resource#update-a-usapptoperson-resource
+func (api usAppToPersonAPI) Update(ctx context.Context, serviceSid, sid string, body UsAppToPersonUpdateParams) (UsAppToPerson, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Compliance/Usa2p/%s", serviceSid, sid), body.encode())
+}
+
+// DELETE /Services/{Service SID}/Compliance/Usa2p/{UsAppToPerson SID}
+// https://www.twilio.com/docs/messaging/api/usapptoperson-resource#delete-a-usapptoperson-resource
+func (api usAppToPersonAPI) Delete(ctx context.Context, serviceSid, sid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/Compliance/Usa2p/%s", serviceSid, sid))
+	return err
+}
+
+// GET /Services/{Service SID}/Compliance/Usa2p/Usecases?BrandRegistrationSid={BrandRegistration SID}
+// https://www.twilio.com/docs/messaging/api/usapptopersonusecase-resource
+func (api usAppToPersonAPI) Usecases(ctx context.Context, serviceSid, brandRegistrationSid string) (UsAppToPersonUsecases, error) {
+	var usecases UsAppToPersonUsecases
+	q := url.Values{"BrandRegistrationSid": {brandRegistrationSid}}
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Compliance/Usa2p/Usecases?%s", serviceSid, q.Encode()), &usecases)
+	return usecases, err
+}
+
+func (api usAppToPersonAPI) post(ctx context.Context, path string, body io.Reader) (UsAppToPerson, error) {
+	var campaign UsAppToPerson
+	err := twilio.PostInto(ctx, api.client, path, body, &campaign)
+	return campaign, err
+}
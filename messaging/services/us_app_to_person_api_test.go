@@ -0,0 +1,221 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUsAppToPersonRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/Compliance/Usa2p/QE1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/us_app_to_person.json")
+		}
+
+		var (
+			exp  UsAppToPerson
+			f, _ = os.Open("fixtures/us_app_to_person.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		campaign, err := (usAppToPersonAPI{client}).Read(context.TODO(), "MG1", "QE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, campaign) {
+			t.Errorf("response diff %v", cmp.Diff(exp, campaign))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (usAppToPersonAPI{client}).Read(ctx, "MG1", "QE1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestUsAppToPersonList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/Compliance/Usa2p?PageSize=1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/us_app_to_person_list.json")
+		}
+
+		var (
+			exp  UsAppToPersonList
+			f, _ = os.Open("fixtures/us_app_to_person_list.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		campaigns, err := (usAppToPersonAPI{client}).List(context.TODO(), "MG1", ListParams{PageSize: 1})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, campaigns) {
+			t.Errorf("response diff %v", cmp.Diff(exp, campaigns))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (usAppToPersonAPI{client}).List(ctx, "MG1", ListParams{PageSize: 1})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestUsAppToPersonCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("BrandRegistrationSid=BN1&Description=sales&HasEmbeddedLinks=true&HasEmbeddedPhone=false&MessageFlow=web+opt-in&MessageSamples=a&MessageSamples=b&UsAppToPersonUsecase=MARKETING")
+			)
+
+			if exp := "/Services/MG1/Compliance/Usa2p"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/us_app_to_person.json")
+		}
+
+		var (
+			exp  UsAppToPerson
+			f, _ = os.Open("fixtures/us_app_to_person.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		campaign, err := (usAppToPersonAPI{client}).Create(context.TODO(), "MG1", UsAppToPersonCreateParams{BrandRegistrationSid: "BN1", Description: "sales", MessageFlow: "web opt-in", MessageSamples: []string{"a", "b"}, UsAppToPersonUsecase: "MARKETING", HasEmbeddedLinks: true})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, campaign) {
+			t.Errorf("response diff %v", cmp.Diff(exp, campaign))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (usAppToPersonAPI{client}).Create(ctx, "MG1", UsAppToPersonCreateParams{BrandRegistrationSid: "BN1", Description: "sales", MessageFlow: "web opt-in", MessageSamples: []string{"a", "b"}, UsAppToPersonUsecase: "MARKETING", HasEmbeddedLinks: true})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestUsAppToPersonUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Description=flash+sales")
+			)
+
+			if exp := "/Services/MG1/Compliance/Usa2p/QE1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/us_app_to_person.json")
+		}
+
+		var (
+			exp  UsAppToPerson
+			f, _ = os.Open("fixtures/us_app_to_person.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		campaign, err := (usAppToPersonAPI{client}).Update(context.TODO(), "MG1", "QE1", UsAppToPersonUpdateParams{Description: "flash sales"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, campaign) {
+			t.Errorf("response diff %v", cmp.Diff(exp, campaign))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (usAppToPersonAPI{client}).Update(ctx, "MG1", "QE1", UsAppToPersonUpdateParams{Description: "flash sales"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestUsAppToPersonDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/Compliance/Usa2p/QE1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (usAppToPersonAPI{client}).Delete(context.TODO(), "MG1", "QE1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (usAppToPersonAPI{client}).Delete(ctx, "MG1", "QE1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestUsAppToPersonUsecases(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/MG1/Compliance/Usa2p/Usecases?BrandRegistrationSid=BN1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/us_app_to_person_usecases.json")
+		}
+
+		var (
+			exp  UsAppToPersonUsecases
+			f, _ = os.Open("fixtures/us_app_to_person_usecases.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		usecases, err := (usAppToPersonAPI{client}).Usecases(context.TODO(), "MG1", "BN1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, usecases) {
+			t.Errorf("response diff %v", cmp.Diff(exp, usecases))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (usAppToPersonAPI{client}).Usecases(ctx, "MG1", "BN1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
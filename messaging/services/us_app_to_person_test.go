@@ -0,0 +1,10 @@
+package services
+
+import "testing"
+
+func TestUsAppToPersonParamsOptionals(t *testing.T) {
+	exp := []byte("BrandRegistrationSid=&Description=&HasEmbeddedLinks=false&HasEmbeddedPhone=false&MessageFlow=&UsAppToPersonUsecase=")
+	t.Run("CreateParams", optionalsFn(UsAppToPersonCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(UsAppToPersonUpdateParams{}, exp))
+}
@@ -0,0 +1,225 @@
+package messaging
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+// ErrStatusRegression returned when a status callback would move a message back
+// to an earlier status, status callbacks are not guaranteed to arrive in order.
+var ErrStatusRegression = errors.New("messaging: out of order status callback")
+
+// StatusCallback is the request made to the `StatusCallback` url of a message on
+// every change of its delivery status.
+// https://www.twilio.com/docs/messaging/guides/track-outbound-message-status
+type StatusCallback struct {
+	MessageSid          string
+	AccountSid          string
+	MessagingServiceSid string
+	From                string
+	To                  string
+	APIVersion          string
+
+	// MessageStatus can be queued, sending, sent, delivered, undelivered, failed or read.
+	MessageStatus string
+
+	// ErrorCode is set for failed and undelivered messages.
+	ErrorCode int
+
+	// RawDlrDoneDate carrier delivery receipt date, YYMMDDhhmm format.
+	RawDlrDoneDate string
+}
+
+// Err returns the DeliveryError of failed and undelivered messages, nil otherwise.
+func (sc StatusCallback) Err() error {
+	if sc.ErrorCode == 0 {
+		return nil
+	}
+	return DeliveryError{Code: sc.ErrorCode, Message: ErrorDescription(sc.ErrorCode)}
+}
+
+// ParseStatusCallback parses the form params of a status callback request.
+func ParseStatusCallback(params url.Values) (StatusCallback, error) {
+	sc := StatusCallback{
+		MessageSid:          params.Get("MessageSid"),
+		AccountSid:          params.Get("AccountSid"),
+		MessagingServiceSid: params.Get("MessagingServiceSid"),
+		From:                params.Get("From"),
+		To:                  params.Get("To"),
+		APIVersion:          params.Get("ApiVersion"),
+		MessageStatus:       params.Get("MessageStatus"),
+		RawDlrDoneDate:      params.Get("RawDlrDoneDate"),
+	}
+	if sc.MessageSid == "" {
+		// SmsSid is sent along MessageSid for backwards compatibility.
+		sc.MessageSid = params.Get("SmsSid")
+	}
+	if sc.MessageStatus == "" {
+		sc.MessageStatus = params.Get("SmsStatus")
+	}
+	if sc.MessageSid == "" || sc.MessageStatus == "" {
+		return sc, errors.New("messaging: status callback without message sid or status")
+	}
+
+	if code := params.Get("ErrorCode"); code != "" {
+		var err error
+		if sc.ErrorCode, err = strconv.Atoi(code); err != nil {
+			return sc, errors.Wrapf(err, "messaging: invalid error code %q", code)
+		}
+	}
+	return sc, nil
+}
+
+// ParseStatusCallbackRequest validates the signature of a status callback request
+// before parsing it.
+func ParseStatusCallbackRequest(validator twilio.WebhookValidator, r *http.Request) (StatusCallback, error) {
+	params, err := validator.Validate(r)
+	if err != nil {
+		return StatusCallback{}, err
+	}
+	return ParseStatusCallback(params)
+}
+
+// DeliveryError is the error code of a failed or undelivered message.
+type DeliveryError struct {
+	Code    int
+	Message string
+}
+
+func (e DeliveryError) Error() string {
+	return fmt.Sprintf("messaging: %d, %s", e.Code, e.Message)
+}
+
+// Retryable reports whether sending the message again may succeed, the other
+// errors are caused by the recipient, the content or the account configuration.
+func (e DeliveryError) Retryable() bool {
+	return retryableErrorCodes[e.Code]
+}
+
+// ErrorDescription returns the description of a messaging error code.
+// https://www.twilio.com/docs/api/errors
+func ErrorDescription(code int) string {
+	if desc, ok := errorCodes[code]; ok {
+		return desc
+	}
+	return "Unknown error code"
+}
+
+var errorCodes = map[int]string{
+	21211: "Invalid 'To' phone number",
+	21408: "Permission to send an SMS has not been enabled for the region",
+	21610: "Attempt to send to unsubscribed recipient",
+	21611: "This 'From' number has exceeded the maximum number of queued messages",
+	21612: "The 'To' phone number is not currently reachable via SMS",
+	21614: "'To' number is not a valid mobile number",
+	30001: "Queue overflow",
+	30002: "Account suspended",
+	30003: "Unreachable destination handset",
+	30004: "Message blocked",
+	30005: "Unknown destination handset",
+	30006: "Landline or unreachable carrier",
+	30007: "Message filtered",
+	30008: "Unknown error",
+	30009: "Missing segment",
+	30010: "Message price exceeds max price",
+	30034: "Message from an unregistered number",
+	30035: "Message from a number that is still being registered",
+	30036: "Validity period expired",
+}
+
+var retryableErrorCodes = map[int]bool{
+	21611: true,
+	30001: true,
+	30003: true,
+	30008: true,
+	30009: true,
+	30035: true,
+	30036: true,
+}
+
+// statusRank orders the delivery statuses, a message moves only to a status of a
+// higher rank. Statuses sharing a rank are final outcomes of a message.
+var statusRank = map[string]int{
+	StatusAccepted:           0,
+	StatusScheduled:          0,
+	StatusQueued:             1,
+	StatusSending:            2,
+	StatusSent:               3,
+	StatusDelivered:          4,
+	StatusUndelivered:        4,
+	StatusFailed:             4,
+	StatusCanceled:           4,
+	StatusPartiallyDelivered: 4,
+	StatusRead:               5,
+}
+
+// CanTransition reports whether a message can move from a status to another,
+// failed, undelivered and canceled messages never change status again. Unknown
+// statuses cannot be ordered, only an untracked status moves to them.
+func CanTransition(from, to string) bool {
+	fromRank, ok := statusRank[from]
+	if !ok {
+		return true
+	}
+	toRank, ok := statusRank[to]
+	if !ok {
+		return false
+	}
+
+	switch from {
+	case StatusFailed, StatusUndelivered, StatusCanceled:
+		return false
+	}
+	return toRank > fromRank
+}
+
+// Tracker tracks the delivery status per message sid, it is safe for concurrent use.
+type Tracker struct {
+	mu       sync.Mutex
+	statuses map[string]string
+}
+
+// NewTracker returns an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{statuses: make(map[string]string)}
+}
+
+// Apply moves the message of the callback to its status. Duplicated callbacks are
+// ignored and report no change, regressions return ErrStatusRegression.
+func (t *Tracker) Apply(sc StatusCallback) (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current, ok := t.statuses[sc.MessageSid]
+	if ok && current == sc.MessageStatus {
+		return false, nil
+	}
+	if ok && !CanTransition(current, sc.MessageStatus) {
+		return false, errors.Wrapf(ErrStatusRegression, "%s: %s to %s", sc.MessageSid, current, sc.MessageStatus)
+	}
+	t.statuses[sc.MessageSid] = sc.MessageStatus
+	return true, nil
+}
+
+// Status returns the last status of a message, false if untracked.
+func (t *Tracker) Status(messageSid string) (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status, ok := t.statuses[messageSid]
+	return status, ok
+}
+
+// Forget stops tracking a message, usually once it reached a final status.
+func (t *Tracker) Forget(messageSid string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.statuses, messageSid)
+}
@@ -0,0 +1,219 @@
+package messaging
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+func TestParseStatusCallback(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		params := url.Values{
+			"MessageSid":          {"SM1"},
+			"SmsSid":              {"SM1"},
+			"AccountSid":          {"AC1"},
+			"MessagingServiceSid": {"MG1"},
+			"From":                {"+14155552345"},
+			"To":                  {"+14155552346"},
+			"ApiVersion":          {"2010-04-01"},
+			"MessageStatus":       {"undelivered"},
+			"SmsStatus":           {"undelivered"},
+			"ErrorCode":           {"30003"},
+		}
+		exp := StatusCallback{
+			MessageSid:          "SM1",
+			AccountSid:          "AC1",
+			MessagingServiceSid: "MG1",
+			From:                "+14155552345",
+			To:                  "+14155552346",
+			APIVersion:          "2010-04-01",
+			MessageStatus:       StatusUndelivered,
+			ErrorCode:           30003,
+		}
+
+		sc, err := ParseStatusCallback(params)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, sc) {
+			t.Errorf("response diff %v", cmp.Diff(exp, sc))
+		}
+
+		derr, ok := sc.Err().(DeliveryError)
+		if !ok {
+			t.Fatalf("exp DeliveryError, got %v", sc.Err())
+		}
+		if exp := "Unreachable destination handset"; exp != derr.Message {
+			t.Errorf("exp %s, got %s", exp, derr.Message)
+		}
+		if !derr.Retryable() {
+			t.Error("exp retryable error")
+		}
+	})
+
+	t.Run("legacy params", func(t *testing.T) {
+		sc, err := ParseStatusCallback(url.Values{"SmsSid": {"SM1"}, "SmsStatus": {"sent"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if sc.MessageSid != "SM1" || sc.MessageStatus != StatusSent {
+			t.Errorf("exp SM1 sent, got %s %s", sc.MessageSid, sc.MessageStatus)
+		}
+		if sc.Err() != nil {
+			t.Errorf("exp no delivery err, got %v", sc.Err())
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, params := range []url.Values{
+			{"MessageStatus": {"sent"}},
+			{"MessageSid": {"SM1"}},
+			{"MessageSid": {"SM1"}, "MessageStatus": {"failed"}, "ErrorCode": {"3000x"}},
+		} {
+			if _, err := ParseStatusCallback(params); err == nil {
+				t.Errorf("exp err for %v, got none", params)
+			}
+		}
+	})
+}
+
+func TestParseStatusCallbackRequest(t *testing.T) {
+	var (
+		callbackURL = "https://example.com/status"
+		params      = url.Values{"MessageSid": {"SM1"}, "MessageStatus": {"delivered"}}
+		validator   = twilio.WebhookValidator{AuthToken: "12345"}
+	)
+	newRequest := func(signature string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, callbackURL, strings.NewReader(params.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set(twilio.SignatureHeader, signature)
+		return r
+	}
+
+	sc, err := ParseStatusCallbackRequest(validator, newRequest(twilio.Signature("12345", callbackURL, params)))
+	if err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if sc.MessageStatus != StatusDelivered {
+		t.Errorf("exp %s, got %s", StatusDelivered, sc.MessageStatus)
+	}
+
+	if _, err := ParseStatusCallbackRequest(validator, newRequest("forged")); err != twilio.ErrInvalidSignature {
+		t.Errorf("exp %v, got %v", twilio.ErrInvalidSignature, err)
+	}
+}
+
+func TestErrorDescription(t *testing.T) {
+	if exp, got := "Message filtered", ErrorDescription(30007); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+	if exp, got := "Unknown error code", ErrorDescription(1); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+	if (DeliveryError{Code: 30007}).Retryable() {
+		t.Error("exp filtered message not retryable")
+	}
+}
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		from, to string
+		exp      bool
+	}{
+		{StatusQueued, StatusSent, true},
+		{StatusSent, StatusDelivered, true},
+		{StatusDelivered, StatusRead, true},
+		{StatusQueued, StatusFailed, true},
+		{StatusScheduled, StatusCanceled, true},
+		{StatusSent, StatusQueued, false},
+		{StatusDelivered, StatusSent, false},
+		{StatusDelivered, StatusUndelivered, false},
+		{StatusUndelivered, StatusRead, false},
+		{StatusFailed, StatusDelivered, false},
+		{"", StatusQueued, true},
+		{StatusSent, "unknown", false},
+	}
+	for _, c := range cases {
+		if got := CanTransition(c.from, c.to); got != c.exp {
+			t.Errorf("exp %s to %s %v, got %v", c.from, c.to, c.exp, got)
+		}
+	}
+}
+
+func TestTracker(t *testing.T) {
+	t.Run("in order", func(t *testing.T) {
+		tracker := NewTracker()
+		for _, status := range []string{StatusQueued, StatusSent, StatusDelivered, StatusRead} {
+			changed, err := tracker.Apply(StatusCallback{MessageSid: "SM1", MessageStatus: status})
+			if err != nil {
+				t.Errorf("exp no err, got %v", err)
+			}
+			if !changed {
+				t.Errorf("exp %s applied", status)
+			}
+		}
+		if status, _ := tracker.Status("SM1"); status != StatusRead {
+			t.Errorf("exp %s, got %s", StatusRead, status)
+		}
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		tracker := NewTracker()
+		tracker.Apply(StatusCallback{MessageSid: "SM1", MessageStatus: StatusDelivered})
+
+		changed, err := tracker.Apply(StatusCallback{MessageSid: "SM1", MessageStatus: StatusSent})
+		if errors.Cause(err) != ErrStatusRegression {
+			t.Errorf("exp %v, got %v", ErrStatusRegression, err)
+		}
+		if changed {
+			t.Error("exp regression not applied")
+		}
+		if status, _ := tracker.Status("SM1"); status != StatusDelivered {
+			t.Errorf("exp %s, got %s", StatusDelivered, status)
+		}
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		tracker := NewTracker()
+		tracker.Apply(StatusCallback{MessageSid: "SM1", MessageStatus: StatusSent})
+
+		changed, err := tracker.Apply(StatusCallback{MessageSid: "SM1", MessageStatus: StatusSent})
+		if err != nil || changed {
+			t.Errorf("exp duplicate ignored, got %v %v", changed, err)
+		}
+	})
+
+	t.Run("forget", func(t *testing.T) {
+		tracker := NewTracker()
+		tracker.Apply(StatusCallback{MessageSid: "SM1", MessageStatus: StatusFailed})
+		tracker.Forget("SM1")
+		if _, ok := tracker.Status("SM1"); ok {
+			t.Error("exp untracked message")
+		}
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var (
+			tracker = NewTracker()
+			wg      sync.WaitGroup
+		)
+		for _, status := range []string{StatusQueued, StatusSending, StatusSent, StatusDelivered} {
+			wg.Add(1)
+			go func(status string) {
+				defer wg.Done()
+				tracker.Apply(StatusCallback{MessageSid: "SM1", MessageStatus: status})
+			}(status)
+		}
+		wg.Wait()
+		if _, ok := tracker.Status("SM1"); !ok {
+			t.Error("exp tracked message")
+		}
+	})
+}
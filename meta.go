@@ -0,0 +1,74 @@
+package twilio
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Meta stores the paging information of a list of the v1 and v2 APIs.
+type Meta struct {
+	Page            int    `json:"page"`
+	PageSize        int    `json:"page_size"`
+	FirstPageURL    string `json:"first_page_url"`
+	PreviousPageURL string `json:"previous_page_url"`
+	URL             string `json:"url"`
+	NextPageURL     string `json:"next_page_url"`
+	Key             string `json:"key"`
+}
+
+// Next returns the params used in listing the next page, false on the last page.
+func (m Meta) Next() (ListParams, bool) {
+	return nextPage(m.NextPageURL)
+}
+
+// Meta2010 stores the paging information of a list of the 2010-04-01 API, returned
+// alongside the resources.
+type Meta2010 struct {
+	Page            int    `json:"page"`
+	PageSize        int    `json:"page_size"`
+	Start           int    `json:"start"`
+	End             int    `json:"end"`
+	URI             string `json:"uri"`
+	FirstPageURI    string `json:"first_page_uri"`
+	PreviousPageURI string `json:"previous_page_uri"`
+	NextPageURI     string `json:"next_page_uri"`
+}
+
+// Next returns the params used in listing the next page, false on the last page.
+func (m Meta2010) Next() (ListParams, bool) {
+	return nextPage(m.NextPageURI)
+}
+
+// ListParams holds the paging information used in listing resources.
+type ListParams struct {
+	// PageSize number of resources per page, max 100, or 1000 on the 2010-04-01 API.
+	// Default 50.
+	PageSize  int    `url:",omitempty"`
+	Page      int    `url:",omitempty"`
+	PageToken string `url:",omitempty"`
+}
+
+// nextPage returns the paging params of the next page url, false without one.
+func nextPage(next string) (ListParams, bool) {
+	if next == "" {
+		return ListParams{}, false
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return ListParams{}, false
+	}
+
+	query := u.Query()
+	params := ListParams{PageToken: query.Get("PageToken")}
+	params.Page, _ = strconv.Atoi(query.Get("Page"))
+	params.PageSize, _ = strconv.Atoi(query.Get("PageSize"))
+	return params, true
+}
+
+// Query returns the encoded params prefixed by `?`, empty if no params are set.
+func Query(v interface{}) string {
+	if q := Values(v).Encode(); q != "" {
+		return "?" + q
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package twilio
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMetaNext(t *testing.T) {
+	tt := map[string]struct {
+		meta interface {
+			Next() (ListParams, bool)
+		}
+		exp ListParams
+	}{
+		"v1 next page":   {Meta{NextPageURL: "https://conversations.twilio.com/v1/Conversations?PageSize=50&Page=1&PageToken=PT1"}, ListParams{PageSize: 50, Page: 1, PageToken: "PT1"}},
+		"2010 next page": {Meta2010{NextPageURI: "/2010-04-01/Accounts/AC1/Calls.json?To=%2B123&PageSize=50&Page=1&PageToken=PA1"}, ListParams{PageSize: 50, Page: 1, PageToken: "PA1"}},
+	}
+	for name, tc := range tt {
+		params, ok := tc.meta.Next()
+		if !ok {
+			t.Fatalf("%s: exp next page", name)
+		}
+		if !cmp.Equal(tc.exp, params) {
+			t.Errorf("%s: params diff %v", name, cmp.Diff(tc.exp, params))
+		}
+	}
+
+	if _, ok := (Meta{}).Next(); ok {
+		t.Error("exp no next page")
+	}
+	if _, ok := (Meta2010{NextPageURI: "%2"}).Next(); ok {
+		t.Error("exp no next page")
+	}
+}
+
+func TestQuery(t *testing.T) {
+	if exp, got := "", Query(ListParams{}); exp != got {
+		t.Errorf("exp query %q, got %q", exp, got)
+	}
+	if exp, got := "?Page=2&PageSize=10", Query(ListParams{PageSize: 10, Page: 2}); exp != got {
+		t.Errorf("exp query %q, got %q", exp, got)
+	}
+}
@@ -0,0 +1,91 @@
+package notify
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// BindingResource handles interactions with Notify Bindings REST API.
+type BindingResource struct {
+	bindingAPI
+}
+
+// Binding types.
+const (
+	BindingTypeAPN               = "apn"
+	BindingTypeFCM               = "fcm"
+	BindingTypeSMS               = "sms"
+	BindingTypeFacebookMessenger = "facebook-messenger"
+)
+
+// Binding is an address notifications are sent to on behalf of an identity, eg. the
+// device token of an apn binding or the phone number of an sms binding.
+type Binding struct {
+	Sid           string   `json:"sid"`
+	AccountSid    string   `json:"account_sid"`
+	ServiceSid    string   `json:"service_sid"`
+	CredentialSid string   `json:"credential_sid"`
+	Identity      string   `json:"identity"`
+	BindingType   string   `json:"binding_type"`
+	Address       string   `json:"address"`
+	Endpoint      string   `json:"endpoint"`
+	Tags          []string `json:"tags"`
+
+	NotificationProtocolVersion string `json:"notification_protocol_version"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		User string `json:"user"`
+	} `json:"links"`
+}
+
+// BindingList holds a page of bindings.
+type BindingList struct {
+	Bindings []Binding `json:"bindings"`
+	Meta     Meta      `json:"meta"`
+}
+
+// BindingListParams holds information used in filtering the bindings listed.
+// https://www.twilio.com/docs/notify/api/binding-resource#read-multiple-binding-resources
+type BindingListParams struct {
+	ListParams
+
+	Identity []string `url:",omitempty"`
+	Tag      []string `url:",omitempty"`
+
+	// StartDate and EndDate YYYY-MM-DD format.
+	StartDate string `url:",omitempty"`
+	EndDate   string `url:",omitempty"`
+}
+
+func (p BindingListParams) query() string {
+	return twilio.Query(p)
+}
+
+// BindingCreateParams holds information used in creating a new binding, a binding
+// with the same address replaces the existing one.
+// https://www.twilio.com/docs/notify/api/binding-resource#create-a-binding-resource
+type BindingCreateParams struct {
+	Identity    string
+	BindingType string
+	Address     string
+
+	// Tag up to 20 tags the binding is targeted by.
+	Tag                         []string `url:",omitempty"`
+	NotificationProtocolVersion string   `url:",omitempty"`
+
+	// CredentialSid overrides the push credential of the service.
+	CredentialSid string `url:",omitempty"`
+	Endpoint      string `url:",omitempty"`
+}
+
+func (bcp BindingCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(bcp).Encode())
+}
@@ -0,0 +1,43 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type bindingAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Bindings/{Binding SID}
+// https://www.twilio.com/docs/notify/api/binding-resource#fetch-a-binding-resource
+func (api bindingAPI) Read(ctx context.Context, serviceSid, bindingSid string) (Binding, error) {
+	var bind Binding
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Bindings/%s", serviceSid, bindingSid), &bind)
+	return bind, err
+}
+
+// GET /Services/{Service SID}/Bindings
+// https://www.twilio.com/docs/notify/api/binding-resource#read-multiple-binding-resources
+func (api bindingAPI) List(ctx context.Context, serviceSid string, params BindingListParams) (BindingList, error) {
+	var binds BindingList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Bindings", serviceSid)+params.query(), &binds)
+	return binds, err
+}
+
+// POST /Services/{Service SID}/Bindings
+// https://www.twilio.com/docs/notify/api/binding-resource#create-a-binding-resource
+func (api bindingAPI) Create(ctx context.Context, serviceSid string, body BindingCreateParams) (Binding, error) {
+	var bind Binding
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Bindings", serviceSid), body.encode(), &bind)
+	return bind, err
+}
+
+// DELETE /Services/{Service SID}/Bindings/{Binding SID}
+// https://www.twilio.com/docs/notify/api/binding-resource#delete-a-binding-resource
+func (api bindingAPI) Delete(ctx context.Context, serviceSid, bindingSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/Bindings/%s", serviceSid, bindingSid))
+	return err
+}
@@ -0,0 +1,147 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBindingRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Bindings/BS1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/binding.json")
+		}
+
+		var (
+			exp  Binding
+			f, _ = os.Open("fixtures/binding.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		bind, err := (bindingAPI{client}).Read(context.TODO(), "IS1", "BS1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, bind) {
+			t.Errorf("response diff %v", cmp.Diff(exp, bind))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (bindingAPI{client}).Read(ctx, "IS1", "BS1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestBindingList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Bindings?Identity=alice&Tag=premium"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/bindings.json")
+		}
+
+		var (
+			exp  BindingList
+			f, _ = os.Open("fixtures/bindings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		binds, err := (bindingAPI{client}).List(context.TODO(), "IS1", BindingListParams{Identity: []string{"alice"}, Tag: []string{"premium"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, binds) {
+			t.Errorf("response diff %v", cmp.Diff(exp, binds))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (bindingAPI{client}).List(ctx, "IS1", BindingListParams{Identity: []string{"alice"}, Tag: []string{"premium"}})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestBindingCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Address=a7c4ed2b4e0c3d2f&BindingType=apn&Identity=alice&Tag=premium&Tag=ios")
+			)
+
+			if exp := "/Services/IS1/Bindings"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/binding.json")
+		}
+
+		var (
+			exp  Binding
+			f, _ = os.Open("fixtures/binding.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		bind, err := (bindingAPI{client}).Create(context.TODO(), "IS1", BindingCreateParams{Identity: "alice", BindingType: BindingTypeAPN, Address: "a7c4ed2b4e0c3d2f", Tag: []string{"premium", "ios"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, bind) {
+			t.Errorf("response diff %v", cmp.Diff(exp, bind))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (bindingAPI{client}).Create(ctx, "IS1", BindingCreateParams{Identity: "alice", BindingType: BindingTypeAPN, Address: "a7c4ed2b4e0c3d2f", Tag: []string{"premium", "ios"}})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestBindingDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Bindings/BS1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (bindingAPI{client}).Delete(context.TODO(), "IS1", "BS1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (bindingAPI{client}).Delete(ctx, "IS1", "BS1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
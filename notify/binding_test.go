@@ -0,0 +1,18 @@
+package notify
+
+import "testing"
+
+func TestBindingParamsOptionals(t *testing.T) {
+	exp := []byte("Address=&BindingType=&Identity=")
+	t.Run("CreateParams", optionalsFn(BindingCreateParams{}, exp))
+}
+
+func TestBindingListParamsQuery(t *testing.T) {
+	if exp, got := "", (BindingListParams{}).query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+	params := BindingListParams{Identity: []string{"alice", "bob"}, StartDate: "2020-01-01"}
+	if exp, got := "?Identity=alice&Identity=bob&StartDate=2020-01-01", params.query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
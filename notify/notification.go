@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+// ErrMissingTarget returned when a notification has no identity, tag, segment or binding.
+var ErrMissingTarget = errors.New("notify: notification without a target")
+
+// NotificationResource handles interactions with Notify Notifications REST API.
+type NotificationResource struct {
+	notificationAPI
+}
+
+// Notification priorities.
+const (
+	PriorityHigh = "high"
+	PriorityLow  = "low"
+)
+
+// TagAll targets every binding of a service.
+const TagAll = "all"
+
+// Notification is sent to every binding of its identities, tags and segments.
+type Notification struct {
+	Sid        string   `json:"sid"`
+	AccountSid string   `json:"account_sid"`
+	ServiceSid string   `json:"service_sid"`
+	Identities []string `json:"identities"`
+	Tags       []string `json:"tags"`
+	Segments   []string `json:"segments"`
+	Priority   string   `json:"priority"`
+	TTL        int      `json:"ttl"`
+	Title      string   `json:"title"`
+	Body       string   `json:"body"`
+	Sound      string   `json:"sound"`
+	Action     string   `json:"action"`
+
+	// Data custom key values delivered with the push notifications.
+	Data json.RawMessage `json:"data"`
+
+	// Apn, Fcm, Sms and FacebookMessenger payload overrides of each channel.
+	Apn               json.RawMessage `json:"apn"`
+	Fcm               json.RawMessage `json:"fcm"`
+	Sms               json.RawMessage `json:"sms"`
+	FacebookMessenger json.RawMessage `json:"facebook_messenger"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+}
+
+// NotificationCreateParams holds the content and the targets of a notification, at
+// least one of Identity, Tag, Segment or ToBinding is required.
+// https://www.twilio.com/docs/notify/api/notification-resource#create-a-notification-resource
+type NotificationCreateParams struct {
+	// Identity up to 20 identities.
+	Identity []string `url:",omitempty"`
+
+	// Tag up to 5 tags, TagAll targets every binding.
+	Tag     []string `url:",omitempty"`
+	Segment []string `url:",omitempty"`
+
+	// ToBinding JSON objects of the bindings targeted without being stored, eg.
+	// `{"binding_type":"sms","address":"+15555555555"}`.
+	ToBinding []string `url:",omitempty"`
+
+	Body     string `url:",omitempty"`
+	Title    string `url:",omitempty"`
+	Priority string `url:",omitempty"`
+
+	// TTL seconds the notification is valid for delivery, max 2419200. Default 2419200.
+	TTL    int             `url:"Ttl,omitempty"`
+	Sound  string          `url:",omitempty"`
+	Action string          `url:",omitempty"`
+	Data   json.RawMessage `url:",omitempty"`
+
+	// Apn, Fcm, Sms and FacebookMessenger override the payload generated for each
+	// channel, eg. `{"aps": {"badge": 1}}` for apn.
+	// https://www.twilio.com/docs/notify/api/notification-resource#specify-type-specific-payloads
+	Apn               json.RawMessage `url:",omitempty"`
+	Fcm               json.RawMessage `url:",omitempty"`
+	Sms               json.RawMessage `url:",omitempty"`
+	FacebookMessenger json.RawMessage `url:",omitempty"`
+
+	DeliveryCallbackURL string `url:"DeliveryCallbackUrl,omitempty"`
+}
+
+func (ncp NotificationCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ncp).Encode())
+}
+
+// targeted reports whether the notification has at least one target.
+func (ncp NotificationCreateParams) targeted() bool {
+	return len(ncp.Identity)+len(ncp.Tag)+len(ncp.Segment)+len(ncp.ToBinding) > 0
+}
@@ -0,0 +1,25 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type notificationAPI struct {
+	client twilio.HTTPClient
+}
+
+// Create sends a notification to its targets, the delivery to each binding is
+// asynchronous and reported to the delivery callback.
+// POST /Services/{Service SID}/Notifications
+// https://www.twilio.com/docs/notify/api/notification-resource#create-a-notification-resource
+func (api notificationAPI) Create(ctx context.Context, serviceSid string, body NotificationCreateParams) (Notification, error) {
+	var notif Notification
+	if !body.targeted() {
+		return notif, ErrMissingTarget
+	}
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Notifications", serviceSid), body.encode(), &notif)
+	return notif, err
+}
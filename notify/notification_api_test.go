@@ -0,0 +1,65 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNotificationCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Apn=%7B%22aps%22%3A%7B%22badge%22%3A1%7D%7D&Body=50%25+off+today&Tag=premium&Title=Sale")
+			)
+
+			if exp := "/Services/IS1/Notifications"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/notification.json")
+		}
+
+		var (
+			exp  Notification
+			f, _ = os.Open("fixtures/notification.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		notif, err := (notificationAPI{client}).Create(context.TODO(), "IS1", NotificationCreateParams{Tag: []string{"premium"}, Title: "Sale", Body: "50% off today", Apn: []byte(`{"aps":{"badge":1}}`)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, notif) {
+			t.Errorf("response diff %v", cmp.Diff(exp, notif))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (notificationAPI{client}).Create(ctx, "IS1", NotificationCreateParams{Tag: []string{"premium"}, Title: "Sale", Body: "50% off today", Apn: []byte(`{"aps":{"badge":1}}`)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestNotificationCreateWithoutTarget(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+		t.Error("exp no request")
+		return nil, nil
+	}
+	if _, err := (notificationAPI{client}).Create(context.TODO(), "IS1", NotificationCreateParams{Body: "hi"}); err != ErrMissingTarget {
+		t.Errorf("exp err %v, got %v", ErrMissingTarget, err)
+	}
+}
@@ -0,0 +1,33 @@
+package notify
+
+import "testing"
+
+func TestNotificationParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("CreateParams", optionalsFn(NotificationCreateParams{}, exp))
+
+	params := NotificationCreateParams{
+		ToBinding: []string{`{"binding_type":"sms","address":"+15555555555"}`},
+		Sms:       []byte(`{"body":"hi"}`),
+	}
+	exp = []byte("Sms=%7B%22body%22%3A%22hi%22%7D&ToBinding=%7B%22binding_type%22%3A%22sms%22%2C%22address%22%3A%22%2B15555555555%22%7D")
+	t.Run("CreateParams to binding", optionalsFn(params, exp))
+}
+
+func TestNotificationTargeted(t *testing.T) {
+	tt := map[string]struct {
+		params NotificationCreateParams
+		exp    bool
+	}{
+		"none":       {NotificationCreateParams{Body: "hi"}, false},
+		"identity":   {NotificationCreateParams{Identity: []string{"alice"}}, true},
+		"tag":        {NotificationCreateParams{Tag: []string{TagAll}}, true},
+		"segment":    {NotificationCreateParams{Segment: []string{"vip"}}, true},
+		"to binding": {NotificationCreateParams{ToBinding: []string{`{}`}}, true},
+	}
+	for name, tc := range tt {
+		if got := tc.params.targeted(); tc.exp != got {
+			t.Errorf("%s: exp %v, got %v", name, tc.exp, got)
+		}
+	}
+}
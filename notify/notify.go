@@ -0,0 +1,52 @@
+// Package notify is a client of the Twilio Notify v1 API, sending notifications over
+// apn, fcm, sms and facebook messenger to the bindings of identities, tags or segments.
+package notify
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Notify notify v1 interface
+type Notify struct {
+	Services      ServiceResource
+	Bindings      BindingResource
+	Notifications NotificationResource
+}
+
+// New returns a notify instance with a base url set to `https://notify.twilio.com/v1`
+// if `TWILIO_NOTIFY_HOST` not set.
+func New(tctx twilio.Context) (Notify, error) {
+	var notify Notify
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		notifyEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return notify, err
+	}
+
+	{
+		notify.Services = ServiceResource{serviceAPI{client}}
+		notify.Bindings = BindingResource{bindingAPI{client}}
+		notify.Notifications = NotificationResource{notificationAPI{client}}
+	}
+	return notify, nil
+}
+
+func notifyEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_NOTIFY_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://notify.%s.twilio.com/v1", region)
+	} else if url == "" {
+		return "https://notify.twilio.com/v1"
+	}
+	return url
+}
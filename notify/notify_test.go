@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("unsuccessful invalid env url", func(t *testing.T) {
+		os.Setenv("TWILIO_NOTIFY_HOST", "%2")
+		if _, err := New(twilio.Context{}); err == nil {
+			t.Errorf("exp parsing err, got none")
+		}
+		os.Unsetenv("TWILIO_NOTIFY_HOST")
+	})
+
+	t.Run("notify", func(t *testing.T) {
+		_, err := New(twilio.Context{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestNotifyEndpoint(t *testing.T) {
+	exp := "https://notify.twilio.com/v1"
+
+	t.Run("default url", func(*testing.T) {
+		if got := notifyEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("default url with region", func(*testing.T) {
+		exp := "https://notify.uk.twilio.com/v1"
+		if got := notifyEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("env url", func(*testing.T) {
+		os.Setenv("TWILIO_NOTIFY_HOST", exp)
+		if got := notifyEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		if got := notifyEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		os.Unsetenv("TWILIO_NOTIFY_HOST")
+	})
+}
@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ServiceResource handles interactions with Notify Services REST API.
+type ServiceResource struct {
+	serviceAPI
+}
+
+// Service holds the credentials of the channels its notifications are sent over.
+// The push credentials are shared by the account, the credentials created with
+// chat.CredentialResource are used as is.
+type Service struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+
+	// ApnCredentialSid and FcmCredentialSid of the push credentials, CR prefixed.
+	ApnCredentialSid string `json:"apn_credential_sid"`
+	FcmCredentialSid string `json:"fcm_credential_sid"`
+
+	// MessagingServiceSid sends the notifications of the sms bindings.
+	MessagingServiceSid                   string `json:"messaging_service_sid"`
+	FacebookMessengerPageID               string `json:"facebook_messenger_page_id"`
+	DefaultApnNotificationProtocolVersion string `json:"default_apn_notification_protocol_version"`
+	DefaultFcmNotificationProtocolVersion string `json:"default_fcm_notification_protocol_version"`
+	LogEnabled                            bool   `json:"log_enabled"`
+	DeliveryCallbackURL                   string `json:"delivery_callback_url"`
+	DeliveryCallbackEnabled               bool   `json:"delivery_callback_enabled"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Bindings      string `json:"bindings"`
+		Notifications string `json:"notifications"`
+		Segments      string `json:"segments"`
+		Users         string `json:"users"`
+	} `json:"links"`
+}
+
+// ServiceList holds a page of services.
+type ServiceList struct {
+	Services []Service `json:"services"`
+	Meta     Meta      `json:"meta"`
+}
+
+// ServiceListParams holds information used in filtering the services listed.
+// https://www.twilio.com/docs/notify/api/service-resource#read-multiple-service-resources
+type ServiceListParams struct {
+	ListParams
+
+	FriendlyName string `url:",omitempty"`
+}
+
+func (p ServiceListParams) query() string {
+	return twilio.Query(p)
+}
+
+// ServiceCreateParams holds information used in creating a new service.
+// https://www.twilio.com/docs/notify/api/service-resource#create-a-service-resource
+type ServiceCreateParams struct {
+	FriendlyName                          string `url:",omitempty"`
+	ApnCredentialSid                      string `url:",omitempty"`
+	FcmCredentialSid                      string `url:",omitempty"`
+	MessagingServiceSid                   string `url:",omitempty"`
+	FacebookMessengerPageID               string `url:"FacebookMessengerPageId,omitempty"`
+	DefaultApnNotificationProtocolVersion string `url:",omitempty"`
+	DefaultFcmNotificationProtocolVersion string `url:",omitempty"`
+	LogEnabled                            *bool  `url:",omitempty"`
+	DeliveryCallbackURL                   string `url:"DeliveryCallbackUrl,omitempty"`
+	DeliveryCallbackEnabled               *bool  `url:",omitempty"`
+}
+
+func (scp ServiceCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scp).Encode())
+}
+
+// ServiceUpdateParams holds information used in updating an existing service.
+// https://www.twilio.com/docs/notify/api/service-resource#update-a-service-resource
+type ServiceUpdateParams struct {
+	FriendlyName                          string `url:",omitempty"`
+	ApnCredentialSid                      string `url:",omitempty"`
+	FcmCredentialSid                      string `url:",omitempty"`
+	MessagingServiceSid                   string `url:",omitempty"`
+	FacebookMessengerPageID               string `url:"FacebookMessengerPageId,omitempty"`
+	DefaultApnNotificationProtocolVersion string `url:",omitempty"`
+	DefaultFcmNotificationProtocolVersion string `url:",omitempty"`
+	LogEnabled                            *bool  `url:",omitempty"`
+	DeliveryCallbackURL                   string `url:"DeliveryCallbackUrl,omitempty"`
+	DeliveryCallbackEnabled               *bool  `url:",omitempty"`
+}
+
+func (sup ServiceUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(sup).Encode())
+}
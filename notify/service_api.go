@@ -0,0 +1,54 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type serviceAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}
+// https://www.twilio.com/docs/notify/api/service-resource#fetch-a-service-resource
+func (api serviceAPI) Read(ctx context.Context, serviceSid string) (Service, error) {
+	var svc Service
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s", serviceSid), &svc)
+	return svc, err
+}
+
+// GET /Services
+// https://www.twilio.com/docs/notify/api/service-resource#read-multiple-service-resources
+func (api serviceAPI) List(ctx context.Context, params ServiceListParams) (ServiceList, error) {
+	var svcs ServiceList
+	err := twilio.GetInto(ctx, api.client, "/Services"+params.query(), &svcs)
+	return svcs, err
+}
+
+// POST /Services
+// https://www.twilio.com/docs/notify/api/service-resource#create-a-service-resource
+func (api serviceAPI) Create(ctx context.Context, body ServiceCreateParams) (Service, error) {
+	return api.post(ctx, "/Services", body.encode())
+}
+
+// POST /Services/{Service SID}
+// https://www.twilio.com/docs/notify/api/service-resource#update-a-service-resource
+func (api serviceAPI) Update(ctx context.Context, serviceSid string, body ServiceUpdateParams) (Service, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s", serviceSid), body.encode())
+}
+
+// DELETE /Services/{Service SID}
+// https://www.twilio.com/docs/notify/api/service-resource#delete-a-service-resource
+func (api serviceAPI) Delete(ctx context.Context, serviceSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s", serviceSid))
+	return err
+}
+
+func (api serviceAPI) post(ctx context.Context, path string, body io.Reader) (Service, error) {
+	var svc Service
+	err := twilio.PostInto(ctx, api.client, path, body, &svc)
+	return svc, err
+}
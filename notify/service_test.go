@@ -0,0 +1,34 @@
+package notify
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+type optionals interface {
+	encode() io.Reader
+}
+
+var optionalsFn = func(m optionals, exp []byte) func(*testing.T) {
+	return func(t *testing.T) {
+		got, err := ioutil.ReadAll(m.encode())
+		if err != nil {
+			t.Errorf("exp parsing err, got %v", err)
+		}
+		if !bytes.Equal(got, exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	}
+}
+
+func TestServiceParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("CreateParams", optionalsFn(ServiceCreateParams{}, exp))
+	t.Run("UpdateParams", optionalsFn(ServiceUpdateParams{}, exp))
+
+	disabled := false
+	exp = []byte("LogEnabled=false")
+	t.Run("UpdateParams disabled", optionalsFn(ServiceUpdateParams{LogEnabled: &disabled}, exp))
+}
@@ -0,0 +1,182 @@
+package twilio
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Channel prefixes of the addresses accepted in place of a phone number.
+const (
+	ChannelWhatsApp  = "whatsapp"
+	ChannelMessenger = "messenger"
+)
+
+// Errors returned when parsing phone numbers.
+var (
+	ErrInvalidPhoneNumber  = errors.New("twilio: invalid phone number")
+	ErrInvalidCountryCode  = errors.New("twilio: invalid phone number country code")
+	ErrPhoneNumberTooShort = errors.New("twilio: phone number too short")
+	ErrPhoneNumberTooLong  = errors.New("twilio: phone number too long")
+	ErrMissingRegion       = errors.New("twilio: national phone number without a region")
+)
+
+// maxE164Digits the max digits of an E.164 number, including the country code.
+const maxE164Digits = 15
+
+// PhoneNumber is a phone number normalised to E.164, with an optional channel
+// prefix. It is encoded by Values as its String, eg. `whatsapp:+14155238886`.
+type PhoneNumber struct {
+	// Channel is empty for sms and voice, ChannelWhatsApp or ChannelMessenger.
+	Channel string
+
+	// Number in E.164 format, or the user id of a messenger address.
+	Number string
+}
+
+// ParsePhoneNumber parses and validates user input, numbers in national format are
+// resolved with the ISO-3166 alpha-2 default region, eg. `ParsePhoneNumber("020 7946 0018", "GB")`.
+// Formatting characters are ignored and international numbers may start with `+` or `00`,
+// only the calling codes of geographic regions are valid.
+func ParsePhoneNumber(s, defaultRegion string) (PhoneNumber, error) {
+	var number PhoneNumber
+
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, ":"); i != -1 {
+		number.Channel = strings.ToLower(s[:i])
+		s = strings.TrimSpace(s[i+1:])
+	}
+
+	switch number.Channel {
+	case "":
+	case ChannelWhatsApp:
+	case ChannelMessenger:
+		// messenger addresses hold a page scoped user id instead of a number
+		if s == "" {
+			return PhoneNumber{}, ErrInvalidPhoneNumber
+		}
+		number.Number = s
+		return number, nil
+	default:
+		return PhoneNumber{}, errors.Errorf("twilio: unknown phone number channel %q", number.Channel)
+	}
+
+	e164, err := normalise(s, defaultRegion)
+	if err != nil {
+		return PhoneNumber{}, err
+	}
+	number.Number = e164
+	return number, nil
+}
+
+// MustParsePhoneNumber is like ParsePhoneNumber but panics on invalid numbers, it
+// simplifies the initialisation of numbers known to be valid.
+func MustParsePhoneNumber(s, defaultRegion string) PhoneNumber {
+	number, err := ParsePhoneNumber(s, defaultRegion)
+	if err != nil {
+		panic(err)
+	}
+	return number
+}
+
+// String returns the number prefixed by its channel.
+func (p PhoneNumber) String() string {
+	if p.Channel == "" {
+		return p.Number
+	}
+	return p.Channel + ":" + p.Number
+}
+
+// CountryCode returns the calling country code of the number, eg. `44`.
+func (p PhoneNumber) CountryCode() string {
+	if cc, ok := lookupCountryCode(strings.TrimPrefix(p.Number, "+")); ok {
+		return cc
+	}
+	return ""
+}
+
+// Region returns the main ISO-3166 alpha-2 region of the country code, eg. `US` for `+1`.
+func (p PhoneNumber) Region() string {
+	if m, ok := countryCodes[p.CountryCode()]; ok {
+		return m.regions[0]
+	}
+	return ""
+}
+
+// IsZero reports whether the number is not set.
+func (p PhoneNumber) IsZero() bool {
+	return p.Number == ""
+}
+
+func (p PhoneNumber) encodeValue() string {
+	return p.String()
+}
+
+func normalise(s, defaultRegion string) (string, error) {
+	var (
+		digits        strings.Builder
+		international bool
+	)
+	// the trunk prefix is often kept in brackets, eg. `+44 (0)20 7946 0018`
+	s = strings.Replace(s, "(0)", "", 1)
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			international = true
+		case strings.ContainsRune(" -.()/", r):
+		default:
+			return "", ErrInvalidPhoneNumber
+		}
+	}
+
+	number := digits.String()
+	if !international && strings.HasPrefix(number, "00") {
+		international, number = true, number[2:]
+	}
+	if number == "" {
+		return "", ErrInvalidPhoneNumber
+	}
+
+	if !international {
+		if defaultRegion == "" {
+			return "", ErrMissingRegion
+		}
+		cc, ok := regionCodes[strings.ToUpper(defaultRegion)]
+		if !ok {
+			return "", ErrInvalidCountryCode
+		}
+		if prefix := countryCodes[cc].trunkPrefix; prefix != "" {
+			number = strings.TrimPrefix(number, prefix)
+		}
+		number = cc + number
+	}
+
+	if len(number) > maxE164Digits {
+		return "", ErrPhoneNumberTooLong
+	}
+
+	cc, ok := lookupCountryCode(number)
+	if !ok {
+		return "", ErrInvalidCountryCode
+	}
+	switch m, national := countryCodes[cc], len(number)-len(cc); {
+	case national < m.minLength:
+		return "", ErrPhoneNumberTooShort
+	case national > m.maxLength:
+		return "", ErrPhoneNumberTooLong
+	}
+	return "+" + number, nil
+}
+
+// lookupCountryCode returns the calling code prefixing the digits, the codes are
+// prefix free so at most one of the 1 to 3 digits prefixes is assigned.
+func lookupCountryCode(digits string) (string, bool) {
+	for i := 1; i <= 3 && i <= len(digits); i++ {
+		if _, ok := countryCodes[digits[:i]]; ok {
+			return digits[:i], true
+		}
+	}
+	return "", false
+}
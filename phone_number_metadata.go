@@ -0,0 +1,235 @@
+package twilio
+
+// countryMetadata describes the numbering plan of a calling country code.
+type countryMetadata struct {
+	// regions ISO-3166 alpha-2 codes sharing the calling code, the main region first.
+	regions []string
+
+	// trunkPrefix dialled before national numbers, dropped in E.164.
+	trunkPrefix string
+
+	// minLength and maxLength bound the digits of the national significant number.
+	minLength, maxLength int
+}
+
+// countryCodes holds the calling codes assigned to geographic regions, with the
+// lengths of their national numbers from the ITU-T E.164 assignments.
+var countryCodes = map[string]countryMetadata{
+	"1":   {[]string{"US", "CA", "AG", "AI", "AS", "BB", "BM", "BS", "DM", "DO", "GD", "GU", "JM", "KN", "KY", "LC", "MP", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI"}, "1", 10, 10},
+	"7":   {[]string{"RU", "KZ"}, "8", 10, 10},
+	"20":  {[]string{"EG"}, "0", 7, 10},
+	"211": {[]string{"SS"}, "0", 9, 9},
+	"212": {[]string{"MA", "EH"}, "0", 9, 9},
+	"213": {[]string{"DZ"}, "0", 8, 9},
+	"216": {[]string{"TN"}, "", 8, 8},
+	"218": {[]string{"LY"}, "0", 8, 9},
+	"220": {[]string{"GM"}, "", 7, 7},
+	"221": {[]string{"SN"}, "", 9, 9},
+	"222": {[]string{"MR"}, "", 8, 8},
+	"223": {[]string{"ML"}, "", 8, 8},
+	"224": {[]string{"GN"}, "", 8, 9},
+	"225": {[]string{"CI"}, "", 8, 10},
+	"226": {[]string{"BF"}, "", 8, 8},
+	"227": {[]string{"NE"}, "", 8, 8},
+	"228": {[]string{"TG"}, "", 8, 8},
+	"229": {[]string{"BJ"}, "", 8, 10},
+	"230": {[]string{"MU"}, "", 7, 8},
+	"231": {[]string{"LR"}, "0", 7, 9},
+	"232": {[]string{"SL"}, "0", 8, 8},
+	"233": {[]string{"GH"}, "0", 9, 9},
+	"234": {[]string{"NG"}, "0", 7, 10},
+	"235": {[]string{"TD"}, "", 8, 8},
+	"236": {[]string{"CF"}, "", 8, 8},
+	"237": {[]string{"CM"}, "", 8, 9},
+	"238": {[]string{"CV"}, "", 7, 7},
+	"239": {[]string{"ST"}, "", 7, 7},
+	"240": {[]string{"GQ"}, "", 9, 9},
+	"241": {[]string{"GA"}, "0", 7, 8},
+	"242": {[]string{"CG"}, "", 9, 9},
+	"243": {[]string{"CD"}, "0", 7, 9},
+	"244": {[]string{"AO"}, "", 9, 9},
+	"245": {[]string{"GW"}, "", 7, 9},
+	"246": {[]string{"IO"}, "", 7, 7},
+	"247": {[]string{"AC"}, "", 4, 6},
+	"248": {[]string{"SC"}, "", 7, 7},
+	"249": {[]string{"SD"}, "0", 9, 9},
+	"250": {[]string{"RW"}, "0", 8, 9},
+	"251": {[]string{"ET"}, "0", 9, 9},
+	"252": {[]string{"SO"}, "0", 7, 9},
+	"253": {[]string{"DJ"}, "", 8, 8},
+	"254": {[]string{"KE"}, "0", 7, 10},
+	"255": {[]string{"TZ"}, "0", 9, 9},
+	"256": {[]string{"UG"}, "0", 9, 9},
+	"257": {[]string{"BI"}, "", 8, 8},
+	"258": {[]string{"MZ"}, "", 8, 9},
+	"260": {[]string{"ZM"}, "0", 9, 9},
+	"261": {[]string{"MG"}, "0", 9, 9},
+	"262": {[]string{"RE", "YT"}, "0", 9, 9},
+	"263": {[]string{"ZW"}, "0", 5, 10},
+	"264": {[]string{"NA"}, "0", 8, 10},
+	"265": {[]string{"MW"}, "0", 7, 9},
+	"266": {[]string{"LS"}, "", 8, 8},
+	"267": {[]string{"BW"}, "", 7, 8},
+	"268": {[]string{"SZ"}, "", 8, 8},
+	"269": {[]string{"KM"}, "", 7, 7},
+	"27":  {[]string{"ZA"}, "0", 9, 9},
+	"290": {[]string{"SH", "TA"}, "", 4, 5},
+	"291": {[]string{"ER"}, "0", 7, 7},
+	"297": {[]string{"AW"}, "", 7, 7},
+	"298": {[]string{"FO"}, "", 6, 6},
+	"299": {[]string{"GL"}, "", 6, 6},
+	"30":  {[]string{"GR"}, "", 10, 10},
+	"31":  {[]string{"NL"}, "0", 9, 11},
+	"32":  {[]string{"BE"}, "0", 8, 9},
+	"33":  {[]string{"FR"}, "0", 9, 9},
+	"34":  {[]string{"ES"}, "", 9, 9},
+	"350": {[]string{"GI"}, "", 8, 8},
+	"351": {[]string{"PT"}, "", 9, 9},
+	"352": {[]string{"LU"}, "", 4, 11},
+	"353": {[]string{"IE"}, "0", 7, 10},
+	"354": {[]string{"IS"}, "", 7, 9},
+	"355": {[]string{"AL"}, "0", 6, 9},
+	"356": {[]string{"MT"}, "", 8, 8},
+	"357": {[]string{"CY"}, "", 8, 8},
+	"358": {[]string{"FI", "AX"}, "0", 5, 12},
+	"359": {[]string{"BG"}, "0", 6, 9},
+	"36":  {[]string{"HU"}, "06", 8, 9},
+	"370": {[]string{"LT"}, "8", 8, 8},
+	"371": {[]string{"LV"}, "", 8, 8},
+	"372": {[]string{"EE"}, "", 7, 10},
+	"373": {[]string{"MD"}, "0", 8, 8},
+	"374": {[]string{"AM"}, "0", 8, 8},
+	"375": {[]string{"BY"}, "8", 9, 10},
+	"376": {[]string{"AD"}, "", 6, 9},
+	"377": {[]string{"MC"}, "0", 8, 9},
+	"378": {[]string{"SM"}, "", 6, 10},
+	"380": {[]string{"UA"}, "0", 9, 9},
+	"381": {[]string{"RS"}, "0", 6, 12},
+	"382": {[]string{"ME"}, "0", 8, 8},
+	"383": {[]string{"XK"}, "0", 8, 9},
+	"385": {[]string{"HR"}, "0", 6, 9},
+	"386": {[]string{"SI"}, "0", 8, 8},
+	"387": {[]string{"BA"}, "0", 8, 9},
+	"389": {[]string{"MK"}, "0", 8, 8},
+	"39":  {[]string{"IT", "VA"}, "", 6, 11},
+	"40":  {[]string{"RO"}, "0", 9, 9},
+	"41":  {[]string{"CH"}, "0", 9, 9},
+	"420": {[]string{"CZ"}, "", 9, 12},
+	"421": {[]string{"SK"}, "0", 6, 9},
+	"423": {[]string{"LI"}, "", 7, 9},
+	"43":  {[]string{"AT"}, "0", 4, 13},
+	"44":  {[]string{"GB", "GG", "IM", "JE"}, "0", 7, 10},
+	"45":  {[]string{"DK"}, "", 8, 8},
+	"46":  {[]string{"SE"}, "0", 7, 13},
+	"47":  {[]string{"NO", "SJ"}, "", 5, 8},
+	"48":  {[]string{"PL"}, "", 9, 9},
+	"49":  {[]string{"DE"}, "0", 5, 15},
+	"500": {[]string{"FK"}, "", 5, 5},
+	"501": {[]string{"BZ"}, "", 7, 7},
+	"502": {[]string{"GT"}, "", 8, 8},
+	"503": {[]string{"SV"}, "", 7, 8},
+	"504": {[]string{"HN"}, "", 8, 8},
+	"505": {[]string{"NI"}, "", 8, 8},
+	"506": {[]string{"CR"}, "", 8, 8},
+	"507": {[]string{"PA"}, "", 7, 8},
+	"508": {[]string{"PM"}, "", 6, 6},
+	"509": {[]string{"HT"}, "", 8, 8},
+	"51":  {[]string{"PE"}, "0", 8, 9},
+	"52":  {[]string{"MX"}, "", 10, 10},
+	"53":  {[]string{"CU"}, "0", 6, 8},
+	"54":  {[]string{"AR"}, "0", 10, 11},
+	"55":  {[]string{"BR"}, "0", 8, 11},
+	"56":  {[]string{"CL"}, "", 9, 9},
+	"57":  {[]string{"CO"}, "0", 8, 10},
+	"58":  {[]string{"VE"}, "0", 10, 10},
+	"590": {[]string{"GP", "BL", "MF"}, "0", 9, 9},
+	"591": {[]string{"BO"}, "0", 8, 8},
+	"592": {[]string{"GY"}, "", 7, 7},
+	"593": {[]string{"EC"}, "0", 8, 9},
+	"594": {[]string{"GF"}, "0", 9, 9},
+	"595": {[]string{"PY"}, "0", 6, 9},
+	"596": {[]string{"MQ"}, "0", 9, 9},
+	"597": {[]string{"SR"}, "", 6, 7},
+	"598": {[]string{"UY"}, "0", 8, 8},
+	"599": {[]string{"CW", "BQ"}, "", 7, 8},
+	"60":  {[]string{"MY"}, "0", 8, 10},
+	"61":  {[]string{"AU", "CC", "CX"}, "0", 9, 9},
+	"62":  {[]string{"ID"}, "0", 7, 12},
+	"63":  {[]string{"PH"}, "0", 8, 10},
+	"64":  {[]string{"NZ"}, "0", 8, 10},
+	"65":  {[]string{"SG"}, "", 8, 8},
+	"66":  {[]string{"TH"}, "0", 8, 9},
+	"670": {[]string{"TL"}, "", 7, 8},
+	"672": {[]string{"NF"}, "", 5, 6},
+	"673": {[]string{"BN"}, "", 7, 7},
+	"674": {[]string{"NR"}, "", 7, 7},
+	"675": {[]string{"PG"}, "", 7, 8},
+	"676": {[]string{"TO"}, "", 5, 7},
+	"677": {[]string{"SB"}, "", 5, 7},
+	"678": {[]string{"VU"}, "", 5, 7},
+	"679": {[]string{"FJ"}, "", 7, 7},
+	"680": {[]string{"PW"}, "", 7, 7},
+	"681": {[]string{"WF"}, "", 6, 6},
+	"682": {[]string{"CK"}, "", 5, 5},
+	"683": {[]string{"NU"}, "", 4, 7},
+	"685": {[]string{"WS"}, "", 5, 10},
+	"686": {[]string{"KI"}, "0", 5, 8},
+	"687": {[]string{"NC"}, "", 6, 6},
+	"688": {[]string{"TV"}, "", 5, 7},
+	"689": {[]string{"PF"}, "", 6, 8},
+	"690": {[]string{"TK"}, "", 4, 7},
+	"691": {[]string{"FM"}, "", 7, 7},
+	"692": {[]string{"MH"}, "1", 7, 7},
+	"81":  {[]string{"JP"}, "0", 9, 10},
+	"82":  {[]string{"KR"}, "0", 8, 10},
+	"84":  {[]string{"VN"}, "0", 9, 10},
+	"850": {[]string{"KP"}, "0", 8, 10},
+	"852": {[]string{"HK"}, "", 8, 8},
+	"853": {[]string{"MO"}, "", 8, 8},
+	"855": {[]string{"KH"}, "0", 8, 9},
+	"856": {[]string{"LA"}, "0", 8, 10},
+	"86":  {[]string{"CN"}, "0", 9, 11},
+	"880": {[]string{"BD"}, "0", 8, 10},
+	"886": {[]string{"TW"}, "0", 8, 9},
+	"90":  {[]string{"TR"}, "0", 10, 10},
+	"91":  {[]string{"IN"}, "0", 10, 10},
+	"92":  {[]string{"PK"}, "0", 9, 10},
+	"93":  {[]string{"AF"}, "0", 9, 9},
+	"94":  {[]string{"LK"}, "0", 9, 9},
+	"95":  {[]string{"MM"}, "0", 7, 10},
+	"960": {[]string{"MV"}, "", 7, 7},
+	"961": {[]string{"LB"}, "0", 7, 8},
+	"962": {[]string{"JO"}, "0", 8, 9},
+	"963": {[]string{"SY"}, "0", 8, 9},
+	"964": {[]string{"IQ"}, "0", 8, 10},
+	"965": {[]string{"KW"}, "", 8, 8},
+	"966": {[]string{"SA"}, "0", 9, 9},
+	"967": {[]string{"YE"}, "0", 7, 9},
+	"968": {[]string{"OM"}, "", 8, 8},
+	"970": {[]string{"PS"}, "0", 8, 9},
+	"971": {[]string{"AE"}, "0", 8, 9},
+	"972": {[]string{"IL"}, "0", 8, 9},
+	"973": {[]string{"BH"}, "", 8, 8},
+	"974": {[]string{"QA"}, "", 7, 8},
+	"975": {[]string{"BT"}, "", 7, 8},
+	"976": {[]string{"MN"}, "0", 8, 8},
+	"977": {[]string{"NP"}, "0", 8, 10},
+	"98":  {[]string{"IR"}, "0", 10, 10},
+	"992": {[]string{"TJ"}, "", 9, 9},
+	"993": {[]string{"TM"}, "8", 8, 8},
+	"994": {[]string{"AZ"}, "0", 9, 9},
+	"995": {[]string{"GE"}, "0", 9, 9},
+	"996": {[]string{"KG"}, "0", 9, 9},
+	"998": {[]string{"UZ"}, "", 9, 9},
+}
+
+// regionCodes maps a region to its calling code.
+var regionCodes = func() map[string]string {
+	codes := make(map[string]string)
+	for cc, m := range countryCodes {
+		for _, region := range m.regions {
+			codes[region] = cc
+		}
+	}
+	return codes
+}()
@@ -0,0 +1,84 @@
+package twilio
+
+import (
+	"testing"
+)
+
+func TestParsePhoneNumber(t *testing.T) {
+	tt := map[string]struct {
+		in, region string
+		exp        PhoneNumber
+		err        error
+	}{
+		"e164":                {"+14155238886", "", PhoneNumber{Number: "+14155238886"}, nil},
+		"formatted":           {"+1 (415) 523-8886", "GB", PhoneNumber{Number: "+14155238886"}, nil},
+		"international 00":    {"0044 20 7946 0018", "", PhoneNumber{Number: "+442079460018"}, nil},
+		"bracketed trunk":     {"+44 (0)20 7946 0018", "", PhoneNumber{Number: "+442079460018"}, nil},
+		"national":            {"020 7946 0018", "gb", PhoneNumber{Number: "+442079460018"}, nil},
+		"national nanp":       {"415.523.8886", "US", PhoneNumber{Number: "+14155238886"}, nil},
+		"national no trunk":   {"06 6982 1234", "IT", PhoneNumber{Number: "+390669821234"}, nil},
+		"whatsapp":            {"WhatsApp:+14155238886", "", PhoneNumber{Channel: ChannelWhatsApp, Number: "+14155238886"}, nil},
+		"messenger":           {"messenger:1234567890", "", PhoneNumber{Channel: ChannelMessenger, Number: "1234567890"}, nil},
+		"missing region":      {"020 7946 0018", "", PhoneNumber{}, ErrMissingRegion},
+		"unknown region":      {"020 7946 0018", "ZZ", PhoneNumber{}, ErrInvalidCountryCode},
+		"invalid country":     {"+2891234567", "", PhoneNumber{}, ErrInvalidCountryCode},
+		"too short":           {"+1415523", "", PhoneNumber{}, ErrPhoneNumberTooShort},
+		"too long":            {"+4420794600181", "", PhoneNumber{}, ErrPhoneNumberTooLong},
+		"too long e164":       {"+4912345678901234", "", PhoneNumber{}, ErrPhoneNumberTooLong},
+		"letters":             {"+1 415 CALL NOW", "", PhoneNumber{}, ErrInvalidPhoneNumber},
+		"empty":               {"", "US", PhoneNumber{}, ErrInvalidPhoneNumber},
+		"empty messenger":     {"messenger:", "", PhoneNumber{}, ErrInvalidPhoneNumber},
+		"plus not as a first": {"1+4155238886", "", PhoneNumber{}, ErrInvalidPhoneNumber},
+	}
+	for name, tc := range tt {
+		got, err := ParsePhoneNumber(tc.in, tc.region)
+		if err != tc.err {
+			t.Errorf("%s: exp err %v, got %v", name, tc.err, err)
+		}
+		if got != tc.exp {
+			t.Errorf("%s: exp %+v, got %+v", name, tc.exp, got)
+		}
+	}
+
+	if _, err := ParsePhoneNumber("sms:+14155238886", ""); err == nil {
+		t.Errorf("exp unknown channel err, got none")
+	}
+}
+
+func TestMustParsePhoneNumber(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("exp panic, got none")
+		}
+	}()
+	MustParsePhoneNumber("+1", "")
+}
+
+func TestPhoneNumber(t *testing.T) {
+	number := MustParsePhoneNumber("whatsapp:+447400123456", "")
+	if exp, got := "whatsapp:+447400123456", number.String(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+	if exp, got := "44", number.CountryCode(); exp != got {
+		t.Errorf("exp country code %s, got %s", exp, got)
+	}
+	if exp, got := "GB", number.Region(); exp != got {
+		t.Errorf("exp region %s, got %s", exp, got)
+	}
+	if number.IsZero() || !(PhoneNumber{}).IsZero() {
+		t.Errorf("exp only the empty number to be zero")
+	}
+	if exp, got := "", (PhoneNumber{}).Region(); exp != got {
+		t.Errorf("exp region %s, got %s", exp, got)
+	}
+}
+
+func TestCountryCodesPrefixFree(t *testing.T) {
+	for a := range countryCodes {
+		for b := range countryCodes {
+			if a != b && len(b) > len(a) && b[:len(a)] == a {
+				t.Errorf("calling code %s prefixes %s", a, b)
+			}
+		}
+	}
+}
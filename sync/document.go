@@ -0,0 +1,75 @@
+package sync
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// DocumentResource handles interactions with Sync Documents REST API.
+type DocumentResource struct {
+	documentAPI
+}
+
+// Document holds a JSON object of up to 16KB, its revision changes with every update.
+type Document struct {
+	Sid        string          `json:"sid"`
+	UniqueName string          `json:"unique_name"`
+	AccountSid string          `json:"account_sid"`
+	ServiceSid string          `json:"service_sid"`
+	Revision   string          `json:"revision"`
+	Data       json.RawMessage `json:"data"`
+
+	// CreatedBy identity of the creator, `system` for the REST API.
+	CreatedBy string `json:"created_by"`
+
+	// DateExpires ISO-8601 format, empty for documents without a ttl.
+	DateExpires string `json:"date_expires"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Permissions string `json:"permissions"`
+	} `json:"links"`
+}
+
+// DocumentList holds a page of documents.
+type DocumentList struct {
+	Documents []Document `json:"documents"`
+	Meta      Meta       `json:"meta"`
+}
+
+// DocumentCreateParams holds information used in creating a new document.
+// https://www.twilio.com/docs/sync/api/document-resource#create-a-document-resource
+type DocumentCreateParams struct {
+	UniqueName string          `url:",omitempty"`
+	Data       json.RawMessage `url:",omitempty"`
+
+	// TTL seconds before the document expires, 0 never expires.
+	TTL int `url:"Ttl,omitempty"`
+}
+
+func (dcp DocumentCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(dcp).Encode())
+}
+
+// DocumentUpdateParams holds information used in updating an existing document.
+// https://www.twilio.com/docs/sync/api/document-resource#update-a-document-resource
+type DocumentUpdateParams struct {
+	Data json.RawMessage `url:",omitempty"`
+	TTL  int             `url:"Ttl,omitempty"`
+
+	// Revision the update is made against, sent as `If-Match`. The update fails
+	// with a revision mismatch if the document changed since, empty always updates.
+	Revision string `url:"-"`
+}
+
+func (dup DocumentUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(dup).Encode())
+}
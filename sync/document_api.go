@@ -0,0 +1,60 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type documentAPI struct {
+	client twilio.HTTPClient
+}
+
+// Read returns a document by its sid or unique name.
+// GET /Services/{Service SID}/Documents/{Document SID}
+// https://www.twilio.com/docs/sync/api/document-resource#fetch-a-document-resource
+func (api documentAPI) Read(ctx context.Context, serviceSid, documentSid string) (Document, error) {
+	var doc Document
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Documents/%s", serviceSid, documentSid), &doc)
+	return doc, err
+}
+
+// GET /Services/{Service SID}/Documents
+// https://www.twilio.com/docs/sync/api/document-resource#read-multiple-document-resources
+func (api documentAPI) List(ctx context.Context, serviceSid string, params ListParams) (DocumentList, error) {
+	var docs DocumentList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Documents", serviceSid)+twilio.Query(params), &docs)
+	return docs, err
+}
+
+// POST /Services/{Service SID}/Documents
+// https://www.twilio.com/docs/sync/api/document-resource#create-a-document-resource
+func (api documentAPI) Create(ctx context.Context, serviceSid string, body DocumentCreateParams) (Document, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Documents", serviceSid), nil, body.encode())
+}
+
+// Update replaces the data of a document, with the revision of body set the update
+// fails unless it is the current revision, see IsRevisionMismatch.
+// POST /Services/{Service SID}/Documents/{Document SID}
+// https://www.twilio.com/docs/sync/api/document-resource#update-a-document-resource
+func (api documentAPI) Update(ctx context.Context, serviceSid, documentSid string, body DocumentUpdateParams) (Document, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Documents/%s", serviceSid, documentSid), ifMatch(body.Revision), body.encode())
+}
+
+// Delete removes a document, with a revision set it fails unless it is the current
+// revision.
+// DELETE /Services/{Service SID}/Documents/{Document SID}
+// https://www.twilio.com/docs/sync/api/document-resource#delete-a-document-resource
+func (api documentAPI) Delete(ctx context.Context, serviceSid, documentSid, revision string) error {
+	_, err := twilio.DeleteWithHeader(ctx, api.client, fmt.Sprintf("/Services/%s/Documents/%s", serviceSid, documentSid), ifMatch(revision))
+	return err
+}
+
+func (api documentAPI) post(ctx context.Context, path string, header http.Header, body io.Reader) (Document, error) {
+	var doc Document
+	err := postInto(ctx, api.client, path, header, body, &doc)
+	return doc, err
+}
@@ -0,0 +1,194 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDocumentRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Documents/ET1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/document.json")
+		}
+
+		var (
+			exp  Document
+			f, _ = os.Open("fixtures/document.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		doc, err := (documentAPI{client}).Read(context.TODO(), "IS1", "ET1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, doc) {
+			t.Errorf("response diff %v", cmp.Diff(exp, doc))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (documentAPI{client}).Read(ctx, "IS1", "ET1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestDocumentList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Documents"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/documents.json")
+		}
+
+		var (
+			exp  DocumentList
+			f, _ = os.Open("fixtures/documents.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		docs, err := (documentAPI{client}).List(context.TODO(), "IS1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, docs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, docs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (documentAPI{client}).List(ctx, "IS1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestDocumentCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Data=%7B%22status%22%3A%22online%22%7D&UniqueName=presence%3Aalice")
+			)
+
+			if exp := "/Services/IS1/Documents"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/document.json")
+		}
+
+		var (
+			exp  Document
+			f, _ = os.Open("fixtures/document.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		doc, err := (documentAPI{client}).Create(context.TODO(), "IS1", DocumentCreateParams{UniqueName: "presence:alice", Data: []byte(`{"status":"online"}`)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, doc) {
+			t.Errorf("response diff %v", cmp.Diff(exp, doc))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (documentAPI{client}).Create(ctx, "IS1", DocumentCreateParams{UniqueName: "presence:alice", Data: []byte(`{"status":"online"}`)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestDocumentUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Data=%7B%22status%22%3A%22away%22%7D")
+			)
+
+			if exp := "/Services/IS1/Documents/ET1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/document.json")
+		}
+
+		var (
+			exp  Document
+			f, _ = os.Open("fixtures/document.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		doc, err := (documentAPI{client}).Update(context.TODO(), "IS1", "ET1", DocumentUpdateParams{Data: []byte(`{"status":"away"}`), Revision: "2"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, doc) {
+			t.Errorf("response diff %v", cmp.Diff(exp, doc))
+		}
+		if exp, got := "2", client.Header.Get("If-Match"); exp != got {
+			t.Errorf("exp If-Match %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (documentAPI{client}).Update(ctx, "IS1", "ET1", DocumentUpdateParams{Data: []byte(`{"status":"away"}`), Revision: "2"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestDocumentDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Documents/ET1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (documentAPI{client}).Delete(context.TODO(), "IS1", "ET1", "3"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+		if exp, got := "3", client.Header.Get("If-Match"); exp != got {
+			t.Errorf("exp If-Match %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (documentAPI{client}).Delete(ctx, "IS1", "ET1", "3")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,9 @@
+package sync
+
+import "testing"
+
+func TestDocumentParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("CreateParams", optionalsFn(DocumentCreateParams{}, exp))
+	t.Run("UpdateParams", optionalsFn(DocumentUpdateParams{Revision: "1"}, exp))
+}
@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ListResource handles interactions with Sync Lists REST API.
+type ListResource struct {
+	listAPI
+}
+
+// List holds an ordered collection of items, its revision changes with every
+// change of its items.
+type List struct {
+	Sid        string `json:"sid"`
+	UniqueName string `json:"unique_name"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+	Revision   string `json:"revision"`
+	CreatedBy  string `json:"created_by"`
+
+	// DateExpires ISO-8601 format, empty for lists without a ttl.
+	DateExpires string `json:"date_expires"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Items       string `json:"items"`
+		Permissions string `json:"permissions"`
+	} `json:"links"`
+}
+
+// ListList holds a page of lists.
+type ListList struct {
+	Lists []List `json:"lists"`
+	Meta  Meta   `json:"meta"`
+}
+
+// ListCreateParams holds information used in creating a new list.
+// https://www.twilio.com/docs/sync/api/list-resource#create-a-list-resource
+type ListCreateParams struct {
+	UniqueName string `url:",omitempty"`
+
+	// CollectionTTL seconds before the list and its items expire, 0 never expires.
+	CollectionTTL int `url:"CollectionTtl,omitempty"`
+}
+
+func (lcp ListCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(lcp).Encode())
+}
+
+// ListUpdateParams holds information used in updating an existing list.
+// https://www.twilio.com/docs/sync/api/list-resource#update-a-list-resource
+type ListUpdateParams struct {
+	CollectionTTL int `url:"CollectionTtl,omitempty"`
+}
+
+func (lup ListUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(lup).Encode())
+}
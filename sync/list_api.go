@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type listAPI struct {
+	client twilio.HTTPClient
+}
+
+// Read returns a list by its sid or unique name.
+// GET /Services/{Service SID}/Lists/{List SID}
+// https://www.twilio.com/docs/sync/api/list-resource#fetch-a-list-resource
+func (api listAPI) Read(ctx context.Context, serviceSid, listSid string) (List, error) {
+	var list List
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Lists/%s", serviceSid, listSid), &list)
+	return list, err
+}
+
+// GET /Services/{Service SID}/Lists
+// https://www.twilio.com/docs/sync/api/list-resource#read-multiple-list-resources
+func (api listAPI) List(ctx context.Context, serviceSid string, params ListParams) (ListList, error) {
+	var lists ListList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Lists", serviceSid)+twilio.Query(params), &lists)
+	return lists, err
+}
+
+// POST /Services/{Service SID}/Lists
+// https://www.twilio.com/docs/sync/api/list-resource#create-a-list-resource
+func (api listAPI) Create(ctx context.Context, serviceSid string, body ListCreateParams) (List, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Lists", serviceSid), body.encode())
+}
+
+// POST /Services/{Service SID}/Lists/{List SID}
+// https://www.twilio.com/docs/sync/api/list-resource#update-a-list-resource
+func (api listAPI) Update(ctx context.Context, serviceSid, listSid string, body ListUpdateParams) (List, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Lists/%s", serviceSid, listSid), body.encode())
+}
+
+// DELETE /Services/{Service SID}/Lists/{List SID}
+// https://www.twilio.com/docs/sync/api/list-resource#delete-a-list-resource
+func (api listAPI) Delete(ctx context.Context, serviceSid, listSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/Lists/%s", serviceSid, listSid))
+	return err
+}
+
+func (api listAPI) post(ctx context.Context, path string, body io.Reader) (List, error) {
+	var list List
+	err := twilio.PostInto(ctx, api.client, path, body, &list)
+	return list, err
+}
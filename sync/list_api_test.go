@@ -0,0 +1,188 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Lists/ES1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/list.json")
+		}
+
+		var (
+			exp  List
+			f, _ = os.Open("fixtures/list.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		list, err := (listAPI{client}).Read(context.TODO(), "IS1", "ES1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, list) {
+			t.Errorf("response diff %v", cmp.Diff(exp, list))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listAPI{client}).Read(ctx, "IS1", "ES1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestListList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Lists"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/lists.json")
+		}
+
+		var (
+			exp  ListList
+			f, _ = os.Open("fixtures/lists.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		lists, err := (listAPI{client}).List(context.TODO(), "IS1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, lists) {
+			t.Errorf("response diff %v", cmp.Diff(exp, lists))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listAPI{client}).List(ctx, "IS1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestListCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("CollectionTtl=86400&UniqueName=typing")
+			)
+
+			if exp := "/Services/IS1/Lists"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/list.json")
+		}
+
+		var (
+			exp  List
+			f, _ = os.Open("fixtures/list.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		list, err := (listAPI{client}).Create(context.TODO(), "IS1", ListCreateParams{UniqueName: "typing", CollectionTTL: 86400})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, list) {
+			t.Errorf("response diff %v", cmp.Diff(exp, list))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listAPI{client}).Create(ctx, "IS1", ListCreateParams{UniqueName: "typing", CollectionTTL: 86400})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestListUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("CollectionTtl=3600")
+			)
+
+			if exp := "/Services/IS1/Lists/ES1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/list.json")
+		}
+
+		var (
+			exp  List
+			f, _ = os.Open("fixtures/list.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		list, err := (listAPI{client}).Update(context.TODO(), "IS1", "ES1", ListUpdateParams{CollectionTTL: 3600})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, list) {
+			t.Errorf("response diff %v", cmp.Diff(exp, list))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listAPI{client}).Update(ctx, "IS1", "ES1", ListUpdateParams{CollectionTTL: 3600})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestListDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Lists/ES1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (listAPI{client}).Delete(context.TODO(), "IS1", "ES1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (listAPI{client}).Delete(ctx, "IS1", "ES1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
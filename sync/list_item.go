@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ListItemResource handles interactions with Sync List Items REST API.
+type ListItemResource struct {
+	listItemAPI
+}
+
+// Order of the items listed.
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Bounds of the From index or key of the items listed.
+const (
+	BoundsInclusive = "inclusive"
+	BoundsExclusive = "exclusive"
+)
+
+// ListItem holds a JSON object of up to 16KB at an index of a list.
+type ListItem struct {
+	Index      int             `json:"index"`
+	AccountSid string          `json:"account_sid"`
+	ServiceSid string          `json:"service_sid"`
+	ListSid    string          `json:"list_sid"`
+	Revision   string          `json:"revision"`
+	Data       json.RawMessage `json:"data"`
+	CreatedBy  string          `json:"created_by"`
+
+	// DateExpires ISO-8601 format, empty for items without a ttl.
+	DateExpires string `json:"date_expires"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// ListItemList holds a page of list items.
+type ListItemList struct {
+	Items []ListItem `json:"items"`
+	Meta  Meta       `json:"meta"`
+}
+
+// ListItemListParams holds information used in listing the items of a list.
+// https://www.twilio.com/docs/sync/api/listitem-resource#read-multiple-listitem-resources
+type ListItemListParams struct {
+	ListParams
+
+	// Order asc or desc by index.
+	Order string `url:",omitempty"`
+
+	// From index of the first item, with Bounds inclusive or exclusive.
+	From   string `url:",omitempty"`
+	Bounds string `url:",omitempty"`
+}
+
+func (p ListItemListParams) query() string {
+	return twilio.Query(p)
+}
+
+// ListItemCreateParams holds information used in appending a new item to a list.
+// https://www.twilio.com/docs/sync/api/listitem-resource#create-a-listitem-resource
+type ListItemCreateParams struct {
+	Data json.RawMessage
+
+	// ItemTTL seconds before the item expires, 0 never expires.
+	ItemTTL int `url:"ItemTtl,omitempty"`
+
+	// CollectionTTL seconds before the parent list expires.
+	CollectionTTL int `url:"CollectionTtl,omitempty"`
+}
+
+func (lcp ListItemCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(lcp).Encode())
+}
+
+// ListItemUpdateParams holds information used in updating an existing item.
+// https://www.twilio.com/docs/sync/api/listitem-resource#update-a-listitem-resource
+type ListItemUpdateParams struct {
+	Data          json.RawMessage `url:",omitempty"`
+	ItemTTL       int             `url:"ItemTtl,omitempty"`
+	CollectionTTL int             `url:"CollectionTtl,omitempty"`
+
+	// Revision the update is made against, sent as `If-Match`.
+	Revision string `url:"-"`
+}
+
+func (lup ListItemUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(lup).Encode())
+}
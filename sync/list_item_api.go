@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type listItemAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Lists/{List SID}/Items/{Index}
+// https://www.twilio.com/docs/sync/api/listitem-resource#fetch-a-listitem-resource
+func (api listItemAPI) Read(ctx context.Context, serviceSid, listSid string, index int) (ListItem, error) {
+	var item ListItem
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Lists/%s/Items/%d", serviceSid, listSid, index), &item)
+	return item, err
+}
+
+// GET /Services/{Service SID}/Lists/{List SID}/Items
+// https://www.twilio.com/docs/sync/api/listitem-resource#read-multiple-listitem-resources
+func (api listItemAPI) List(ctx context.Context, serviceSid, listSid string, params ListItemListParams) (ListItemList, error) {
+	var items ListItemList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Lists/%s/Items", serviceSid, listSid)+params.query(), &items)
+	return items, err
+}
+
+// Create appends an item to the end of a list.
+// POST /Services/{Service SID}/Lists/{List SID}/Items
+// https://www.twilio.com/docs/sync/api/listitem-resource#create-a-listitem-resource
+func (api listItemAPI) Create(ctx context.Context, serviceSid, listSid string, body ListItemCreateParams) (ListItem, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Lists/%s/Items", serviceSid, listSid), nil, body.encode())
+}
+
+// Update replaces the data of an item, with the revision of body set the update
+// fails unless it is the current revision, see IsRevisionMismatch.
+// POST /Services/{Service SID}/Lists/{List SID}/Items/{Index}
+// https://www.twilio.com/docs/sync/api/listitem-resource#update-a-listitem-resource
+func (api listItemAPI) Update(ctx context.Context, serviceSid, listSid string, index int, body ListItemUpdateParams) (ListItem, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Lists/%s/Items/%d", serviceSid, listSid, index), ifMatch(body.Revision), body.encode())
+}
+
+// Delete removes an item, with a revision set it fails unless it is the current revision.
+// DELETE /Services/{Service SID}/Lists/{List SID}/Items/{Index}
+// https://www.twilio.com/docs/sync/api/listitem-resource#delete-a-listitem-resource
+func (api listItemAPI) Delete(ctx context.Context, serviceSid, listSid string, index int, revision string) error {
+	_, err := twilio.DeleteWithHeader(ctx, api.client, fmt.Sprintf("/Services/%s/Lists/%s/Items/%d", serviceSid, listSid, index), ifMatch(revision))
+	return err
+}
+
+func (api listItemAPI) post(ctx context.Context, path string, header http.Header, body io.Reader) (ListItem, error) {
+	var item ListItem
+	err := postInto(ctx, api.client, path, header, body, &item)
+	return item, err
+}
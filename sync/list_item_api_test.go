@@ -0,0 +1,191 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListItemRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Lists/ES1/Items/0"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/list_item.json")
+		}
+
+		var (
+			exp  ListItem
+			f, _ = os.Open("fixtures/list_item.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		item, err := (listItemAPI{client}).Read(context.TODO(), "IS1", "ES1", 0)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, item) {
+			t.Errorf("response diff %v", cmp.Diff(exp, item))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listItemAPI{client}).Read(ctx, "IS1", "ES1", 0)
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestListItemList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Lists/ES1/Items?Bounds=exclusive&From=10&Order=desc"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/list_items.json")
+		}
+
+		var (
+			exp  ListItemList
+			f, _ = os.Open("fixtures/list_items.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		items, err := (listItemAPI{client}).List(context.TODO(), "IS1", "ES1", ListItemListParams{Order: OrderDesc, From: "10", Bounds: BoundsExclusive})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, items) {
+			t.Errorf("response diff %v", cmp.Diff(exp, items))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listItemAPI{client}).List(ctx, "IS1", "ES1", ListItemListParams{Order: OrderDesc, From: "10", Bounds: BoundsExclusive})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestListItemCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Data=%7B%22identity%22%3A%22alice%22%7D&ItemTtl=60")
+			)
+
+			if exp := "/Services/IS1/Lists/ES1/Items"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/list_item.json")
+		}
+
+		var (
+			exp  ListItem
+			f, _ = os.Open("fixtures/list_item.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		item, err := (listItemAPI{client}).Create(context.TODO(), "IS1", "ES1", ListItemCreateParams{Data: []byte(`{"identity":"alice"}`), ItemTTL: 60})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, item) {
+			t.Errorf("response diff %v", cmp.Diff(exp, item))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listItemAPI{client}).Create(ctx, "IS1", "ES1", ListItemCreateParams{Data: []byte(`{"identity":"alice"}`), ItemTTL: 60})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestListItemUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Data=%7B%7D")
+			)
+
+			if exp := "/Services/IS1/Lists/ES1/Items/0"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/list_item.json")
+		}
+
+		var (
+			exp  ListItem
+			f, _ = os.Open("fixtures/list_item.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		item, err := (listItemAPI{client}).Update(context.TODO(), "IS1", "ES1", 0, ListItemUpdateParams{Data: []byte(`{}`), Revision: "1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, item) {
+			t.Errorf("response diff %v", cmp.Diff(exp, item))
+		}
+		if exp, got := "1", client.Header.Get("If-Match"); exp != got {
+			t.Errorf("exp If-Match %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (listItemAPI{client}).Update(ctx, "IS1", "ES1", 0, ListItemUpdateParams{Data: []byte(`{}`), Revision: "1"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestListItemDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Lists/ES1/Items/0"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (listItemAPI{client}).Delete(context.TODO(), "IS1", "ES1", 0, ""); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (listItemAPI{client}).Delete(ctx, "IS1", "ES1", 0, "")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
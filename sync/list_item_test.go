@@ -0,0 +1,20 @@
+package sync
+
+import "testing"
+
+func TestListItemParamsOptionals(t *testing.T) {
+	exp := []byte("Data=")
+	t.Run("CreateParams", optionalsFn(ListItemCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(ListItemUpdateParams{Revision: "1"}, exp))
+}
+
+func TestListItemListParamsQuery(t *testing.T) {
+	if exp, got := "", (ListItemListParams{}).query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+	params := ListItemListParams{ListParams: ListParams{PageSize: 10}, Order: OrderAsc}
+	if exp, got := "?Order=asc&PageSize=10", params.query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
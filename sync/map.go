@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// MapResource handles interactions with Sync Maps REST API.
+type MapResource struct {
+	mapAPI
+}
+
+// Map holds a collection of items indexed by key, its revision changes with every
+// change of its items.
+type Map struct {
+	Sid        string `json:"sid"`
+	UniqueName string `json:"unique_name"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+	Revision   string `json:"revision"`
+	CreatedBy  string `json:"created_by"`
+
+	// DateExpires ISO-8601 format, empty for maps without a ttl.
+	DateExpires string `json:"date_expires"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Items       string `json:"items"`
+		Permissions string `json:"permissions"`
+	} `json:"links"`
+}
+
+// MapList holds a page of maps.
+type MapList struct {
+	Maps []Map `json:"maps"`
+	Meta Meta  `json:"meta"`
+}
+
+// MapCreateParams holds information used in creating a new map.
+// https://www.twilio.com/docs/sync/api/map-resource#create-a-map-resource
+type MapCreateParams struct {
+	UniqueName string `url:",omitempty"`
+
+	// CollectionTTL seconds before the map and its items expire, 0 never expires.
+	CollectionTTL int `url:"CollectionTtl,omitempty"`
+}
+
+func (mcp MapCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mcp).Encode())
+}
+
+// MapUpdateParams holds information used in updating an existing map.
+// https://www.twilio.com/docs/sync/api/map-resource#update-a-map-resource
+type MapUpdateParams struct {
+	CollectionTTL int `url:"CollectionTtl,omitempty"`
+}
+
+func (mup MapUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mup).Encode())
+}
@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type mapAPI struct {
+	client twilio.HTTPClient
+}
+
+// Read returns a map by its sid or unique name.
+// GET /Services/{Service SID}/Maps/{Map SID}
+// https://www.twilio.com/docs/sync/api/map-resource#fetch-a-map-resource
+func (api mapAPI) Read(ctx context.Context, serviceSid, mapSid string) (Map, error) {
+	var m Map
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Maps/%s", serviceSid, mapSid), &m)
+	return m, err
+}
+
+// GET /Services/{Service SID}/Maps
+// https://www.twilio.com/docs/sync/api/map-resource#read-multiple-map-resources
+func (api mapAPI) List(ctx context.Context, serviceSid string, params ListParams) (MapList, error) {
+	var maps MapList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Maps", serviceSid)+twilio.Query(params), &maps)
+	return maps, err
+}
+
+// POST /Services/{Service SID}/Maps
+// https://www.twilio.com/docs/sync/api/map-resource#create-a-map-resource
+func (api mapAPI) Create(ctx context.Context, serviceSid string, body MapCreateParams) (Map, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Maps", serviceSid), body.encode())
+}
+
+// POST /Services/{Service SID}/Maps/{Map SID}
+// https://www.twilio.com/docs/sync/api/map-resource#update-a-map-resource
+func (api mapAPI) Update(ctx context.Context, serviceSid, mapSid string, body MapUpdateParams) (Map, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Maps/%s", serviceSid, mapSid), body.encode())
+}
+
+// DELETE /Services/{Service SID}/Maps/{Map SID}
+// https://www.twilio.com/docs/sync/api/map-resource#delete-a-map-resource
+func (api mapAPI) Delete(ctx context.Context, serviceSid, mapSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/Maps/%s", serviceSid, mapSid))
+	return err
+}
+
+func (api mapAPI) post(ctx context.Context, path string, body io.Reader) (Map, error) {
+	var m Map
+	err := twilio.PostInto(ctx, api.client, path, body, &m)
+	return m, err
+}
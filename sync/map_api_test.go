@@ -0,0 +1,188 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMapRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Maps/MP1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/map.json")
+		}
+
+		var (
+			exp  Map
+			f, _ = os.Open("fixtures/map.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		m, err := (mapAPI{client}).Read(context.TODO(), "IS1", "MP1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, m) {
+			t.Errorf("response diff %v", cmp.Diff(exp, m))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapAPI{client}).Read(ctx, "IS1", "MP1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMapList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Maps"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/maps.json")
+		}
+
+		var (
+			exp  MapList
+			f, _ = os.Open("fixtures/maps.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		maps, err := (mapAPI{client}).List(context.TODO(), "IS1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, maps) {
+			t.Errorf("response diff %v", cmp.Diff(exp, maps))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapAPI{client}).List(ctx, "IS1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMapCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("UniqueName=members")
+			)
+
+			if exp := "/Services/IS1/Maps"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/map.json")
+		}
+
+		var (
+			exp  Map
+			f, _ = os.Open("fixtures/map.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		m, err := (mapAPI{client}).Create(context.TODO(), "IS1", MapCreateParams{UniqueName: "members"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, m) {
+			t.Errorf("response diff %v", cmp.Diff(exp, m))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapAPI{client}).Create(ctx, "IS1", MapCreateParams{UniqueName: "members"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMapUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("CollectionTtl=3600")
+			)
+
+			if exp := "/Services/IS1/Maps/MP1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/map.json")
+		}
+
+		var (
+			exp  Map
+			f, _ = os.Open("fixtures/map.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		m, err := (mapAPI{client}).Update(context.TODO(), "IS1", "MP1", MapUpdateParams{CollectionTTL: 3600})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, m) {
+			t.Errorf("response diff %v", cmp.Diff(exp, m))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapAPI{client}).Update(ctx, "IS1", "MP1", MapUpdateParams{CollectionTTL: 3600})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMapDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Maps/MP1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (mapAPI{client}).Delete(context.TODO(), "IS1", "MP1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (mapAPI{client}).Delete(ctx, "IS1", "MP1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
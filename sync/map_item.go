@@ -0,0 +1,90 @@
+package sync
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// MapItemResource handles interactions with Sync Map Items REST API.
+type MapItemResource struct {
+	mapItemAPI
+}
+
+// MapItem holds a JSON object of up to 16KB at a key of a map.
+type MapItem struct {
+	Key        string          `json:"key"`
+	AccountSid string          `json:"account_sid"`
+	ServiceSid string          `json:"service_sid"`
+	MapSid     string          `json:"map_sid"`
+	Revision   string          `json:"revision"`
+	Data       json.RawMessage `json:"data"`
+	CreatedBy  string          `json:"created_by"`
+
+	// DateExpires ISO-8601 format, empty for items without a ttl.
+	DateExpires string `json:"date_expires"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// MapItemList holds a page of map items.
+type MapItemList struct {
+	Items []MapItem `json:"items"`
+	Meta  Meta      `json:"meta"`
+}
+
+// MapItemListParams holds information used in listing the items of a map.
+// https://www.twilio.com/docs/sync/api/map-item-resource#read-multiple-mapitem-resources
+type MapItemListParams struct {
+	ListParams
+
+	// Order asc or desc by key.
+	Order string `url:",omitempty"`
+
+	// From key of the first item, with Bounds inclusive or exclusive.
+	From   string `url:",omitempty"`
+	Bounds string `url:",omitempty"`
+}
+
+func (p MapItemListParams) query() string {
+	return twilio.Query(p)
+}
+
+// MapItemCreateParams holds information used in adding a new item to a map.
+// https://www.twilio.com/docs/sync/api/map-item-resource#create-a-mapitem-resource
+type MapItemCreateParams struct {
+	Key  string
+	Data json.RawMessage
+
+	// ItemTTL seconds before the item expires, 0 never expires.
+	ItemTTL int `url:"ItemTtl,omitempty"`
+
+	// CollectionTTL seconds before the parent map expires.
+	CollectionTTL int `url:"CollectionTtl,omitempty"`
+}
+
+func (mcp MapItemCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mcp).Encode())
+}
+
+// MapItemUpdateParams holds information used in updating an existing item.
+// https://www.twilio.com/docs/sync/api/map-item-resource#update-a-mapitem-resource
+type MapItemUpdateParams struct {
+	Data          json.RawMessage `url:",omitempty"`
+	ItemTTL       int             `url:"ItemTtl,omitempty"`
+	CollectionTTL int             `url:"CollectionTtl,omitempty"`
+
+	// Revision the update is made against, sent as `If-Match`.
+	Revision string `url:"-"`
+}
+
+func (mup MapItemUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(mup).Encode())
+}
@@ -0,0 +1,65 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type mapItemAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Maps/{Map SID}/Items/{Key}
+// https://www.twilio.com/docs/sync/api/map-item-resource#fetch-a-mapitem-resource
+func (api mapItemAPI) Read(ctx context.Context, serviceSid, mapSid, key string) (MapItem, error) {
+	var item MapItem
+	err := twilio.GetInto(ctx, api.client, api.path(serviceSid, mapSid, key), &item)
+	return item, err
+}
+
+// GET /Services/{Service SID}/Maps/{Map SID}/Items
+// https://www.twilio.com/docs/sync/api/map-item-resource#read-multiple-mapitem-resources
+func (api mapItemAPI) List(ctx context.Context, serviceSid, mapSid string, params MapItemListParams) (MapItemList, error) {
+	var items MapItemList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Maps/%s/Items", serviceSid, mapSid)+params.query(), &items)
+	return items, err
+}
+
+// Create adds an item to a map, it fails if the key already exists.
+// POST /Services/{Service SID}/Maps/{Map SID}/Items
+// https://www.twilio.com/docs/sync/api/map-item-resource#create-a-mapitem-resource
+func (api mapItemAPI) Create(ctx context.Context, serviceSid, mapSid string, body MapItemCreateParams) (MapItem, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Maps/%s/Items", serviceSid, mapSid), nil, body.encode())
+}
+
+// Update replaces the data of an item, with the revision of body set the update
+// fails unless it is the current revision, see IsRevisionMismatch.
+// POST /Services/{Service SID}/Maps/{Map SID}/Items/{Key}
+// https://www.twilio.com/docs/sync/api/map-item-resource#update-a-mapitem-resource
+func (api mapItemAPI) Update(ctx context.Context, serviceSid, mapSid, key string, body MapItemUpdateParams) (MapItem, error) {
+	return api.post(ctx, api.path(serviceSid, mapSid, key), ifMatch(body.Revision), body.encode())
+}
+
+// Delete removes an item, with a revision set it fails unless it is the current revision.
+// DELETE /Services/{Service SID}/Maps/{Map SID}/Items/{Key}
+// https://www.twilio.com/docs/sync/api/map-item-resource#delete-a-mapitem-resource
+func (api mapItemAPI) Delete(ctx context.Context, serviceSid, mapSid, key, revision string) error {
+	_, err := twilio.DeleteWithHeader(ctx, api.client, api.path(serviceSid, mapSid, key), ifMatch(revision))
+	return err
+}
+
+func (api mapItemAPI) post(ctx context.Context, path string, header http.Header, body io.Reader) (MapItem, error) {
+	var item MapItem
+	err := postInto(ctx, api.client, path, header, body, &item)
+	return item, err
+}
+
+// path escapes the key, keys are any string up to 320 characters.
+func (api mapItemAPI) path(serviceSid, mapSid, key string) string {
+	return fmt.Sprintf("/Services/%s/Maps/%s/Items/%s", serviceSid, mapSid, url.PathEscape(key))
+}
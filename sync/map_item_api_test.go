@@ -0,0 +1,194 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMapItemRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Maps/MP1/Items/alice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/map_item.json")
+		}
+
+		var (
+			exp  MapItem
+			f, _ = os.Open("fixtures/map_item.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		item, err := (mapItemAPI{client}).Read(context.TODO(), "IS1", "MP1", "alice")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, item) {
+			t.Errorf("response diff %v", cmp.Diff(exp, item))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapItemAPI{client}).Read(ctx, "IS1", "MP1", "alice")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMapItemList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Maps/MP1/Items?From=a"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/map_items.json")
+		}
+
+		var (
+			exp  MapItemList
+			f, _ = os.Open("fixtures/map_items.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		items, err := (mapItemAPI{client}).List(context.TODO(), "IS1", "MP1", MapItemListParams{From: "a"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, items) {
+			t.Errorf("response diff %v", cmp.Diff(exp, items))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapItemAPI{client}).List(ctx, "IS1", "MP1", MapItemListParams{From: "a"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestMapItemCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Data=%7B%7D&Key=alice")
+			)
+
+			if exp := "/Services/IS1/Maps/MP1/Items"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/map_item.json")
+		}
+
+		var (
+			exp  MapItem
+			f, _ = os.Open("fixtures/map_item.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		item, err := (mapItemAPI{client}).Create(context.TODO(), "IS1", "MP1", MapItemCreateParams{Key: "alice", Data: []byte(`{}`)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, item) {
+			t.Errorf("response diff %v", cmp.Diff(exp, item))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapItemAPI{client}).Create(ctx, "IS1", "MP1", MapItemCreateParams{Key: "alice", Data: []byte(`{}`)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMapItemUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("ItemTtl=60")
+			)
+
+			if exp := "/Services/IS1/Maps/MP1/Items/user%2Falice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/map_item.json")
+		}
+
+		var (
+			exp  MapItem
+			f, _ = os.Open("fixtures/map_item.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		item, err := (mapItemAPI{client}).Update(context.TODO(), "IS1", "MP1", "user/alice", MapItemUpdateParams{ItemTTL: 60, Revision: "4"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, item) {
+			t.Errorf("response diff %v", cmp.Diff(exp, item))
+		}
+		if exp, got := "4", client.Header.Get("If-Match"); exp != got {
+			t.Errorf("exp If-Match %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (mapItemAPI{client}).Update(ctx, "IS1", "MP1", "user/alice", MapItemUpdateParams{ItemTTL: 60, Revision: "4"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestMapItemDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Maps/MP1/Items/alice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (mapItemAPI{client}).Delete(context.TODO(), "IS1", "MP1", "alice", "4"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+		if exp, got := "4", client.Header.Get("If-Match"); exp != got {
+			t.Errorf("exp If-Match %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (mapItemAPI{client}).Delete(ctx, "IS1", "MP1", "alice", "4")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,10 @@
+package sync
+
+import "testing"
+
+func TestMapItemParamsOptionals(t *testing.T) {
+	exp := []byte("Data=&Key=")
+	t.Run("CreateParams", optionalsFn(MapItemCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(MapItemUpdateParams{Revision: "1"}, exp))
+}
@@ -0,0 +1,49 @@
+package sync
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// PermissionResource handles interactions with the Sync Permissions REST API of
+// documents, lists or maps.
+type PermissionResource struct {
+	permissionAPI
+}
+
+// Permission holds the access of an identity to a document, list or map, enforced
+// when the acl of the service is enabled.
+type Permission struct {
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+
+	// DocumentSid, ListSid or MapSid of the object the permission applies to.
+	DocumentSid string `json:"document_sid,omitempty"`
+	ListSid     string `json:"list_sid,omitempty"`
+	MapSid      string `json:"map_sid,omitempty"`
+	Identity    string `json:"identity"`
+	Read        bool   `json:"read"`
+	Write       bool   `json:"write"`
+	Manage      bool   `json:"manage"`
+	URL         string `json:"url"`
+}
+
+// PermissionList holds a page of permissions.
+type PermissionList struct {
+	Permissions []Permission `json:"permissions"`
+	Meta        Meta         `json:"meta"`
+}
+
+// PermissionUpdateParams holds the access granted to an identity, every flag is required.
+// https://www.twilio.com/docs/sync/api/document-permission-resource#update-a-document-permission-resource
+type PermissionUpdateParams struct {
+	Read   bool
+	Write  bool
+	Manage bool
+}
+
+func (pup PermissionUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(pup).Encode())
+}
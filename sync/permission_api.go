@@ -0,0 +1,51 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type permissionAPI struct {
+	client twilio.HTTPClient
+
+	// collection of the objects holding the permissions, Documents, Lists or Maps.
+	collection string
+}
+
+// GET /Services/{Service SID}/{Documents|Lists|Maps}/{SID}/Permissions/{Identity}
+// https://www.twilio.com/docs/sync/api/document-permission-resource#fetch-a-document-permission-resource
+func (api permissionAPI) Read(ctx context.Context, serviceSid, sid, identity string) (Permission, error) {
+	var perm Permission
+	err := twilio.GetInto(ctx, api.client, api.path(serviceSid, sid)+"/"+identity, &perm)
+	return perm, err
+}
+
+// GET /Services/{Service SID}/{Documents|Lists|Maps}/{SID}/Permissions
+// https://www.twilio.com/docs/sync/api/document-permission-resource#read-multiple-document-permission-resources
+func (api permissionAPI) List(ctx context.Context, serviceSid, sid string, params ListParams) (PermissionList, error) {
+	var perms PermissionList
+	err := twilio.GetInto(ctx, api.client, api.path(serviceSid, sid)+twilio.Query(params), &perms)
+	return perms, err
+}
+
+// Update grants the access of an identity, creating the permission if needed.
+// POST /Services/{Service SID}/{Documents|Lists|Maps}/{SID}/Permissions/{Identity}
+// https://www.twilio.com/docs/sync/api/document-permission-resource#update-a-document-permission-resource
+func (api permissionAPI) Update(ctx context.Context, serviceSid, sid, identity string, body PermissionUpdateParams) (Permission, error) {
+	var perm Permission
+	err := twilio.PostInto(ctx, api.client, api.path(serviceSid, sid)+"/"+identity, body.encode(), &perm)
+	return perm, err
+}
+
+// DELETE /Services/{Service SID}/{Documents|Lists|Maps}/{SID}/Permissions/{Identity}
+// https://www.twilio.com/docs/sync/api/document-permission-resource#delete-a-document-permission-resource
+func (api permissionAPI) Delete(ctx context.Context, serviceSid, sid, identity string) error {
+	_, err := api.client.Delete(ctx, api.path(serviceSid, sid)+"/"+identity)
+	return err
+}
+
+func (api permissionAPI) path(serviceSid, sid string) string {
+	return fmt.Sprintf("/Services/%s/%s/%s/Permissions", serviceSid, api.collection, sid)
+}
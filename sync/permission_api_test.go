@@ -0,0 +1,147 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPermissionRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Documents/ET1/Permissions/alice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/permission.json")
+		}
+
+		var (
+			exp  Permission
+			f, _ = os.Open("fixtures/permission.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		perm, err := (permissionAPI{client, "Documents"}).Read(context.TODO(), "IS1", "ET1", "alice")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, perm) {
+			t.Errorf("response diff %v", cmp.Diff(exp, perm))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (permissionAPI{client, "Documents"}).Read(ctx, "IS1", "ET1", "alice")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestPermissionList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Documents/ET1/Permissions"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/permissions.json")
+		}
+
+		var (
+			exp  PermissionList
+			f, _ = os.Open("fixtures/permissions.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		perms, err := (permissionAPI{client, "Documents"}).List(context.TODO(), "IS1", "ET1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, perms) {
+			t.Errorf("response diff %v", cmp.Diff(exp, perms))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (permissionAPI{client, "Documents"}).List(ctx, "IS1", "ET1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestPermissionUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Manage=false&Read=true&Write=true")
+			)
+
+			if exp := "/Services/IS1/Documents/ET1/Permissions/alice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/permission.json")
+		}
+
+		var (
+			exp  Permission
+			f, _ = os.Open("fixtures/permission.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		perm, err := (permissionAPI{client, "Documents"}).Update(context.TODO(), "IS1", "ET1", "alice", PermissionUpdateParams{Read: true, Write: true})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, perm) {
+			t.Errorf("response diff %v", cmp.Diff(exp, perm))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (permissionAPI{client, "Documents"}).Update(ctx, "IS1", "ET1", "alice", PermissionUpdateParams{Read: true, Write: true})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestPermissionDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Documents/ET1/Permissions/alice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (permissionAPI{client, "Documents"}).Delete(context.TODO(), "IS1", "ET1", "alice"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (permissionAPI{client, "Documents"}).Delete(ctx, "IS1", "ET1", "alice")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
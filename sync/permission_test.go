@@ -0,0 +1,8 @@
+package sync
+
+import "testing"
+
+func TestPermissionParamsOptionals(t *testing.T) {
+	exp := []byte("Manage=false&Read=false&Write=false")
+	t.Run("UpdateParams", optionalsFn(PermissionUpdateParams{}, exp))
+}
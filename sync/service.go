@@ -0,0 +1,86 @@
+package sync
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ServiceResource handles interactions with Sync Services REST API.
+type ServiceResource struct {
+	serviceAPI
+}
+
+// Service holds the documents, lists, maps and streams shared by its clients.
+type Service struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+	UniqueName   string `json:"unique_name"`
+	WebhookURL   string `json:"webhook_url"`
+
+	// WebhooksFromRestEnabled fires the webhooks of the changes made by the REST API.
+	WebhooksFromRestEnabled     bool `json:"webhooks_from_rest_enabled"`
+	ReachabilityWebhooksEnabled bool `json:"reachability_webhooks_enabled"`
+
+	// ACLEnabled restricts the access of the clients to the resources they hold
+	// permissions for.
+	ACLEnabled                    bool `json:"acl_enabled"`
+	ReachabilityDebouncingEnabled bool `json:"reachability_debouncing_enabled"`
+
+	// ReachabilityDebouncingWindow milliseconds before a disconnected identity is
+	// reported as unreachable, 1000 to 30000. Default 5000.
+	ReachabilityDebouncingWindow int `json:"reachability_debouncing_window"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Documents string `json:"documents"`
+		Lists     string `json:"lists"`
+		Maps      string `json:"maps"`
+		Streams   string `json:"streams"`
+	} `json:"links"`
+}
+
+// ServiceList holds a page of services.
+type ServiceList struct {
+	Services []Service `json:"services"`
+	Meta     Meta      `json:"meta"`
+}
+
+// ServiceCreateParams holds information used in creating a new service.
+// https://www.twilio.com/docs/sync/api/service#create-a-service-resource
+type ServiceCreateParams struct {
+	FriendlyName                  string `url:",omitempty"`
+	WebhookURL                    string `url:"WebhookUrl,omitempty"`
+	ReachabilityWebhooksEnabled   *bool  `url:",omitempty"`
+	ACLEnabled                    *bool  `url:"AclEnabled,omitempty"`
+	ReachabilityDebouncingEnabled *bool  `url:",omitempty"`
+	ReachabilityDebouncingWindow  int    `url:",omitempty"`
+	WebhooksFromRestEnabled       *bool  `url:",omitempty"`
+}
+
+func (scp ServiceCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scp).Encode())
+}
+
+// ServiceUpdateParams holds information used in updating an existing service.
+// https://www.twilio.com/docs/sync/api/service#update-a-service-resource
+type ServiceUpdateParams struct {
+	FriendlyName                  string `url:",omitempty"`
+	WebhookURL                    string `url:"WebhookUrl,omitempty"`
+	ReachabilityWebhooksEnabled   *bool  `url:",omitempty"`
+	ACLEnabled                    *bool  `url:"AclEnabled,omitempty"`
+	ReachabilityDebouncingEnabled *bool  `url:",omitempty"`
+	ReachabilityDebouncingWindow  int    `url:",omitempty"`
+	WebhooksFromRestEnabled       *bool  `url:",omitempty"`
+}
+
+func (sup ServiceUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(sup).Encode())
+}
@@ -0,0 +1,64 @@
+package sync
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// StreamResource handles interactions with Sync Message Streams REST API.
+type StreamResource struct {
+	streamAPI
+}
+
+// Stream publishes messages to its subscribers without storing them.
+type Stream struct {
+	Sid        string `json:"sid"`
+	UniqueName string `json:"unique_name"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+	CreatedBy  string `json:"created_by"`
+
+	// DateExpires ISO-8601 format, empty for streams without a ttl.
+	DateExpires string `json:"date_expires"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		StreamMessages string `json:"stream_messages"`
+	} `json:"links"`
+}
+
+// StreamList holds a page of streams.
+type StreamList struct {
+	Streams []Stream `json:"streams"`
+	Meta    Meta     `json:"meta"`
+}
+
+// StreamCreateParams holds information used in creating a new stream.
+// https://www.twilio.com/docs/sync/api/stream#create-a-sync-stream-resource
+type StreamCreateParams struct {
+	UniqueName string `url:",omitempty"`
+
+	// TTL seconds before the stream expires, 0 never expires.
+	TTL int `url:"Ttl,omitempty"`
+}
+
+func (scp StreamCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scp).Encode())
+}
+
+// StreamUpdateParams holds information used in updating an existing stream.
+// https://www.twilio.com/docs/sync/api/stream#update-a-sync-stream-resource
+type StreamUpdateParams struct {
+	TTL int `url:"Ttl,omitempty"`
+}
+
+func (sup StreamUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(sup).Encode())
+}
@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type streamAPI struct {
+	client twilio.HTTPClient
+}
+
+// Read returns a stream by its sid or unique name.
+// GET /Services/{Service SID}/Streams/{Stream SID}
+// https://www.twilio.com/docs/sync/api/stream#fetch-a-sync-stream-resource
+func (api streamAPI) Read(ctx context.Context, serviceSid, streamSid string) (Stream, error) {
+	var stream Stream
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Streams/%s", serviceSid, streamSid), &stream)
+	return stream, err
+}
+
+// GET /Services/{Service SID}/Streams
+// https://www.twilio.com/docs/sync/api/stream#read-multiple-sync-stream-resources
+func (api streamAPI) List(ctx context.Context, serviceSid string, params ListParams) (StreamList, error) {
+	var streams StreamList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Streams", serviceSid)+twilio.Query(params), &streams)
+	return streams, err
+}
+
+// POST /Services/{Service SID}/Streams
+// https://www.twilio.com/docs/sync/api/stream#create-a-sync-stream-resource
+func (api streamAPI) Create(ctx context.Context, serviceSid string, body StreamCreateParams) (Stream, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Streams", serviceSid), body.encode())
+}
+
+// POST /Services/{Service SID}/Streams/{Stream SID}
+// https://www.twilio.com/docs/sync/api/stream#update-a-sync-stream-resource
+func (api streamAPI) Update(ctx context.Context, serviceSid, streamSid string, body StreamUpdateParams) (Stream, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Streams/%s", serviceSid, streamSid), body.encode())
+}
+
+// DELETE /Services/{Service SID}/Streams/{Stream SID}
+// https://www.twilio.com/docs/sync/api/stream#delete-a-sync-stream-resource
+func (api streamAPI) Delete(ctx context.Context, serviceSid, streamSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/Streams/%s", serviceSid, streamSid))
+	return err
+}
+
+func (api streamAPI) post(ctx context.Context, path string, body io.Reader) (Stream, error) {
+	var stream Stream
+	err := twilio.PostInto(ctx, api.client, path, body, &stream)
+	return stream, err
+}
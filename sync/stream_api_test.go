@@ -0,0 +1,188 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStreamRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Streams/TO1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/stream.json")
+		}
+
+		var (
+			exp  Stream
+			f, _ = os.Open("fixtures/stream.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		stream, err := (streamAPI{client}).Read(context.TODO(), "IS1", "TO1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, stream) {
+			t.Errorf("response diff %v", cmp.Diff(exp, stream))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (streamAPI{client}).Read(ctx, "IS1", "TO1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestStreamList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Streams"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/streams.json")
+		}
+
+		var (
+			exp  StreamList
+			f, _ = os.Open("fixtures/streams.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		streams, err := (streamAPI{client}).List(context.TODO(), "IS1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, streams) {
+			t.Errorf("response diff %v", cmp.Diff(exp, streams))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (streamAPI{client}).List(ctx, "IS1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestStreamCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Ttl=3600&UniqueName=cursors")
+			)
+
+			if exp := "/Services/IS1/Streams"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/stream.json")
+		}
+
+		var (
+			exp  Stream
+			f, _ = os.Open("fixtures/stream.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		stream, err := (streamAPI{client}).Create(context.TODO(), "IS1", StreamCreateParams{UniqueName: "cursors", TTL: 3600})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, stream) {
+			t.Errorf("response diff %v", cmp.Diff(exp, stream))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (streamAPI{client}).Create(ctx, "IS1", StreamCreateParams{UniqueName: "cursors", TTL: 3600})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestStreamUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Ttl=60")
+			)
+
+			if exp := "/Services/IS1/Streams/TO1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/stream.json")
+		}
+
+		var (
+			exp  Stream
+			f, _ = os.Open("fixtures/stream.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		stream, err := (streamAPI{client}).Update(context.TODO(), "IS1", "TO1", StreamUpdateParams{TTL: 60})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, stream) {
+			t.Errorf("response diff %v", cmp.Diff(exp, stream))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (streamAPI{client}).Update(ctx, "IS1", "TO1", StreamUpdateParams{TTL: 60})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestStreamDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/IS1/Streams/TO1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (streamAPI{client}).Delete(context.TODO(), "IS1", "TO1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (streamAPI{client}).Delete(ctx, "IS1", "TO1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
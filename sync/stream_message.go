@@ -0,0 +1,30 @@
+package sync
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// StreamMessageResource handles interactions with Sync Stream Messages REST API.
+type StreamMessageResource struct {
+	streamMessageAPI
+}
+
+// StreamMessage is a message published to the subscribers of a stream.
+type StreamMessage struct {
+	Sid  string          `json:"sid"`
+	Data json.RawMessage `json:"data"`
+}
+
+// StreamMessageCreateParams holds the JSON object of up to 4KB published.
+// https://www.twilio.com/docs/sync/api/stream-message-resource#create-a-stream-message-resource
+type StreamMessageCreateParams struct {
+	Data json.RawMessage
+}
+
+func (scp StreamMessageCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scp).Encode())
+}
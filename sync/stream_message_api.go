@@ -0,0 +1,21 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type streamMessageAPI struct {
+	client twilio.HTTPClient
+}
+
+// Create publishes a message to the subscribers of a stream.
+// POST /Services/{Service SID}/Streams/{Stream SID}/Messages
+// https://www.twilio.com/docs/sync/api/stream-message-resource#create-a-stream-message-resource
+func (api streamMessageAPI) Create(ctx context.Context, serviceSid, streamSid string, body StreamMessageCreateParams) (StreamMessage, error) {
+	var msg StreamMessage
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Streams/%s/Messages", serviceSid, streamSid), body.encode(), &msg)
+	return msg, err
+}
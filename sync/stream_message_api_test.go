@@ -0,0 +1,54 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStreamMessageCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Data=%7B%22x%22%3A1%2C%22y%22%3A2%7D")
+			)
+
+			if exp := "/Services/IS1/Streams/TO1/Messages"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/stream_message.json")
+		}
+
+		var (
+			exp  StreamMessage
+			f, _ = os.Open("fixtures/stream_message.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		msg, err := (streamMessageAPI{client}).Create(context.TODO(), "IS1", "TO1", StreamMessageCreateParams{Data: []byte(`{"x":1,"y":2}`)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, msg) {
+			t.Errorf("response diff %v", cmp.Diff(exp, msg))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (streamMessageAPI{client}).Create(ctx, "IS1", "TO1", StreamMessageCreateParams{Data: []byte(`{"x":1,"y":2}`)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
@@ -0,0 +1,104 @@
+// Package sync is a client of the Twilio Sync v1 API, storing state shared in
+// realtime by the clients of a service in documents, lists, maps and streams.
+//
+// Documents, list items and map items carry a revision, updates and deletes made
+// with a revision fail with a precondition error if the resource changed since.
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+// Sync sync v1 interface
+type Sync struct {
+	Services            ServiceResource
+	Documents           DocumentResource
+	DocumentPermissions PermissionResource
+	Lists               ListResource
+	ListItems           ListItemResource
+	ListPermissions     PermissionResource
+	Maps                MapResource
+	MapItems            MapItemResource
+	MapPermissions      PermissionResource
+	Streams             StreamResource
+	StreamMessages      StreamMessageResource
+}
+
+// New returns a sync instance with a base url set to `https://sync.twilio.com/v1`
+// if `TWILIO_SYNC_HOST` not set.
+func New(tctx twilio.Context) (Sync, error) {
+	var sync Sync
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		syncEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return sync, err
+	}
+
+	{
+		sync.Services = ServiceResource{serviceAPI{client}}
+		sync.Documents = DocumentResource{documentAPI{client}}
+		sync.DocumentPermissions = PermissionResource{permissionAPI{client, "Documents"}}
+		sync.Lists = ListResource{listAPI{client}}
+		sync.ListItems = ListItemResource{listItemAPI{client}}
+		sync.ListPermissions = PermissionResource{permissionAPI{client, "Lists"}}
+		sync.Maps = MapResource{mapAPI{client}}
+		sync.MapItems = MapItemResource{mapItemAPI{client}}
+		sync.MapPermissions = PermissionResource{permissionAPI{client, "Maps"}}
+		sync.Streams = StreamResource{streamAPI{client}}
+		sync.StreamMessages = StreamMessageResource{streamMessageAPI{client}}
+	}
+	return sync, nil
+}
+
+// IsRevisionMismatch reports whether err is returned by an update or a delete whose
+// revision is not the current revision of the resource, the resource should be read
+// again before retrying.
+func IsRevisionMismatch(err error) bool {
+	terr, ok := errors.Cause(err).(twilio.ErrTwilioResponse)
+	return ok && terr.Status == http.StatusPreconditionFailed
+}
+
+// ifMatch returns the header expecting the revision, empty revisions are ignored.
+func ifMatch(revision string) http.Header {
+	if revision == "" {
+		return nil
+	}
+	return http.Header{"If-Match": []string{revision}}
+}
+
+// postInto decodes the response of a POST request sent with the header into v.
+func postInto(ctx context.Context, client twilio.HTTPClient, path string, header http.Header, body io.Reader, v interface{}) error {
+	if len(header) == 0 {
+		return twilio.PostInto(ctx, client, path, body, v)
+	}
+	data, err := twilio.PostWithHeader(ctx, client, path, header, body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func syncEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_SYNC_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://sync.%s.twilio.com/v1", region)
+	} else if url == "" {
+		return "https://sync.twilio.com/v1"
+	}
+	return url
+}
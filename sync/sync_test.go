@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("unsuccessful invalid env url", func(t *testing.T) {
+		os.Setenv("TWILIO_SYNC_HOST", "%2")
+		if _, err := New(twilio.Context{}); err == nil {
+			t.Errorf("exp parsing err, got none")
+		}
+		os.Unsetenv("TWILIO_SYNC_HOST")
+	})
+
+	t.Run("sync", func(t *testing.T) {
+		_, err := New(twilio.Context{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestSyncEndpoint(t *testing.T) {
+	exp := "https://sync.twilio.com/v1"
+
+	t.Run("default url", func(*testing.T) {
+		if got := syncEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("default url with region", func(*testing.T) {
+		exp := "https://sync.uk.twilio.com/v1"
+		if got := syncEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("env url", func(*testing.T) {
+		os.Setenv("TWILIO_SYNC_HOST", exp)
+		if got := syncEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		if got := syncEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		os.Unsetenv("TWILIO_SYNC_HOST")
+	})
+}
+
+func TestIsRevisionMismatch(t *testing.T) {
+	tt := map[string]struct {
+		err error
+		exp bool
+	}{
+		"precondition failed": {twilio.ErrTwilioResponse{Status: 412}, true},
+		"wrapped":             {errors.Wrap(twilio.ErrTwilioResponse{Status: 412}, "update"), true},
+		"not found":           {twilio.ErrTwilioResponse{Code: 20404, Status: 404}, false},
+		"other":               {errors.New("timeout"), false},
+		"nil":                 {nil, false},
+	}
+	for name, tc := range tt {
+		if got := IsRevisionMismatch(tc.err); tc.exp != got {
+			t.Errorf("%s: exp %v, got %v", name, tc.exp, got)
+		}
+	}
+}
+
+type requestHandlerFunc func(*http.Request) (*http.Response, error)
+
+func (fn requestHandlerFunc) Do(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+func TestIfMatch(t *testing.T) {
+	var got string
+	handler := requestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+		got = r.Header.Get("If-Match")
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+	})
+	client, _ := twilio.NewHTTPClient("key", "secret", "https://sync.twilio.com/v1", handler)
+
+	if _, err := (documentAPI{client}).Update(context.TODO(), "IS1", "ET1", DocumentUpdateParams{Revision: "3"}); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if exp := "3"; exp != got {
+		t.Errorf("exp If-Match %s, got %s", exp, got)
+	}
+
+	if err := (mapItemAPI{client}).Delete(context.TODO(), "IS1", "MP1", "alice", ""); err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if exp := ""; exp != got {
+		t.Errorf("exp no If-Match, got %s", got)
+	}
+
+	// a client unable to send the header must not drop the revision check
+	plain := struct{ twilio.HTTPClient }{client}
+	if err := (mapItemAPI{plain}).Delete(context.TODO(), "IS1", "MP1", "alice", "4"); err != twilio.ErrHeaderNotSupported {
+		t.Errorf("exp err %v, got %v", twilio.ErrHeaderNotSupported, err)
+	}
+}
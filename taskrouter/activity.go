@@ -0,0 +1,73 @@
+package taskrouter
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ActivityResource handles interactions with TaskRouter Activities REST API.
+type ActivityResource struct {
+	activityAPI
+}
+
+// Activity is a state of the workers, eg. Idle or Offline, tasks are routed only to
+// the workers of an available activity.
+type Activity struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	WorkspaceSid string `json:"workspace_sid"`
+	FriendlyName string `json:"friendly_name"`
+	Available    bool   `json:"available"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// ActivityList holds a page of activities.
+type ActivityList struct {
+	Activities []Activity `json:"activities"`
+	Meta       Meta       `json:"meta"`
+}
+
+// ActivityListParams holds information used in filtering the activities listed.
+// https://www.twilio.com/docs/taskrouter/api/activity#read-multiple-activity-resources
+type ActivityListParams struct {
+	ListParams
+
+	FriendlyName string `url:",omitempty"`
+	Available    *bool  `url:",omitempty"`
+}
+
+func (p ActivityListParams) query() string {
+	return twilio.Query(p)
+}
+
+// ActivityCreateParams holds information used in creating a new activity.
+// https://www.twilio.com/docs/taskrouter/api/activity#create-an-activity-resource
+type ActivityCreateParams struct {
+	FriendlyName string
+
+	// Available true by default.
+	Available *bool `url:",omitempty"`
+}
+
+func (acp ActivityCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(acp).Encode())
+}
+
+// ActivityUpdateParams holds information used in updating an existing activity,
+// the availability of an activity can't be changed.
+// https://www.twilio.com/docs/taskrouter/api/activity#update-an-activity-resource
+type ActivityUpdateParams struct {
+	FriendlyName string
+}
+
+func (aup ActivityUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(aup).Encode())
+}
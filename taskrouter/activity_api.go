@@ -0,0 +1,54 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type activityAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Workspaces/{Workspace SID}/Activities/{Activity SID}
+// https://www.twilio.com/docs/taskrouter/api/activity#fetch-an-activity-resource
+func (api activityAPI) Read(ctx context.Context, workspaceSid, activitySid string) (Activity, error) {
+	var act Activity
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Activities/%s", workspaceSid, activitySid), &act)
+	return act, err
+}
+
+// GET /Workspaces/{Workspace SID}/Activities
+// https://www.twilio.com/docs/taskrouter/api/activity#read-multiple-activity-resources
+func (api activityAPI) List(ctx context.Context, workspaceSid string, params ActivityListParams) (ActivityList, error) {
+	var acts ActivityList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Activities", workspaceSid)+params.query(), &acts)
+	return acts, err
+}
+
+// POST /Workspaces/{Workspace SID}/Activities
+// https://www.twilio.com/docs/taskrouter/api/activity#create-an-activity-resource
+func (api activityAPI) Create(ctx context.Context, workspaceSid string, body ActivityCreateParams) (Activity, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Activities", workspaceSid), body.encode())
+}
+
+// POST /Workspaces/{Workspace SID}/Activities/{Activity SID}
+// https://www.twilio.com/docs/taskrouter/api/activity#update-an-activity-resource
+func (api activityAPI) Update(ctx context.Context, workspaceSid, activitySid string, body ActivityUpdateParams) (Activity, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Activities/%s", workspaceSid, activitySid), body.encode())
+}
+
+// DELETE /Workspaces/{Workspace SID}/Activities/{Activity SID}
+// https://www.twilio.com/docs/taskrouter/api/activity#delete-an-activity-resource
+func (api activityAPI) Delete(ctx context.Context, workspaceSid, activitySid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Workspaces/%s/Activities/%s", workspaceSid, activitySid))
+	return err
+}
+
+func (api activityAPI) post(ctx context.Context, path string, body io.Reader) (Activity, error) {
+	var act Activity
+	err := twilio.PostInto(ctx, api.client, path, body, &act)
+	return act, err
+}
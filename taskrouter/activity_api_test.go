@@ -0,0 +1,188 @@
+package taskrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestActivityRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Activities/WA2"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/activity.json")
+		}
+
+		var (
+			exp  Activity
+			f, _ = os.Open("fixtures/activity.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		act, err := (activityAPI{client}).Read(context.TODO(), "WS1", "WA2")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, act) {
+			t.Errorf("response diff %v", cmp.Diff(exp, act))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (activityAPI{client}).Read(ctx, "WS1", "WA2")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestActivityList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Activities?FriendlyName=Idle"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/activities.json")
+		}
+
+		var (
+			exp  ActivityList
+			f, _ = os.Open("fixtures/activities.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		acts, err := (activityAPI{client}).List(context.TODO(), "WS1", ActivityListParams{FriendlyName: "Idle"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, acts) {
+			t.Errorf("response diff %v", cmp.Diff(exp, acts))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (activityAPI{client}).List(ctx, "WS1", ActivityListParams{FriendlyName: "Idle"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestActivityCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("FriendlyName=Idle")
+			)
+
+			if exp := "/Workspaces/WS1/Activities"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/activity.json")
+		}
+
+		var (
+			exp  Activity
+			f, _ = os.Open("fixtures/activity.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		act, err := (activityAPI{client}).Create(context.TODO(), "WS1", ActivityCreateParams{FriendlyName: "Idle"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, act) {
+			t.Errorf("response diff %v", cmp.Diff(exp, act))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (activityAPI{client}).Create(ctx, "WS1", ActivityCreateParams{FriendlyName: "Idle"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestActivityUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("FriendlyName=Idle")
+			)
+
+			if exp := "/Workspaces/WS1/Activities/WA2"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/activity.json")
+		}
+
+		var (
+			exp  Activity
+			f, _ = os.Open("fixtures/activity.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		act, err := (activityAPI{client}).Update(context.TODO(), "WS1", "WA2", ActivityUpdateParams{FriendlyName: "Idle"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, act) {
+			t.Errorf("response diff %v", cmp.Diff(exp, act))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (activityAPI{client}).Update(ctx, "WS1", "WA2", ActivityUpdateParams{FriendlyName: "Idle"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestActivityDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Activities/WA2"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (activityAPI{client}).Delete(context.TODO(), "WS1", "WA2"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (activityAPI{client}).Delete(ctx, "WS1", "WA2")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
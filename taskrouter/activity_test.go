@@ -0,0 +1,12 @@
+package taskrouter
+
+import "testing"
+
+func TestActivityParamsOptionals(t *testing.T) {
+	t.Run("CreateParams", optionalsFn(ActivityCreateParams{}, []byte("FriendlyName=")))
+	t.Run("UpdateParams", optionalsFn(ActivityUpdateParams{}, []byte("FriendlyName=")))
+
+	unavailable := false
+	exp := []byte("Available=false&FriendlyName=Offline")
+	t.Run("CreateParams unavailable", optionalsFn(ActivityCreateParams{FriendlyName: "Offline", Available: &unavailable}, exp))
+}
@@ -0,0 +1,9 @@
+package taskrouter
+
+import "github.com/smnalex/twilio-go"
+
+// Meta stores information about a current view of a request.
+type Meta = twilio.Meta
+
+// ListParams holds the paging information used in listing resources.
+type ListParams = twilio.ListParams
@@ -0,0 +1,114 @@
+package taskrouter
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Statuses of a reservation.
+const (
+	ReservationStatusPending   = "pending"
+	ReservationStatusAccepted  = "accepted"
+	ReservationStatusRejected  = "rejected"
+	ReservationStatusTimeout   = "timeout"
+	ReservationStatusCanceled  = "canceled"
+	ReservationStatusRescinded = "rescinded"
+	ReservationStatusWrapping  = "wrapping"
+	ReservationStatusCompleted = "completed"
+)
+
+// Instructions of a reservation, handled by TaskRouter on behalf of the worker.
+const (
+	InstructionConference = "conference"
+	InstructionDequeue    = "dequeue"
+	InstructionCall       = "call"
+	InstructionRedirect   = "redirect"
+)
+
+// ReservationResource handles interactions with TaskRouter Task Reservations REST API.
+type ReservationResource struct {
+	reservationAPI
+}
+
+// Reservation offers a task to a worker, until the worker accepts or rejects it
+// or the reservation times out.
+type Reservation struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	WorkspaceSid string `json:"workspace_sid"`
+	TaskSid      string `json:"task_sid"`
+	WorkerSid    string `json:"worker_sid"`
+	WorkerName   string `json:"worker_name"`
+
+	// ReservationStatus one of the ReservationStatus constants.
+	ReservationStatus string `json:"reservation_status"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Task      string `json:"task"`
+		Worker    string `json:"worker"`
+		Workspace string `json:"workspace"`
+	} `json:"links"`
+}
+
+// ReservationList holds a page of reservations.
+type ReservationList struct {
+	Reservations []Reservation `json:"reservations"`
+	Meta         Meta          `json:"meta"`
+}
+
+// ReservationListParams holds information used in filtering the reservations listed.
+// https://www.twilio.com/docs/taskrouter/api/reservations#read-multiple-taskreservation-resources
+type ReservationListParams struct {
+	ListParams
+
+	ReservationStatus string `url:",omitempty"`
+	WorkerSid         string `url:",omitempty"`
+}
+
+func (p ReservationListParams) query() string {
+	return twilio.Query(p)
+}
+
+// ReservationUpdateParams holds information used in updating a reservation, either
+// by its status or an instruction.
+// https://www.twilio.com/docs/taskrouter/api/reservations#update-a-taskreservation-resource
+type ReservationUpdateParams struct {
+	ReservationStatus string `url:",omitempty"`
+
+	// Instruction one of the Instruction constants.
+	Instruction       string `url:",omitempty"`
+	WorkerActivitySid string `url:",omitempty"`
+
+	// DequeueFrom and DequeueTo connect a queued call to the worker.
+	DequeueFrom                string `url:",omitempty"`
+	DequeueTo                  string `url:",omitempty"`
+	DequeueStatusCallbackURL   string `url:"DequeueStatusCallbackUrl,omitempty"`
+	DequeuePostWorkActivitySid string `url:",omitempty"`
+	DequeueRecord              string `url:",omitempty"`
+	DequeueTimeout             int    `url:",omitempty"`
+
+	// From and To connect the worker into a conference with the caller.
+	From                          string   `url:",omitempty"`
+	To                            string   `url:",omitempty"`
+	ConferenceRecord              string   `url:",omitempty"`
+	ConferenceStatusCallback      string   `url:",omitempty"`
+	ConferenceStatusCallbackEvent []string `url:",omitempty"`
+	EndConferenceOnExit           *bool    `url:",omitempty"`
+	StartConferenceOnEnter        *bool    `url:",omitempty"`
+	Timeout                       int      `url:",omitempty"`
+
+	// PostWorkActivitySid the activity of the worker once the call ends.
+	PostWorkActivitySid string `url:",omitempty"`
+}
+
+func (rup ReservationUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rup).Encode())
+}
@@ -0,0 +1,68 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type reservationAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Workspaces/{Workspace SID}/Tasks/{Task SID}/Reservations/{Reservation SID}
+// https://www.twilio.com/docs/taskrouter/api/reservations#fetch-a-taskreservation-resource
+func (api reservationAPI) Read(ctx context.Context, workspaceSid, taskSid, reservationSid string) (Reservation, error) {
+	var res Reservation
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Tasks/%s/Reservations/%s", workspaceSid, taskSid, reservationSid), &res)
+	return res, err
+}
+
+// GET /Workspaces/{Workspace SID}/Tasks/{Task SID}/Reservations
+// https://www.twilio.com/docs/taskrouter/api/reservations#read-multiple-taskreservation-resources
+func (api reservationAPI) List(ctx context.Context, workspaceSid, taskSid string, params ReservationListParams) (ReservationList, error) {
+	var res ReservationList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Tasks/%s/Reservations", workspaceSid, taskSid)+params.query(), &res)
+	return res, err
+}
+
+// POST /Workspaces/{Workspace SID}/Tasks/{Task SID}/Reservations/{Reservation SID}
+// https://www.twilio.com/docs/taskrouter/api/reservations#update-a-taskreservation-resource
+func (api reservationAPI) Update(ctx context.Context, workspaceSid, taskSid, reservationSid string, body ReservationUpdateParams) (Reservation, error) {
+	var res Reservation
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Tasks/%s/Reservations/%s", workspaceSid, taskSid, reservationSid), body.encode(), &res)
+	return res, err
+}
+
+// Accept accepts the reservation, assigning the task to the worker.
+// https://www.twilio.com/docs/taskrouter/api/reservations#update-a-taskreservation-resource
+func (api reservationAPI) Accept(ctx context.Context, workspaceSid, taskSid, reservationSid string) (Reservation, error) {
+	return api.Update(ctx, workspaceSid, taskSid, reservationSid, ReservationUpdateParams{ReservationStatus: ReservationStatusAccepted})
+}
+
+// Reject rejects the reservation, the task is offered to the next worker. The
+// worker moves to the activity if set.
+// https://www.twilio.com/docs/taskrouter/api/reservations#update-a-taskreservation-resource
+func (api reservationAPI) Reject(ctx context.Context, workspaceSid, taskSid, reservationSid, workerActivitySid string) (Reservation, error) {
+	return api.Update(ctx, workspaceSid, taskSid, reservationSid, ReservationUpdateParams{
+		ReservationStatus: ReservationStatusRejected,
+		WorkerActivitySid: workerActivitySid,
+	})
+}
+
+// Conference accepts the reservation and connects the worker into a conference with
+// the caller of the task, the instruction is set on the params.
+// https://www.twilio.com/docs/taskrouter/api/reservations#conference-instruction
+func (api reservationAPI) Conference(ctx context.Context, workspaceSid, taskSid, reservationSid string, body ReservationUpdateParams) (Reservation, error) {
+	body.Instruction = InstructionConference
+	return api.Update(ctx, workspaceSid, taskSid, reservationSid, body)
+}
+
+// Dequeue accepts the reservation and connects the queued call of the task to the
+// worker, the instruction is set on the params.
+// https://www.twilio.com/docs/taskrouter/api/reservations#dequeue-instruction
+func (api reservationAPI) Dequeue(ctx context.Context, workspaceSid, taskSid, reservationSid string, body ReservationUpdateParams) (Reservation, error) {
+	body.Instruction = InstructionDequeue
+	return api.Update(ctx, workspaceSid, taskSid, reservationSid, body)
+}
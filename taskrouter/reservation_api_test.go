@@ -0,0 +1,284 @@
+package taskrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReservationRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Tasks/WT1/Reservations/WR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/reservation.json")
+		}
+
+		var (
+			exp  Reservation
+			f, _ = os.Open("fixtures/reservation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		res, err := (reservationAPI{client}).Read(context.TODO(), "WS1", "WT1", "WR1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, res) {
+			t.Errorf("response diff %v", cmp.Diff(exp, res))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (reservationAPI{client}).Read(ctx, "WS1", "WT1", "WR1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestReservationList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Tasks/WT1/Reservations?ReservationStatus=pending"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/reservations.json")
+		}
+
+		var (
+			exp  ReservationList
+			f, _ = os.Open("fixtures/reservations.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		res, err := (reservationAPI{client}).List(context.TODO(), "WS1", "WT1", ReservationListParams{ReservationStatus: ReservationStatusPending})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, res) {
+			t.Errorf("response diff %v", cmp.Diff(exp, res))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (reservationAPI{client}).List(ctx, "WS1", "WT1", ReservationListParams{ReservationStatus: ReservationStatusPending})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestReservationUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("From=%2B15017122661&Instruction=call&To=%2B15558675310")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1/Reservations/WR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/reservation.json")
+		}
+
+		var (
+			exp  Reservation
+			f, _ = os.Open("fixtures/reservation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		res, err := (reservationAPI{client}).Update(context.TODO(), "WS1", "WT1", "WR1", ReservationUpdateParams{Instruction: InstructionCall, From: "+15017122661", To: "+15558675310"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, res) {
+			t.Errorf("response diff %v", cmp.Diff(exp, res))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (reservationAPI{client}).Update(ctx, "WS1", "WT1", "WR1", ReservationUpdateParams{Instruction: InstructionCall, From: "+15017122661", To: "+15558675310"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestReservationAccept(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("ReservationStatus=accepted")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1/Reservations/WR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/reservation.json")
+		}
+
+		var (
+			exp  Reservation
+			f, _ = os.Open("fixtures/reservation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		res, err := (reservationAPI{client}).Accept(context.TODO(), "WS1", "WT1", "WR1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, res) {
+			t.Errorf("response diff %v", cmp.Diff(exp, res))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (reservationAPI{client}).Accept(ctx, "WS1", "WT1", "WR1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestReservationReject(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("ReservationStatus=rejected&WorkerActivitySid=WA1")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1/Reservations/WR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/reservation.json")
+		}
+
+		var (
+			exp  Reservation
+			f, _ = os.Open("fixtures/reservation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		res, err := (reservationAPI{client}).Reject(context.TODO(), "WS1", "WT1", "WR1", "WA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, res) {
+			t.Errorf("response diff %v", cmp.Diff(exp, res))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (reservationAPI{client}).Reject(ctx, "WS1", "WT1", "WR1", "WA1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestReservationConference(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("From=%2B15017122661&Instruction=conference&PostWorkActivitySid=WA5")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1/Reservations/WR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/reservation.json")
+		}
+
+		var (
+			exp  Reservation
+			f, _ = os.Open("fixtures/reservation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		res, err := (reservationAPI{client}).Conference(context.TODO(), "WS1", "WT1", "WR1", ReservationUpdateParams{From: "+15017122661", PostWorkActivitySid: "WA5"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, res) {
+			t.Errorf("response diff %v", cmp.Diff(exp, res))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (reservationAPI{client}).Conference(ctx, "WS1", "WT1", "WR1", ReservationUpdateParams{From: "+15017122661", PostWorkActivitySid: "WA5"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestReservationDequeue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("DequeueFrom=%2B15017122661&DequeuePostWorkActivitySid=WA5&Instruction=dequeue")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1/Reservations/WR1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/reservation.json")
+		}
+
+		var (
+			exp  Reservation
+			f, _ = os.Open("fixtures/reservation.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		res, err := (reservationAPI{client}).Dequeue(context.TODO(), "WS1", "WT1", "WR1", ReservationUpdateParams{DequeueFrom: "+15017122661", DequeuePostWorkActivitySid: "WA5"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, res) {
+			t.Errorf("response diff %v", cmp.Diff(exp, res))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (reservationAPI{client}).Dequeue(ctx, "WS1", "WT1", "WR1", ReservationUpdateParams{DequeueFrom: "+15017122661", DequeuePostWorkActivitySid: "WA5"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
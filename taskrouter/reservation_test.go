@@ -0,0 +1,11 @@
+package taskrouter
+
+import "testing"
+
+func TestReservationParamsOptionals(t *testing.T) {
+	t.Run("UpdateParams", optionalsFn(ReservationUpdateParams{}, []byte("")))
+
+	exp := []byte("ConferenceStatusCallbackEvent=start&ConferenceStatusCallbackEvent=end&Instruction=conference")
+	params := ReservationUpdateParams{Instruction: InstructionConference, ConferenceStatusCallbackEvent: []string{"start", "end"}}
+	t.Run("UpdateParams events", optionalsFn(params, exp))
+}
@@ -0,0 +1,107 @@
+package taskrouter
+
+import "github.com/smnalex/twilio-go"
+
+// StatisticsResource handles interactions with TaskRouter Statistics REST API.
+type StatisticsResource struct {
+	statisticsAPI
+}
+
+// Statistics holds the cumulative statistics over an interval and the realtime
+// statistics of a workspace or a task queue.
+type Statistics struct {
+	AccountSid   string `json:"account_sid"`
+	WorkspaceSid string `json:"workspace_sid"`
+
+	// TaskQueueSid set for the statistics of a task queue.
+	TaskQueueSid string               `json:"task_queue_sid"`
+	Cumulative   CumulativeStatistics `json:"cumulative"`
+	Realtime     RealtimeStatistics   `json:"realtime"`
+	URL          string               `json:"url"`
+}
+
+// CumulativeStatistics holds the counts of the tasks and reservations over an interval.
+type CumulativeStatistics struct {
+	// StartTime ISO-8601 format.
+	StartTime string `json:"start_time"`
+
+	// EndTime ISO-8601 format.
+	EndTime string `json:"end_time"`
+
+	TasksCreated            int `json:"tasks_created"`
+	TasksCanceled           int `json:"tasks_canceled"`
+	TasksCompleted          int `json:"tasks_completed"`
+	TasksDeleted            int `json:"tasks_deleted"`
+	TasksMoved              int `json:"tasks_moved"`
+	TasksTimedOutInWorkflow int `json:"tasks_timed_out_in_workflow"`
+
+	ReservationsCreated   int `json:"reservations_created"`
+	ReservationsAccepted  int `json:"reservations_accepted"`
+	ReservationsRejected  int `json:"reservations_rejected"`
+	ReservationsTimedOut  int `json:"reservations_timed_out"`
+	ReservationsCanceled  int `json:"reservations_canceled"`
+	ReservationsRescinded int `json:"reservations_rescinded"`
+
+	// AvgTaskAcceptanceTime seconds.
+	AvgTaskAcceptanceTime     int               `json:"avg_task_acceptance_time"`
+	WaitDurationUntilAccepted DurationStatistic `json:"wait_duration_until_accepted"`
+	WaitDurationUntilCanceled DurationStatistic `json:"wait_duration_until_canceled"`
+}
+
+// DurationStatistic holds the distribution of a duration, in seconds.
+type DurationStatistic struct {
+	Avg   int `json:"avg"`
+	Min   int `json:"min"`
+	Max   int `json:"max"`
+	Total int `json:"total"`
+}
+
+// RealtimeStatistics holds the current state of the tasks and workers.
+type RealtimeStatistics struct {
+	TotalTasks   int `json:"total_tasks"`
+	TotalWorkers int `json:"total_workers"`
+
+	// TotalAvailableWorkers set for the statistics of a task queue.
+	TotalAvailableWorkers int `json:"total_available_workers"`
+
+	// TotalEligibleWorkers set for the statistics of a task queue.
+	TotalEligibleWorkers int `json:"total_eligible_workers"`
+
+	// LongestTaskWaitingAge seconds.
+	LongestTaskWaitingAge int    `json:"longest_task_waiting_age"`
+	LongestTaskWaitingSid string `json:"longest_task_waiting_sid"`
+
+	// TasksByStatus the count of the tasks by assignment status.
+	TasksByStatus      map[string]int       `json:"tasks_by_status"`
+	TasksByPriority    map[string]int       `json:"tasks_by_priority"`
+	ActivityStatistics []ActivityStatistics `json:"activity_statistics"`
+}
+
+// ActivityStatistics holds the count of the workers of an activity.
+type ActivityStatistics struct {
+	Sid          string `json:"sid"`
+	FriendlyName string `json:"friendly_name"`
+	Workers      int    `json:"workers"`
+}
+
+// StatisticsParams holds information used in filtering the cumulative statistics,
+// the last 15 minutes by default.
+// https://www.twilio.com/docs/taskrouter/api/workspace-statistics
+type StatisticsParams struct {
+	// Minutes before now, takes precedence over StartDate.
+	Minutes int `url:",omitempty"`
+
+	// StartDate ISO-8601 format.
+	StartDate string `url:",omitempty"`
+
+	// EndDate ISO-8601 format.
+	EndDate     string `url:",omitempty"`
+	TaskChannel string `url:",omitempty"`
+
+	// SplitByWaitTime splits the tasks by wait time in seconds, eg. `30,60,120`.
+	SplitByWaitTime string `url:",omitempty"`
+}
+
+func (p StatisticsParams) query() string {
+	return twilio.Query(p)
+}
@@ -0,0 +1,30 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type statisticsAPI struct {
+	client twilio.HTTPClient
+}
+
+// Workspace returns the statistics of a workspace.
+// GET /Workspaces/{Workspace SID}/Statistics
+// https://www.twilio.com/docs/taskrouter/api/workspace-statistics
+func (api statisticsAPI) Workspace(ctx context.Context, workspaceSid string, params StatisticsParams) (Statistics, error) {
+	var stats Statistics
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Statistics", workspaceSid)+params.query(), &stats)
+	return stats, err
+}
+
+// TaskQueue returns the statistics of a task queue.
+// GET /Workspaces/{Workspace SID}/TaskQueues/{TaskQueue SID}/Statistics
+// https://www.twilio.com/docs/taskrouter/api/taskqueue-statistics
+func (api statisticsAPI) TaskQueue(ctx context.Context, workspaceSid, taskQueueSid string, params StatisticsParams) (Statistics, error) {
+	var stats Statistics
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/TaskQueues/%s/Statistics", workspaceSid, taskQueueSid)+params.query(), &stats)
+	return stats, err
+}
@@ -0,0 +1,77 @@
+package taskrouter
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStatisticsWorkspace(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Statistics?Minutes=15"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/workspace_statistics.json")
+		}
+
+		var (
+			exp  Statistics
+			f, _ = os.Open("fixtures/workspace_statistics.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		stats, err := (statisticsAPI{client}).Workspace(context.TODO(), "WS1", StatisticsParams{Minutes: 15})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, stats) {
+			t.Errorf("response diff %v", cmp.Diff(exp, stats))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (statisticsAPI{client}).Workspace(ctx, "WS1", StatisticsParams{Minutes: 15})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestStatisticsTaskQueue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/TaskQueues/WQ1/Statistics?TaskChannel=voice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/task_queue_statistics.json")
+		}
+
+		var (
+			exp  Statistics
+			f, _ = os.Open("fixtures/task_queue_statistics.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		stats, err := (statisticsAPI{client}).TaskQueue(context.TODO(), "WS1", "WQ1", StatisticsParams{TaskChannel: "voice"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, stats) {
+			t.Errorf("response diff %v", cmp.Diff(exp, stats))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (statisticsAPI{client}).TaskQueue(ctx, "WS1", "WQ1", StatisticsParams{TaskChannel: "voice"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
@@ -0,0 +1,122 @@
+package taskrouter
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Assignment statuses of a task.
+const (
+	AssignmentStatusPending   = "pending"
+	AssignmentStatusReserved  = "reserved"
+	AssignmentStatusAssigned  = "assigned"
+	AssignmentStatusCanceled  = "canceled"
+	AssignmentStatusCompleted = "completed"
+	AssignmentStatusWrapping  = "wrapping"
+)
+
+// TaskResource handles interactions with TaskRouter Tasks REST API.
+type TaskResource struct {
+	taskAPI
+}
+
+// Task is a unit of work, eg. a call or a chat, routed by a workflow to a worker.
+type Task struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	WorkspaceSid string `json:"workspace_sid"`
+	WorkflowSid  string `json:"workflow_sid"`
+	WorkflowName string `json:"workflow_friendly_name"`
+
+	TaskQueueSid  string `json:"task_queue_sid"`
+	TaskQueueName string `json:"task_queue_friendly_name"`
+
+	TaskChannelSid        string `json:"task_channel_sid"`
+	TaskChannelUniqueName string `json:"task_channel_unique_name"`
+
+	// AssignmentStatus one of the AssignmentStatus constants.
+	AssignmentStatus string `json:"assignment_status"`
+
+	// Attributes JSON string, eg. `{"type": "billing", "language": "en"}`.
+	Attributes json.RawMessage `json:"attributes"`
+	Priority   int             `json:"priority"`
+	Reason     string          `json:"reason"`
+
+	// Age seconds since the task was created.
+	Age     int `json:"age"`
+	Timeout int `json:"timeout"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		TaskQueue    string `json:"task_queue"`
+		Workflow     string `json:"workflow"`
+		Workspace    string `json:"workspace"`
+		Reservations string `json:"reservations"`
+	} `json:"links"`
+}
+
+// TaskList holds a page of tasks.
+type TaskList struct {
+	Tasks []Task `json:"tasks"`
+	Meta  Meta   `json:"meta"`
+}
+
+// TaskListParams holds information used in filtering the tasks listed.
+// https://www.twilio.com/docs/taskrouter/api/task#read-multiple-task-resources
+type TaskListParams struct {
+	ListParams
+
+	AssignmentStatus []string `url:",omitempty"`
+	TaskQueueSid     string   `url:",omitempty"`
+	TaskQueueName    string   `url:",omitempty"`
+	WorkflowSid      string   `url:",omitempty"`
+	WorkflowName     string   `url:",omitempty"`
+	Priority         int      `url:",omitempty"`
+	Ordering         string   `url:",omitempty"`
+
+	// EvaluateTaskAttributes filters the tasks by attributes, eg. `type == "billing"`.
+	EvaluateTaskAttributes string `url:",omitempty"`
+}
+
+func (p TaskListParams) query() string {
+	return twilio.Query(p)
+}
+
+// TaskCreateParams holds information used in creating a new task.
+// https://www.twilio.com/docs/taskrouter/api/task#create-a-task-resource
+type TaskCreateParams struct {
+	// WorkflowSid required if the workspace has more than one workflow.
+	WorkflowSid string          `url:",omitempty"`
+	Attributes  json.RawMessage `url:",omitempty"`
+	Priority    int             `url:",omitempty"`
+
+	// Timeout seconds before the task is canceled, 86400 by default.
+	Timeout     int    `url:",omitempty"`
+	TaskChannel string `url:",omitempty"`
+}
+
+func (tcp TaskCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(tcp).Encode())
+}
+
+// TaskUpdateParams holds information used in updating an existing task.
+// https://www.twilio.com/docs/taskrouter/api/task#update-a-task-resource
+type TaskUpdateParams struct {
+	Attributes       json.RawMessage `url:",omitempty"`
+	AssignmentStatus string          `url:",omitempty"`
+	Reason           string          `url:",omitempty"`
+	Priority         int             `url:",omitempty"`
+	TaskChannel      string          `url:",omitempty"`
+}
+
+func (tup TaskUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(tup).Encode())
+}
@@ -0,0 +1,68 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type taskAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Workspaces/{Workspace SID}/Tasks/{Task SID}
+// https://www.twilio.com/docs/taskrouter/api/task#fetch-a-task-resource
+func (api taskAPI) Read(ctx context.Context, workspaceSid, taskSid string) (Task, error) {
+	var task Task
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Tasks/%s", workspaceSid, taskSid), &task)
+	return task, err
+}
+
+// GET /Workspaces/{Workspace SID}/Tasks
+// https://www.twilio.com/docs/taskrouter/api/task#read-multiple-task-resources
+func (api taskAPI) List(ctx context.Context, workspaceSid string, params TaskListParams) (TaskList, error) {
+	var tasks TaskList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Tasks", workspaceSid)+params.query(), &tasks)
+	return tasks, err
+}
+
+// POST /Workspaces/{Workspace SID}/Tasks
+// https://www.twilio.com/docs/taskrouter/api/task#create-a-task-resource
+func (api taskAPI) Create(ctx context.Context, workspaceSid string, body TaskCreateParams) (Task, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Tasks", workspaceSid), body.encode())
+}
+
+// POST /Workspaces/{Workspace SID}/Tasks/{Task SID}
+// https://www.twilio.com/docs/taskrouter/api/task#update-a-task-resource
+func (api taskAPI) Update(ctx context.Context, workspaceSid, taskSid string, body TaskUpdateParams) (Task, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Tasks/%s", workspaceSid, taskSid), body.encode())
+}
+
+// Cancel cancels a pending or reserved task.
+// POST /Workspaces/{Workspace SID}/Tasks/{Task SID}
+// https://www.twilio.com/docs/taskrouter/api/task#update-a-task-resource
+func (api taskAPI) Cancel(ctx context.Context, workspaceSid, taskSid, reason string) (Task, error) {
+	return api.Update(ctx, workspaceSid, taskSid, TaskUpdateParams{AssignmentStatus: AssignmentStatusCanceled, Reason: reason})
+}
+
+// Complete completes an assigned or wrapping task.
+// POST /Workspaces/{Workspace SID}/Tasks/{Task SID}
+// https://www.twilio.com/docs/taskrouter/api/task#update-a-task-resource
+func (api taskAPI) Complete(ctx context.Context, workspaceSid, taskSid, reason string) (Task, error) {
+	return api.Update(ctx, workspaceSid, taskSid, TaskUpdateParams{AssignmentStatus: AssignmentStatusCompleted, Reason: reason})
+}
+
+// DELETE /Workspaces/{Workspace SID}/Tasks/{Task SID}
+// https://www.twilio.com/docs/taskrouter/api/task#delete-a-task-resource
+func (api taskAPI) Delete(ctx context.Context, workspaceSid, taskSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Workspaces/%s/Tasks/%s", workspaceSid, taskSid))
+	return err
+}
+
+func (api taskAPI) post(ctx context.Context, path string, body io.Reader) (Task, error) {
+	var task Task
+	err := twilio.PostInto(ctx, api.client, path, body, &task)
+	return task, err
+}
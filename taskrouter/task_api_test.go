@@ -0,0 +1,270 @@
+package taskrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTaskRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Tasks/WT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/task.json")
+		}
+
+		var (
+			exp  Task
+			f, _ = os.Open("fixtures/task.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		task, err := (taskAPI{client}).Read(context.TODO(), "WS1", "WT1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, task) {
+			t.Errorf("response diff %v", cmp.Diff(exp, task))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskAPI{client}).Read(ctx, "WS1", "WT1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestTaskList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Tasks?AssignmentStatus=pending&AssignmentStatus=reserved"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/tasks.json")
+		}
+
+		var (
+			exp  TaskList
+			f, _ = os.Open("fixtures/tasks.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		tasks, err := (taskAPI{client}).List(context.TODO(), "WS1", TaskListParams{AssignmentStatus: []string{AssignmentStatusPending, AssignmentStatusReserved}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, tasks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, tasks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskAPI{client}).List(ctx, "WS1", TaskListParams{AssignmentStatus: []string{AssignmentStatusPending, AssignmentStatusReserved}})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestTaskCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Attributes=%7B%22type%22%3A%22billing%22%7D&Priority=10&WorkflowSid=WW1")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/task.json")
+		}
+
+		var (
+			exp  Task
+			f, _ = os.Open("fixtures/task.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		task, err := (taskAPI{client}).Create(context.TODO(), "WS1", TaskCreateParams{WorkflowSid: "WW1", Attributes: []byte(`{"type":"billing"}`), Priority: 10})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, task) {
+			t.Errorf("response diff %v", cmp.Diff(exp, task))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskAPI{client}).Create(ctx, "WS1", TaskCreateParams{WorkflowSid: "WW1", Attributes: []byte(`{"type":"billing"}`), Priority: 10})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestTaskUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Priority=20")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/task.json")
+		}
+
+		var (
+			exp  Task
+			f, _ = os.Open("fixtures/task.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		task, err := (taskAPI{client}).Update(context.TODO(), "WS1", "WT1", TaskUpdateParams{Priority: 20})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, task) {
+			t.Errorf("response diff %v", cmp.Diff(exp, task))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskAPI{client}).Update(ctx, "WS1", "WT1", TaskUpdateParams{Priority: 20})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestTaskCancel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AssignmentStatus=canceled&Reason=caller+hung+up")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/task.json")
+		}
+
+		var (
+			exp  Task
+			f, _ = os.Open("fixtures/task.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		task, err := (taskAPI{client}).Cancel(context.TODO(), "WS1", "WT1", "caller hung up")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, task) {
+			t.Errorf("response diff %v", cmp.Diff(exp, task))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskAPI{client}).Cancel(ctx, "WS1", "WT1", "caller hung up")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestTaskComplete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AssignmentStatus=completed&Reason=resolved")
+			)
+
+			if exp := "/Workspaces/WS1/Tasks/WT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/task.json")
+		}
+
+		var (
+			exp  Task
+			f, _ = os.Open("fixtures/task.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		task, err := (taskAPI{client}).Complete(context.TODO(), "WS1", "WT1", "resolved")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, task) {
+			t.Errorf("response diff %v", cmp.Diff(exp, task))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskAPI{client}).Complete(ctx, "WS1", "WT1", "resolved")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestTaskDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Tasks/WT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (taskAPI{client}).Delete(context.TODO(), "WS1", "WT1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (taskAPI{client}).Delete(ctx, "WS1", "WT1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,110 @@
+package taskrouter
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Task orders of a task queue.
+const (
+	TaskOrderFIFO = "FIFO"
+	TaskOrderLIFO = "LIFO"
+)
+
+// TaskQueueResource handles interactions with TaskRouter TaskQueues REST API.
+type TaskQueueResource struct {
+	taskQueueAPI
+}
+
+// TaskQueue holds the tasks waiting for one of the workers matching its target
+// workers expression.
+type TaskQueue struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	WorkspaceSid string `json:"workspace_sid"`
+	FriendlyName string `json:"friendly_name"`
+
+	// TargetWorkers expression matching the workers of the queue, eg. `languages HAS "en"`.
+	TargetWorkers      string `json:"target_workers"`
+	MaxReservedWorkers int    `json:"max_reserved_workers"`
+
+	// TaskOrder TaskOrderFIFO or TaskOrderLIFO.
+	TaskOrder string `json:"task_order"`
+
+	ReservationActivitySid  string `json:"reservation_activity_sid"`
+	ReservationActivityName string `json:"reservation_activity_name"`
+	AssignmentActivitySid   string `json:"assignment_activity_sid"`
+	AssignmentActivityName  string `json:"assignment_activity_name"`
+	EventCallbackURL        string `json:"event_callback_url"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Statistics           string `json:"statistics"`
+		RealTimeStatistics   string `json:"real_time_statistics"`
+		CumulativeStatistics string `json:"cumulative_statistics"`
+		Workspace            string `json:"workspace"`
+	} `json:"links"`
+}
+
+// TaskQueueList holds a page of task queues.
+type TaskQueueList struct {
+	TaskQueues []TaskQueue `json:"task_queues"`
+	Meta       Meta        `json:"meta"`
+}
+
+// TaskQueueListParams holds information used in filtering the task queues listed.
+// https://www.twilio.com/docs/taskrouter/api/task-queue#read-multiple-taskqueue-resources
+type TaskQueueListParams struct {
+	ListParams
+
+	FriendlyName             string `url:",omitempty"`
+	EvaluateWorkerAttributes string `url:",omitempty"`
+	WorkerSid                string `url:",omitempty"`
+	Ordering                 string `url:",omitempty"`
+}
+
+func (p TaskQueueListParams) query() string {
+	return twilio.Query(p)
+}
+
+// TaskQueueCreateParams holds information used in creating a new task queue.
+// https://www.twilio.com/docs/taskrouter/api/task-queue#create-a-taskqueue-resource
+type TaskQueueCreateParams struct {
+	FriendlyName string
+
+	// TargetWorkers all the workers of the workspace if not set, eg. `skills HAS "billing"`.
+	TargetWorkers string `url:",omitempty"`
+
+	// MaxReservedWorkers 1 by default, up to 50.
+	MaxReservedWorkers int    `url:",omitempty"`
+	TaskOrder          string `url:",omitempty"`
+
+	ReservationActivitySid string `url:",omitempty"`
+	AssignmentActivitySid  string `url:",omitempty"`
+}
+
+func (tcp TaskQueueCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(tcp).Encode())
+}
+
+// TaskQueueUpdateParams holds information used in updating an existing task queue.
+// https://www.twilio.com/docs/taskrouter/api/task-queue#update-a-taskqueue-resource
+type TaskQueueUpdateParams struct {
+	FriendlyName           string `url:",omitempty"`
+	TargetWorkers          string `url:",omitempty"`
+	MaxReservedWorkers     int    `url:",omitempty"`
+	TaskOrder              string `url:",omitempty"`
+	ReservationActivitySid string `url:",omitempty"`
+	AssignmentActivitySid  string `url:",omitempty"`
+}
+
+func (tup TaskQueueUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(tup).Encode())
+}
@@ -0,0 +1,54 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type taskQueueAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Workspaces/{Workspace SID}/TaskQueues/{TaskQueue SID}
+// https://www.twilio.com/docs/taskrouter/api/task-queue#fetch-a-taskqueue-resource
+func (api taskQueueAPI) Read(ctx context.Context, workspaceSid, taskQueueSid string) (TaskQueue, error) {
+	var queue TaskQueue
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/TaskQueues/%s", workspaceSid, taskQueueSid), &queue)
+	return queue, err
+}
+
+// GET /Workspaces/{Workspace SID}/TaskQueues
+// https://www.twilio.com/docs/taskrouter/api/task-queue#read-multiple-taskqueue-resources
+func (api taskQueueAPI) List(ctx context.Context, workspaceSid string, params TaskQueueListParams) (TaskQueueList, error) {
+	var queues TaskQueueList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/TaskQueues", workspaceSid)+params.query(), &queues)
+	return queues, err
+}
+
+// POST /Workspaces/{Workspace SID}/TaskQueues
+// https://www.twilio.com/docs/taskrouter/api/task-queue#create-a-taskqueue-resource
+func (api taskQueueAPI) Create(ctx context.Context, workspaceSid string, body TaskQueueCreateParams) (TaskQueue, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/TaskQueues", workspaceSid), body.encode())
+}
+
+// POST /Workspaces/{Workspace SID}/TaskQueues/{TaskQueue SID}
+// https://www.twilio.com/docs/taskrouter/api/task-queue#update-a-taskqueue-resource
+func (api taskQueueAPI) Update(ctx context.Context, workspaceSid, taskQueueSid string, body TaskQueueUpdateParams) (TaskQueue, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/TaskQueues/%s", workspaceSid, taskQueueSid), body.encode())
+}
+
+// DELETE /Workspaces/{Workspace SID}/TaskQueues/{TaskQueue SID}
+// https://www.twilio.com/docs/taskrouter/api/task-queue#delete-a-taskqueue-resource
+func (api taskQueueAPI) Delete(ctx context.Context, workspaceSid, taskQueueSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Workspaces/%s/TaskQueues/%s", workspaceSid, taskQueueSid))
+	return err
+}
+
+func (api taskQueueAPI) post(ctx context.Context, path string, body io.Reader) (TaskQueue, error) {
+	var queue TaskQueue
+	err := twilio.PostInto(ctx, api.client, path, body, &queue)
+	return queue, err
+}
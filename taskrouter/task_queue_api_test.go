@@ -0,0 +1,188 @@
+package taskrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTaskQueueRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/TaskQueues/WQ1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/task_queue.json")
+		}
+
+		var (
+			exp  TaskQueue
+			f, _ = os.Open("fixtures/task_queue.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		q, err := (taskQueueAPI{client}).Read(context.TODO(), "WS1", "WQ1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, q) {
+			t.Errorf("response diff %v", cmp.Diff(exp, q))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskQueueAPI{client}).Read(ctx, "WS1", "WQ1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestTaskQueueList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/TaskQueues?WorkerSid=WK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/task_queues.json")
+		}
+
+		var (
+			exp  TaskQueueList
+			f, _ = os.Open("fixtures/task_queues.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		qs, err := (taskQueueAPI{client}).List(context.TODO(), "WS1", TaskQueueListParams{WorkerSid: "WK1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, qs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, qs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskQueueAPI{client}).List(ctx, "WS1", TaskQueueListParams{WorkerSid: "WK1"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestTaskQueueCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("FriendlyName=billing&TargetWorkers=skills+HAS+%22billing%22&TaskOrder=FIFO")
+			)
+
+			if exp := "/Workspaces/WS1/TaskQueues"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/task_queue.json")
+		}
+
+		var (
+			exp  TaskQueue
+			f, _ = os.Open("fixtures/task_queue.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		q, err := (taskQueueAPI{client}).Create(context.TODO(), "WS1", TaskQueueCreateParams{FriendlyName: "billing", TargetWorkers: `skills HAS "billing"`, TaskOrder: TaskOrderFIFO})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, q) {
+			t.Errorf("response diff %v", cmp.Diff(exp, q))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskQueueAPI{client}).Create(ctx, "WS1", TaskQueueCreateParams{FriendlyName: "billing", TargetWorkers: `skills HAS "billing"`, TaskOrder: TaskOrderFIFO})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestTaskQueueUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("MaxReservedWorkers=3")
+			)
+
+			if exp := "/Workspaces/WS1/TaskQueues/WQ1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/task_queue.json")
+		}
+
+		var (
+			exp  TaskQueue
+			f, _ = os.Open("fixtures/task_queue.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		q, err := (taskQueueAPI{client}).Update(context.TODO(), "WS1", "WQ1", TaskQueueUpdateParams{MaxReservedWorkers: 3})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, q) {
+			t.Errorf("response diff %v", cmp.Diff(exp, q))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (taskQueueAPI{client}).Update(ctx, "WS1", "WQ1", TaskQueueUpdateParams{MaxReservedWorkers: 3})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestTaskQueueDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/TaskQueues/WQ1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (taskQueueAPI{client}).Delete(context.TODO(), "WS1", "WQ1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (taskQueueAPI{client}).Delete(ctx, "WS1", "WQ1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
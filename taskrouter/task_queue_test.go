@@ -0,0 +1,8 @@
+package taskrouter
+
+import "testing"
+
+func TestTaskQueueParamsOptionals(t *testing.T) {
+	t.Run("CreateParams", optionalsFn(TaskQueueCreateParams{}, []byte("FriendlyName=")))
+	t.Run("UpdateParams", optionalsFn(TaskQueueUpdateParams{}, []byte("")))
+}
@@ -0,0 +1,15 @@
+package taskrouter
+
+import "testing"
+
+func TestTaskParamsOptionals(t *testing.T) {
+	t.Run("CreateParams", optionalsFn(TaskCreateParams{}, []byte("")))
+	t.Run("UpdateParams", optionalsFn(TaskUpdateParams{}, []byte("")))
+}
+
+func TestTaskListParamsQuery(t *testing.T) {
+	params := TaskListParams{EvaluateTaskAttributes: `type == "billing"`, Ordering: "Priority:desc"}
+	if exp, got := "?EvaluateTaskAttributes=type+%3D%3D+%22billing%22&Ordering=Priority%3Adesc", params.query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
@@ -0,0 +1,62 @@
+// Package taskrouter is a client of the Twilio TaskRouter v1 API, routing tasks,
+// eg. incoming support channels, to the workers best suited to handle them.
+package taskrouter
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// TaskRouter taskrouter v1 interface
+type TaskRouter struct {
+	Workspaces   WorkspaceResource
+	Workers      WorkerResource
+	Activities   ActivityResource
+	TaskQueues   TaskQueueResource
+	Workflows    WorkflowResource
+	Tasks        TaskResource
+	Reservations ReservationResource
+	Statistics   StatisticsResource
+}
+
+// New returns a taskrouter instance with a base url set to `https://taskrouter.twilio.com/v1`
+// if `TWILIO_TASKROUTER_HOST` not set.
+func New(tctx twilio.Context) (TaskRouter, error) {
+	var taskrouter TaskRouter
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		taskrouterEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return taskrouter, err
+	}
+
+	{
+		taskrouter.Workspaces = WorkspaceResource{workspaceAPI{client}}
+		taskrouter.Workers = WorkerResource{workerAPI{client}}
+		taskrouter.Activities = ActivityResource{activityAPI{client}}
+		taskrouter.TaskQueues = TaskQueueResource{taskQueueAPI{client}}
+		taskrouter.Workflows = WorkflowResource{workflowAPI{client}}
+		taskrouter.Tasks = TaskResource{taskAPI{client}}
+		taskrouter.Reservations = ReservationResource{reservationAPI{client}}
+		taskrouter.Statistics = StatisticsResource{statisticsAPI{client}}
+	}
+	return taskrouter, nil
+}
+
+func taskrouterEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_TASKROUTER_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://taskrouter.%s.twilio.com/v1", region)
+	} else if url == "" {
+		return "https://taskrouter.twilio.com/v1"
+	}
+	return url
+}
@@ -0,0 +1,103 @@
+package taskrouter
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// WorkerResource handles interactions with TaskRouter Workers REST API.
+type WorkerResource struct {
+	workerAPI
+}
+
+// Worker is an agent tasks are routed to, matched by the target expressions of the
+// queues against its attributes.
+type Worker struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	WorkspaceSid string `json:"workspace_sid"`
+	FriendlyName string `json:"friendly_name"`
+	ActivitySid  string `json:"activity_sid"`
+	ActivityName string `json:"activity_name"`
+
+	// Available is set by the activity of the worker.
+	Available bool `json:"available"`
+
+	// Attributes JSON string, eg. `{"skills": ["billing"], "languages": ["en"]}`.
+	Attributes json.RawMessage `json:"attributes"`
+
+	// DateStatusChanged ISO-8601 format.
+	DateStatusChanged string `json:"date_status_changed"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Channels     string `json:"channels"`
+		Reservations string `json:"reservations"`
+		Activity     string `json:"activity"`
+		Workspace    string `json:"workspace"`
+	} `json:"links"`
+}
+
+// WorkerList holds a page of workers.
+type WorkerList struct {
+	Workers []Worker `json:"workers"`
+	Meta    Meta     `json:"meta"`
+}
+
+// WorkerListParams holds information used in filtering the workers listed.
+// https://www.twilio.com/docs/taskrouter/api/worker#read-multiple-worker-resources
+type WorkerListParams struct {
+	ListParams
+
+	ActivityName  string `url:",omitempty"`
+	ActivitySid   string `url:",omitempty"`
+	Available     *bool  `url:",omitempty"`
+	FriendlyName  string `url:",omitempty"`
+	TaskQueueName string `url:",omitempty"`
+	TaskQueueSid  string `url:",omitempty"`
+
+	// TargetWorkersExpression filters the workers by attributes, eg. `skills HAS "billing"`.
+	TargetWorkersExpression string `url:",omitempty"`
+}
+
+func (p WorkerListParams) query() string {
+	return twilio.Query(p)
+}
+
+// WorkerCreateParams holds information used in creating a new worker.
+// https://www.twilio.com/docs/taskrouter/api/worker#create-a-worker-resource
+type WorkerCreateParams struct {
+	FriendlyName string
+
+	// ActivitySid default activity of the workspace if not set.
+	ActivitySid string          `url:",omitempty"`
+	Attributes  json.RawMessage `url:",omitempty"`
+}
+
+func (wcp WorkerCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(wcp).Encode())
+}
+
+// WorkerUpdateParams holds information used in updating an existing worker.
+// https://www.twilio.com/docs/taskrouter/api/worker#update-a-worker-resource
+type WorkerUpdateParams struct {
+	FriendlyName string          `url:",omitempty"`
+	ActivitySid  string          `url:",omitempty"`
+	Attributes   json.RawMessage `url:",omitempty"`
+
+	// RejectPendingReservations rejects the pending reservations when the worker
+	// becomes unavailable.
+	RejectPendingReservations *bool `url:",omitempty"`
+}
+
+func (wup WorkerUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(wup).Encode())
+}
@@ -0,0 +1,62 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type workerAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Workspaces/{Workspace SID}/Workers/{Worker SID}
+// https://www.twilio.com/docs/taskrouter/api/worker#fetch-a-worker-resource
+func (api workerAPI) Read(ctx context.Context, workspaceSid, workerSid string) (Worker, error) {
+	var worker Worker
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Workers/%s", workspaceSid, workerSid), &worker)
+	return worker, err
+}
+
+// GET /Workspaces/{Workspace SID}/Workers
+// https://www.twilio.com/docs/taskrouter/api/worker#read-multiple-worker-resources
+func (api workerAPI) List(ctx context.Context, workspaceSid string, params WorkerListParams) (WorkerList, error) {
+	var workers WorkerList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Workers", workspaceSid)+params.query(), &workers)
+	return workers, err
+}
+
+// POST /Workspaces/{Workspace SID}/Workers
+// https://www.twilio.com/docs/taskrouter/api/worker#create-a-worker-resource
+func (api workerAPI) Create(ctx context.Context, workspaceSid string, body WorkerCreateParams) (Worker, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Workers", workspaceSid), body.encode())
+}
+
+// POST /Workspaces/{Workspace SID}/Workers/{Worker SID}
+// https://www.twilio.com/docs/taskrouter/api/worker#update-a-worker-resource
+func (api workerAPI) Update(ctx context.Context, workspaceSid, workerSid string, body WorkerUpdateParams) (Worker, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Workers/%s", workspaceSid, workerSid), body.encode())
+}
+
+// SetActivity changes the activity of a worker, eg. to an available activity once
+// the agent signs in.
+// POST /Workspaces/{Workspace SID}/Workers/{Worker SID}
+// https://www.twilio.com/docs/taskrouter/api/worker#update-a-worker-resource
+func (api workerAPI) SetActivity(ctx context.Context, workspaceSid, workerSid, activitySid string) (Worker, error) {
+	return api.Update(ctx, workspaceSid, workerSid, WorkerUpdateParams{ActivitySid: activitySid})
+}
+
+// DELETE /Workspaces/{Workspace SID}/Workers/{Worker SID}
+// https://www.twilio.com/docs/taskrouter/api/worker#delete-a-worker-resource
+func (api workerAPI) Delete(ctx context.Context, workspaceSid, workerSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Workspaces/%s/Workers/%s", workspaceSid, workerSid))
+	return err
+}
+
+func (api workerAPI) post(ctx context.Context, path string, body io.Reader) (Worker, error) {
+	var worker Worker
+	err := twilio.PostInto(ctx, api.client, path, body, &worker)
+	return worker, err
+}
@@ -0,0 +1,229 @@
+package taskrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWorkerRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Workers/WK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/worker.json")
+		}
+
+		var (
+			exp  Worker
+			f, _ = os.Open("fixtures/worker.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		wk, err := (workerAPI{client}).Read(context.TODO(), "WS1", "WK1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, wk) {
+			t.Errorf("response diff %v", cmp.Diff(exp, wk))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workerAPI{client}).Read(ctx, "WS1", "WK1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWorkerList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Workers?TargetWorkersExpression=skills+HAS+%22billing%22"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/workers.json")
+		}
+
+		var (
+			exp  WorkerList
+			f, _ = os.Open("fixtures/workers.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		wks, err := (workerAPI{client}).List(context.TODO(), "WS1", WorkerListParams{TargetWorkersExpression: `skills HAS "billing"`})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, wks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, wks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workerAPI{client}).List(ctx, "WS1", WorkerListParams{TargetWorkersExpression: `skills HAS "billing"`})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWorkerCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Attributes=%7B%22skills%22%3A%5B%22billing%22%5D%7D&FriendlyName=alice")
+			)
+
+			if exp := "/Workspaces/WS1/Workers"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/worker.json")
+		}
+
+		var (
+			exp  Worker
+			f, _ = os.Open("fixtures/worker.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		wk, err := (workerAPI{client}).Create(context.TODO(), "WS1", WorkerCreateParams{FriendlyName: "alice", Attributes: []byte(`{"skills":["billing"]}`)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, wk) {
+			t.Errorf("response diff %v", cmp.Diff(exp, wk))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workerAPI{client}).Create(ctx, "WS1", WorkerCreateParams{FriendlyName: "alice", Attributes: []byte(`{"skills":["billing"]}`)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWorkerUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("ActivitySid=WA1&Attributes=%7B%22skills%22%3A%5B%22billing%22%2C%22sales%22%5D%7D")
+			)
+
+			if exp := "/Workspaces/WS1/Workers/WK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/worker.json")
+		}
+
+		var (
+			exp  Worker
+			f, _ = os.Open("fixtures/worker.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		wk, err := (workerAPI{client}).Update(context.TODO(), "WS1", "WK1", WorkerUpdateParams{ActivitySid: "WA1", Attributes: []byte(`{"skills":["billing","sales"]}`)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, wk) {
+			t.Errorf("response diff %v", cmp.Diff(exp, wk))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workerAPI{client}).Update(ctx, "WS1", "WK1", WorkerUpdateParams{ActivitySid: "WA1", Attributes: []byte(`{"skills":["billing","sales"]}`)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWorkerSetActivity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("ActivitySid=WA2")
+			)
+
+			if exp := "/Workspaces/WS1/Workers/WK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/worker.json")
+		}
+
+		var (
+			exp  Worker
+			f, _ = os.Open("fixtures/worker.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		wk, err := (workerAPI{client}).SetActivity(context.TODO(), "WS1", "WK1", "WA2")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, wk) {
+			t.Errorf("response diff %v", cmp.Diff(exp, wk))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workerAPI{client}).SetActivity(ctx, "WS1", "WK1", "WA2")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWorkerDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Workers/WK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (workerAPI{client}).Delete(context.TODO(), "WS1", "WK1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (workerAPI{client}).Delete(ctx, "WS1", "WK1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,19 @@
+package taskrouter
+
+import "testing"
+
+func TestWorkerParamsOptionals(t *testing.T) {
+	t.Run("CreateParams", optionalsFn(WorkerCreateParams{}, []byte("FriendlyName=")))
+	t.Run("UpdateParams", optionalsFn(WorkerUpdateParams{}, []byte("")))
+
+	reject := true
+	exp := []byte("ActivitySid=WA1&RejectPendingReservations=true")
+	t.Run("UpdateParams reject", optionalsFn(WorkerUpdateParams{ActivitySid: "WA1", RejectPendingReservations: &reject}, exp))
+}
+
+func TestWorkerListParamsQuery(t *testing.T) {
+	available := false
+	if exp, got := "?Available=false&TaskQueueSid=WQ1", (WorkerListParams{Available: &available, TaskQueueSid: "WQ1"}).query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
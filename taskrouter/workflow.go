@@ -0,0 +1,109 @@
+package taskrouter
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// WorkflowResource handles interactions with TaskRouter Workflows REST API.
+type WorkflowResource struct {
+	workflowAPI
+}
+
+// Workflow routes the tasks created with it to the task queues of its configuration.
+type Workflow struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	WorkspaceSid string `json:"workspace_sid"`
+	FriendlyName string `json:"friendly_name"`
+
+	// Configuration JSON string, see Config.
+	Configuration string `json:"configuration"`
+
+	AssignmentCallbackURL         string `json:"assignment_callback_url"`
+	FallbackAssignmentCallbackURL string `json:"fallback_assignment_callback_url"`
+	TaskReservationTimeout        int    `json:"task_reservation_timeout"`
+	DocumentContentType           string `json:"document_content_type"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Statistics           string `json:"statistics"`
+		RealTimeStatistics   string `json:"real_time_statistics"`
+		CumulativeStatistics string `json:"cumulative_statistics"`
+	} `json:"links"`
+}
+
+// Config parses the configuration of the workflow.
+func (w Workflow) Config() (WorkflowConfiguration, error) {
+	var cfg WorkflowConfiguration
+	err := json.Unmarshal([]byte(w.Configuration), &cfg)
+	return cfg, err
+}
+
+// WorkflowList holds a page of workflows.
+type WorkflowList struct {
+	Workflows []Workflow `json:"workflows"`
+	Meta      Meta       `json:"meta"`
+}
+
+// WorkflowListParams holds information used in filtering the workflows listed.
+// https://www.twilio.com/docs/taskrouter/api/workflow#read-multiple-workflow-resources
+type WorkflowListParams struct {
+	ListParams
+
+	FriendlyName string `url:",omitempty"`
+}
+
+func (p WorkflowListParams) query() string {
+	return twilio.Query(p)
+}
+
+// WorkflowCreateParams holds information used in creating a new workflow.
+// https://www.twilio.com/docs/taskrouter/api/workflow#create-a-workflow-resource
+type WorkflowCreateParams struct {
+	FriendlyName  string
+	Configuration WorkflowConfiguration `url:"-"`
+
+	// AssignmentCallbackURL called when a worker is reserved for a task.
+	AssignmentCallbackURL         string `url:"AssignmentCallbackUrl,omitempty"`
+	FallbackAssignmentCallbackURL string `url:"FallbackAssignmentCallbackUrl,omitempty"`
+
+	// TaskReservationTimeout seconds, 120 by default.
+	TaskReservationTimeout int `url:",omitempty"`
+}
+
+func (wcp WorkflowCreateParams) encode() io.Reader {
+	vals := twilio.Values(wcp)
+	vals.Set("Configuration", wcp.Configuration.String())
+	return strings.NewReader(vals.Encode())
+}
+
+// WorkflowUpdateParams holds information used in updating an existing workflow,
+// the configuration is kept if not set.
+// https://www.twilio.com/docs/taskrouter/api/workflow#update-a-workflow-resource
+type WorkflowUpdateParams struct {
+	FriendlyName                  string                 `url:",omitempty"`
+	Configuration                 *WorkflowConfiguration `url:"-"`
+	AssignmentCallbackURL         string                 `url:"AssignmentCallbackUrl,omitempty"`
+	FallbackAssignmentCallbackURL string                 `url:"FallbackAssignmentCallbackUrl,omitempty"`
+	TaskReservationTimeout        int                    `url:",omitempty"`
+
+	// ReEvaluateTasks routes the pending tasks again with the new configuration.
+	ReEvaluateTasks *bool `url:",omitempty"`
+}
+
+func (wup WorkflowUpdateParams) encode() io.Reader {
+	vals := twilio.Values(wup)
+	if wup.Configuration != nil {
+		vals.Set("Configuration", wup.Configuration.String())
+	}
+	return strings.NewReader(vals.Encode())
+}
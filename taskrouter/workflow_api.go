@@ -0,0 +1,64 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type workflowAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Workspaces/{Workspace SID}/Workflows/{Workflow SID}
+// https://www.twilio.com/docs/taskrouter/api/workflow#fetch-a-workflow-resource
+func (api workflowAPI) Read(ctx context.Context, workspaceSid, workflowSid string) (Workflow, error) {
+	var flow Workflow
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Workflows/%s", workspaceSid, workflowSid), &flow)
+	return flow, err
+}
+
+// GET /Workspaces/{Workspace SID}/Workflows
+// https://www.twilio.com/docs/taskrouter/api/workflow#read-multiple-workflow-resources
+func (api workflowAPI) List(ctx context.Context, workspaceSid string, params WorkflowListParams) (WorkflowList, error) {
+	var flows WorkflowList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s/Workflows", workspaceSid)+params.query(), &flows)
+	return flows, err
+}
+
+// Create validates the configuration before creating the workflow.
+// POST /Workspaces/{Workspace SID}/Workflows
+// https://www.twilio.com/docs/taskrouter/api/workflow#create-a-workflow-resource
+func (api workflowAPI) Create(ctx context.Context, workspaceSid string, body WorkflowCreateParams) (Workflow, error) {
+	if err := body.Configuration.Validate(); err != nil {
+		return Workflow{}, err
+	}
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Workflows", workspaceSid), body.encode())
+}
+
+// Update validates the configuration, if set, before updating the workflow.
+// POST /Workspaces/{Workspace SID}/Workflows/{Workflow SID}
+// https://www.twilio.com/docs/taskrouter/api/workflow#update-a-workflow-resource
+func (api workflowAPI) Update(ctx context.Context, workspaceSid, workflowSid string, body WorkflowUpdateParams) (Workflow, error) {
+	if body.Configuration != nil {
+		if err := body.Configuration.Validate(); err != nil {
+			return Workflow{}, err
+		}
+	}
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s/Workflows/%s", workspaceSid, workflowSid), body.encode())
+}
+
+// DELETE /Workspaces/{Workspace SID}/Workflows/{Workflow SID}
+// https://www.twilio.com/docs/taskrouter/api/workflow#delete-a-workflow-resource
+func (api workflowAPI) Delete(ctx context.Context, workspaceSid, workflowSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Workspaces/%s/Workflows/%s", workspaceSid, workflowSid))
+	return err
+}
+
+func (api workflowAPI) post(ctx context.Context, path string, body io.Reader) (Workflow, error) {
+	var flow Workflow
+	err := twilio.PostInto(ctx, api.client, path, body, &flow)
+	return flow, err
+}
@@ -0,0 +1,205 @@
+package taskrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestWorkflowRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Workflows/WW1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/workflow.json")
+		}
+
+		var (
+			exp  Workflow
+			f, _ = os.Open("fixtures/workflow.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		flow, err := (workflowAPI{client}).Read(context.TODO(), "WS1", "WW1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, flow) {
+			t.Errorf("response diff %v", cmp.Diff(exp, flow))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workflowAPI{client}).Read(ctx, "WS1", "WW1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWorkflowList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Workflows?FriendlyName=support"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/workflows.json")
+		}
+
+		var (
+			exp  WorkflowList
+			f, _ = os.Open("fixtures/workflows.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		flows, err := (workflowAPI{client}).List(context.TODO(), "WS1", WorkflowListParams{FriendlyName: "support"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, flows) {
+			t.Errorf("response diff %v", cmp.Diff(exp, flows))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workflowAPI{client}).List(ctx, "WS1", WorkflowListParams{FriendlyName: "support"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWorkflowCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AssignmentCallbackUrl=https%3A%2F%2Fexample.com%2Fassignment&Configuration=%7B%22task_routing%22%3A%7B%22filters%22%3A%5B%7B%22filter_friendly_name%22%3A%22Billing%22%2C%22expression%22%3A%22type+%3D%3D+%5C%22billing%5C%22%22%2C%22targets%22%3A%5B%7B%22queue%22%3A%22WQ1%22%2C%22timeout%22%3A60%7D%5D%7D%5D%2C%22default_filter%22%3A%7B%22queue%22%3A%22WQ0%22%7D%7D%7D&FriendlyName=support")
+			)
+
+			if exp := "/Workspaces/WS1/Workflows"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/workflow.json")
+		}
+
+		var (
+			exp  Workflow
+			f, _ = os.Open("fixtures/workflow.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		flow, err := (workflowAPI{client}).Create(context.TODO(), "WS1", WorkflowCreateParams{FriendlyName: "support", Configuration: NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`, RoutingTarget{Queue: "WQ1", Timeout: 60}), AssignmentCallbackURL: "https://example.com/assignment"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, flow) {
+			t.Errorf("response diff %v", cmp.Diff(exp, flow))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workflowAPI{client}).Create(ctx, "WS1", WorkflowCreateParams{FriendlyName: "support", Configuration: NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`, RoutingTarget{Queue: "WQ1", Timeout: 60}), AssignmentCallbackURL: "https://example.com/assignment"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWorkflowUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("TaskReservationTimeout=60")
+			)
+
+			if exp := "/Workspaces/WS1/Workflows/WW1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/workflow.json")
+		}
+
+		var (
+			exp  Workflow
+			f, _ = os.Open("fixtures/workflow.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		flow, err := (workflowAPI{client}).Update(context.TODO(), "WS1", "WW1", WorkflowUpdateParams{TaskReservationTimeout: 60})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, flow) {
+			t.Errorf("response diff %v", cmp.Diff(exp, flow))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workflowAPI{client}).Update(ctx, "WS1", "WW1", WorkflowUpdateParams{TaskReservationTimeout: 60})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWorkflowDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1/Workflows/WW1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (workflowAPI{client}).Delete(context.TODO(), "WS1", "WW1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (workflowAPI{client}).Delete(ctx, "WS1", "WW1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestWorkflowInvalidConfiguration(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+		t.Error("exp no request")
+		return nil, nil
+	}
+	if _, err := (workflowAPI{client}).Create(context.TODO(), "WS1", WorkflowCreateParams{FriendlyName: "support"}); err != ErrMissingDefaultQueue {
+		t.Errorf("exp err %v, got %v", ErrMissingDefaultQueue, err)
+	}
+
+	cfg := NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`)
+	if _, err := (workflowAPI{client}).Update(context.TODO(), "WS1", "WW1", WorkflowUpdateParams{Configuration: &cfg}); errors.Cause(err) != ErrMissingFilterTarget {
+		t.Errorf("exp err %v, got %v", ErrMissingFilterTarget, err)
+	}
+}
@@ -0,0 +1,111 @@
+package taskrouter
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Errors returned by an invalid workflow configuration.
+var (
+	ErrMissingDefaultQueue = errors.New("taskrouter: workflow configuration without a default queue")
+	ErrMissingFilterTarget = errors.New("taskrouter: workflow filter without a target queue")
+	ErrMissingExpression   = errors.New("taskrouter: workflow filter without an expression")
+)
+
+// WorkflowConfiguration holds the rules routing the tasks of a workflow to its
+// queues, build it with NewWorkflowConfiguration.
+// https://www.twilio.com/docs/taskrouter/workflow-configuration
+type WorkflowConfiguration struct {
+	TaskRouting TaskRouting `json:"task_routing"`
+}
+
+// TaskRouting holds the filters evaluated in order against the attributes of a
+// task, tasks matching no filter are routed to the default filter.
+type TaskRouting struct {
+	Filters       []WorkflowFilter `json:"filters"`
+	DefaultFilter *RoutingTarget   `json:"default_filter,omitempty"`
+}
+
+// WorkflowFilter routes the tasks matching its expression, eg. `type == "billing"`,
+// through its targets in order, until a worker accepts the task.
+type WorkflowFilter struct {
+	FilterFriendlyName string          `json:"filter_friendly_name"`
+	Expression         string          `json:"expression"`
+	Targets            []RoutingTarget `json:"targets"`
+}
+
+// RoutingTarget is a queue a task is routed to, a target is skipped after its
+// timeout in seconds.
+type RoutingTarget struct {
+	Queue string `json:"queue"`
+
+	// Expression narrows the workers of the queue, eg. `task.language IN worker.languages`.
+	Expression string `json:"expression,omitempty"`
+	Priority   int    `json:"priority,omitempty"`
+	Timeout    int    `json:"timeout,omitempty"`
+
+	// OrderBy orders the matching workers, eg. `worker.level DESC`.
+	OrderBy string `json:"order_by,omitempty"`
+
+	// SkipIf skips the target when the expression holds, eg. `workers.available == 0`.
+	SkipIf string `json:"skip_if,omitempty"`
+}
+
+// NewWorkflowConfiguration returns a configuration routing every task to the
+// default queue, use Filter to route the tasks by their attributes.
+//
+//	cfg := NewWorkflowConfiguration("WQdefault").
+//		Filter("Billing", `type == "billing"`, RoutingTarget{Queue: "WQbilling", Timeout: 60}).
+//		Filter("Spanish", `language == "es"`, RoutingTarget{Queue: "WQspanish"})
+func NewWorkflowConfiguration(defaultQueueSid string) WorkflowConfiguration {
+	return WorkflowConfiguration{
+		TaskRouting: TaskRouting{
+			Filters:       []WorkflowFilter{},
+			DefaultFilter: &RoutingTarget{Queue: defaultQueueSid},
+		},
+	}
+}
+
+// Filter returns a copy of the configuration with the filter appended, filters are
+// evaluated in the order they are added.
+func (wc WorkflowConfiguration) Filter(name, expression string, targets ...RoutingTarget) WorkflowConfiguration {
+	filters := make([]WorkflowFilter, len(wc.TaskRouting.Filters), len(wc.TaskRouting.Filters)+1)
+	copy(filters, wc.TaskRouting.Filters)
+	wc.TaskRouting.Filters = append(filters, WorkflowFilter{
+		FilterFriendlyName: name,
+		Expression:         expression,
+		Targets:            targets,
+	})
+	return wc
+}
+
+// Validate checks the configuration routes every task to a queue.
+func (wc WorkflowConfiguration) Validate() error {
+	if wc.TaskRouting.DefaultFilter == nil || wc.TaskRouting.DefaultFilter.Queue == "" {
+		return ErrMissingDefaultQueue
+	}
+	for _, filter := range wc.TaskRouting.Filters {
+		if filter.Expression == "" {
+			return errors.Wrapf(ErrMissingExpression, "filter %q", filter.FilterFriendlyName)
+		}
+		if len(filter.Targets) == 0 {
+			return errors.Wrapf(ErrMissingFilterTarget, "filter %q", filter.FilterFriendlyName)
+		}
+		for _, target := range filter.Targets {
+			if target.Queue == "" {
+				return errors.Wrapf(ErrMissingFilterTarget, "filter %q", filter.FilterFriendlyName)
+			}
+		}
+	}
+	return nil
+}
+
+// String returns the JSON configuration sent to the API.
+func (wc WorkflowConfiguration) String() string {
+	if wc.TaskRouting.Filters == nil {
+		wc.TaskRouting.Filters = []WorkflowFilter{}
+	}
+	b, _ := json.Marshal(wc)
+	return string(b)
+}
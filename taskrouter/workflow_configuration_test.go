@@ -0,0 +1,60 @@
+package taskrouter
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestWorkflowConfigurationFilter(t *testing.T) {
+	base := NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`, RoutingTarget{Queue: "WQ1"})
+	spanish := base.Filter("Spanish", `language == "es"`, RoutingTarget{Queue: "WQ2"})
+	french := base.Filter("French", `language == "fr"`, RoutingTarget{Queue: "WQ3"})
+
+	if got := len(base.TaskRouting.Filters); got != 1 {
+		t.Errorf("exp base config unchanged, got %d filters", got)
+	}
+	if exp, got := "Spanish", spanish.TaskRouting.Filters[1].FilterFriendlyName; exp != got {
+		t.Errorf("exp filter %s, got %s", exp, got)
+	}
+	if exp, got := "French", french.TaskRouting.Filters[1].FilterFriendlyName; exp != got {
+		t.Errorf("exp filter %s, got %s", exp, got)
+	}
+}
+
+func TestWorkflowConfigurationValidate(t *testing.T) {
+	tt := []struct {
+		name string
+		cfg  WorkflowConfiguration
+		err  error
+	}{
+		{"valid", NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`, RoutingTarget{Queue: "WQ1"}), nil},
+		{"no default", WorkflowConfiguration{}, ErrMissingDefaultQueue},
+		{"empty default", NewWorkflowConfiguration(""), ErrMissingDefaultQueue},
+		{"no expression", NewWorkflowConfiguration("WQ0").Filter("Billing", "", RoutingTarget{Queue: "WQ1"}), ErrMissingExpression},
+		{"no targets", NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`), ErrMissingFilterTarget},
+		{"no target queue", NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`, RoutingTarget{Timeout: 30}), ErrMissingFilterTarget},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.cfg.Validate(); errors.Cause(err) != tc.err {
+				t.Errorf("exp err %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestWorkflowConfigurationString(t *testing.T) {
+	cfg := NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`, RoutingTarget{
+		Queue:      "WQ1",
+		Expression: "task.language IN worker.languages",
+		Priority:   5,
+		Timeout:    60,
+		OrderBy:    "worker.level DESC",
+		SkipIf:     "workers.available == 0",
+	})
+	exp := `{"task_routing":{"filters":[{"filter_friendly_name":"Billing","expression":"type == \"billing\"","targets":[{"queue":"WQ1","expression":"task.language IN worker.languages","priority":5,"timeout":60,"order_by":"worker.level DESC","skip_if":"workers.available == 0"}]}],"default_filter":{"queue":"WQ0"}}}`
+	if got := cfg.String(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
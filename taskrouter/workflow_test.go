@@ -0,0 +1,41 @@
+package taskrouter
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWorkflowParamsOptionals(t *testing.T) {
+	exp := []byte("Configuration=%7B%22task_routing%22%3A%7B%22filters%22%3A%5B%5D%7D%7D&FriendlyName=")
+	t.Run("CreateParams", optionalsFn(WorkflowCreateParams{}, exp))
+	t.Run("UpdateParams", optionalsFn(WorkflowUpdateParams{}, []byte("")))
+
+	reevaluate := true
+	cfg := NewWorkflowConfiguration("WQ0")
+	exp = []byte("Configuration=%7B%22task_routing%22%3A%7B%22filters%22%3A%5B%5D%2C%22default_filter%22%3A%7B%22queue%22%3A%22WQ0%22%7D%7D%7D&ReEvaluateTasks=true")
+	t.Run("UpdateParams configuration", optionalsFn(WorkflowUpdateParams{Configuration: &cfg, ReEvaluateTasks: &reevaluate}, exp))
+}
+
+func TestWorkflowConfig(t *testing.T) {
+	var (
+		flow Workflow
+		f, _ = os.Open("fixtures/workflow.json")
+	)
+	json.NewDecoder(f).Decode(&flow)
+
+	cfg, err := flow.Config()
+	if err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	exp := NewWorkflowConfiguration("WQ0").Filter("Billing", `type == "billing"`, RoutingTarget{Queue: "WQ1", Timeout: 60})
+	if !cmp.Equal(exp, cfg) {
+		t.Errorf("config diff %v", cmp.Diff(exp, cfg))
+	}
+
+	if _, err := (Workflow{Configuration: "{"}).Config(); err == nil {
+		t.Error("exp err, got nil")
+	}
+}
@@ -0,0 +1,103 @@
+package taskrouter
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// WorkspaceResource handles interactions with TaskRouter Workspaces REST API.
+type WorkspaceResource struct {
+	workspaceAPI
+}
+
+// Workspace holds the workers, activities, queues, workflows and tasks of a routing
+// application.
+type Workspace struct {
+	Sid                 string `json:"sid"`
+	AccountSid          string `json:"account_sid"`
+	FriendlyName        string `json:"friendly_name"`
+	EventCallbackURL    string `json:"event_callback_url"`
+	EventsFilter        string `json:"events_filter"`
+	DefaultActivitySid  string `json:"default_activity_sid"`
+	DefaultActivityName string `json:"default_activity_name"`
+	TimeoutActivitySid  string `json:"timeout_activity_sid"`
+	TimeoutActivityName string `json:"timeout_activity_name"`
+
+	// MultiTaskEnabled lets workers handle multiple tasks at once.
+	MultiTaskEnabled bool `json:"multi_task_enabled"`
+
+	// PrioritizeQueueOrder FIFO or LIFO order of the tasks of the same priority.
+	PrioritizeQueueOrder string `json:"prioritize_queue_order"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Activities   string `json:"activities"`
+		Workers      string `json:"workers"`
+		TaskQueues   string `json:"task_queues"`
+		Workflows    string `json:"workflows"`
+		Tasks        string `json:"tasks"`
+		Statistics   string `json:"statistics"`
+		TaskChannels string `json:"task_channels"`
+		Events       string `json:"events"`
+	} `json:"links"`
+}
+
+// WorkspaceList holds a page of workspaces.
+type WorkspaceList struct {
+	Workspaces []Workspace `json:"workspaces"`
+	Meta       Meta        `json:"meta"`
+}
+
+// WorkspaceListParams holds information used in filtering the workspaces listed.
+// https://www.twilio.com/docs/taskrouter/api/workspace#read-multiple-workspace-resources
+type WorkspaceListParams struct {
+	ListParams
+
+	FriendlyName string `url:",omitempty"`
+}
+
+func (p WorkspaceListParams) query() string {
+	return twilio.Query(p)
+}
+
+// WorkspaceCreateParams holds information used in creating a new workspace.
+// https://www.twilio.com/docs/taskrouter/api/workspace#create-a-workspace-resource
+type WorkspaceCreateParams struct {
+	FriendlyName     string
+	EventCallbackURL string `url:"EventCallbackUrl,omitempty"`
+
+	// EventsFilter comma separated event types sent to the event callback.
+	EventsFilter         string `url:",omitempty"`
+	MultiTaskEnabled     *bool  `url:",omitempty"`
+	PrioritizeQueueOrder string `url:",omitempty"`
+
+	// Template FIFO creates the default activities, queue and workflow.
+	Template string `url:",omitempty"`
+}
+
+func (wcp WorkspaceCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(wcp).Encode())
+}
+
+// WorkspaceUpdateParams holds information used in updating an existing workspace.
+// https://www.twilio.com/docs/taskrouter/api/workspace#update-a-workspace-resource
+type WorkspaceUpdateParams struct {
+	FriendlyName         string `url:",omitempty"`
+	DefaultActivitySid   string `url:",omitempty"`
+	TimeoutActivitySid   string `url:",omitempty"`
+	EventCallbackURL     string `url:"EventCallbackUrl,omitempty"`
+	EventsFilter         string `url:",omitempty"`
+	MultiTaskEnabled     *bool  `url:",omitempty"`
+	PrioritizeQueueOrder string `url:",omitempty"`
+}
+
+func (wup WorkspaceUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(wup).Encode())
+}
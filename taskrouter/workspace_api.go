@@ -0,0 +1,54 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type workspaceAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Workspaces/{Workspace SID}
+// https://www.twilio.com/docs/taskrouter/api/workspace#fetch-a-workspace-resource
+func (api workspaceAPI) Read(ctx context.Context, workspaceSid string) (Workspace, error) {
+	var ws Workspace
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Workspaces/%s", workspaceSid), &ws)
+	return ws, err
+}
+
+// GET /Workspaces
+// https://www.twilio.com/docs/taskrouter/api/workspace#read-multiple-workspace-resources
+func (api workspaceAPI) List(ctx context.Context, params WorkspaceListParams) (WorkspaceList, error) {
+	var wss WorkspaceList
+	err := twilio.GetInto(ctx, api.client, "/Workspaces"+params.query(), &wss)
+	return wss, err
+}
+
+// POST /Workspaces
+// https://www.twilio.com/docs/taskrouter/api/workspace#create-a-workspace-resource
+func (api workspaceAPI) Create(ctx context.Context, body WorkspaceCreateParams) (Workspace, error) {
+	return api.post(ctx, "/Workspaces", body.encode())
+}
+
+// POST /Workspaces/{Workspace SID}
+// https://www.twilio.com/docs/taskrouter/api/workspace#update-a-workspace-resource
+func (api workspaceAPI) Update(ctx context.Context, workspaceSid string, body WorkspaceUpdateParams) (Workspace, error) {
+	return api.post(ctx, fmt.Sprintf("/Workspaces/%s", workspaceSid), body.encode())
+}
+
+// DELETE /Workspaces/{Workspace SID}
+// https://www.twilio.com/docs/taskrouter/api/workspace#delete-a-workspace-resource
+func (api workspaceAPI) Delete(ctx context.Context, workspaceSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Workspaces/%s", workspaceSid))
+	return err
+}
+
+func (api workspaceAPI) post(ctx context.Context, path string, body io.Reader) (Workspace, error) {
+	var ws Workspace
+	err := twilio.PostInto(ctx, api.client, path, body, &ws)
+	return ws, err
+}
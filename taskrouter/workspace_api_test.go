@@ -0,0 +1,188 @@
+package taskrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWorkspaceRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/workspace.json")
+		}
+
+		var (
+			exp  Workspace
+			f, _ = os.Open("fixtures/workspace.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		ws, err := (workspaceAPI{client}).Read(context.TODO(), "WS1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, ws) {
+			t.Errorf("response diff %v", cmp.Diff(exp, ws))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workspaceAPI{client}).Read(ctx, "WS1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWorkspaceList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces?FriendlyName=support"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/workspaces.json")
+		}
+
+		var (
+			exp  WorkspaceList
+			f, _ = os.Open("fixtures/workspaces.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		wss, err := (workspaceAPI{client}).List(context.TODO(), WorkspaceListParams{FriendlyName: "support"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, wss) {
+			t.Errorf("response diff %v", cmp.Diff(exp, wss))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workspaceAPI{client}).List(ctx, WorkspaceListParams{FriendlyName: "support"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestWorkspaceCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("EventCallbackUrl=https%3A%2F%2Fexample.com%2Fevents&FriendlyName=support&PrioritizeQueueOrder=FIFO")
+			)
+
+			if exp := "/Workspaces"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/workspace.json")
+		}
+
+		var (
+			exp  Workspace
+			f, _ = os.Open("fixtures/workspace.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		ws, err := (workspaceAPI{client}).Create(context.TODO(), WorkspaceCreateParams{FriendlyName: "support", EventCallbackURL: "https://example.com/events", PrioritizeQueueOrder: "FIFO"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, ws) {
+			t.Errorf("response diff %v", cmp.Diff(exp, ws))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workspaceAPI{client}).Create(ctx, WorkspaceCreateParams{FriendlyName: "support", EventCallbackURL: "https://example.com/events", PrioritizeQueueOrder: "FIFO"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWorkspaceUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("DefaultActivitySid=WA1")
+			)
+
+			if exp := "/Workspaces/WS1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/workspace.json")
+		}
+
+		var (
+			exp  Workspace
+			f, _ = os.Open("fixtures/workspace.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		ws, err := (workspaceAPI{client}).Update(context.TODO(), "WS1", WorkspaceUpdateParams{DefaultActivitySid: "WA1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, ws) {
+			t.Errorf("response diff %v", cmp.Diff(exp, ws))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (workspaceAPI{client}).Update(ctx, "WS1", WorkspaceUpdateParams{DefaultActivitySid: "WA1"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestWorkspaceDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Workspaces/WS1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (workspaceAPI{client}).Delete(context.TODO(), "WS1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (workspaceAPI{client}).Delete(ctx, "WS1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,33 @@
+package taskrouter
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+type optionals interface {
+	encode() io.Reader
+}
+
+var optionalsFn = func(m optionals, exp []byte) func(*testing.T) {
+	return func(t *testing.T) {
+		got, err := ioutil.ReadAll(m.encode())
+		if err != nil {
+			t.Errorf("exp parsing err, got %v", err)
+		}
+		if !bytes.Equal(got, exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	}
+}
+
+func TestWorkspaceParamsOptionals(t *testing.T) {
+	t.Run("CreateParams", optionalsFn(WorkspaceCreateParams{}, []byte("FriendlyName=")))
+	t.Run("UpdateParams", optionalsFn(WorkspaceUpdateParams{}, []byte("")))
+
+	disabled := false
+	exp := []byte("MultiTaskEnabled=false")
+	t.Run("UpdateParams disabled", optionalsFn(WorkspaceUpdateParams{MultiTaskEnabled: &disabled}, exp))
+}
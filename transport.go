@@ -0,0 +1,33 @@
+package twilio
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// DefaultHTTPClient is the RequestHandler used by `NewContext`, all the API clients
+// created with it share the connection pool of the transport.
+var DefaultHTTPClient = &http.Client{
+	Timeout:   2 * time.Minute,
+	Transport: NewTransport(),
+}
+
+// NewTransport returns an *http.Transport tuned for concurrent requests to the
+// Twilio API, with keep-alives, HTTP/2 and timeouts for stalled connections.
+func NewTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          200,
+		MaxIdleConnsPerHost:   100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+}
@@ -0,0 +1,32 @@
+package twilio
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewTransport(t *testing.T) {
+	tr := NewTransport()
+
+	if !tr.ForceAttemptHTTP2 {
+		t.Error("exp HTTP/2 to be enabled")
+	}
+	if tr.MaxIdleConnsPerHost <= http.DefaultMaxIdleConnsPerHost {
+		t.Errorf("exp more than %d idle conns per host, got %d", http.DefaultMaxIdleConnsPerHost, tr.MaxIdleConnsPerHost)
+	}
+	if tr.ResponseHeaderTimeout == 0 || tr.TLSHandshakeTimeout == 0 {
+		t.Error("exp transport timeouts")
+	}
+	if tr == NewTransport() {
+		t.Error("exp a new transport on every call")
+	}
+}
+
+func TestDefaultHTTPClient(t *testing.T) {
+	if DefaultHTTPClient.Timeout == 0 {
+		t.Error("exp client timeout")
+	}
+	if _, ok := DefaultHTTPClient.Transport.(*http.Transport); !ok {
+		t.Errorf("exp *http.Transport, got %T", DefaultHTTPClient.Transport)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+
+	"github.com/pkg/errors"
 )
 
 // ErrTwilioResponse returned when response codes are greater than 400.
@@ -17,6 +19,12 @@ func (e ErrTwilioResponse) Error() string {
 	return fmt.Sprintf("%d: %d, %s", e.Status, e.Code, e.Message)
 }
 
+// IsNotFound reports whether err is the response of a missing resource.
+func IsNotFound(err error) bool {
+	terr, ok := errors.Cause(err).(ErrTwilioResponse)
+	return ok && terr.Status == http.StatusNotFound
+}
+
 // Context store for credentials, configuration and the http client.
 type Context struct {
 	AccountSID string
@@ -28,16 +36,37 @@ type Context struct {
 	Region string
 
 	RequestHandler RequestHandler
+
+	// Logger optional, logs every request made by the API clients
+	// with the secrets and message bodies redacted.
+	Logger Logger
+
+	// MaxBodySize optional, limits the size of the API responses. Defaults to
+	// `DefaultMaxBodySize`, a negative size disables the limit.
+	MaxBodySize int64
 }
 
-// NewContext returns a new Context with a http.DefaultClient and various informations
+// String implements fmt.Stringer, the APISecret is redacted.
+func (c Context) String() string {
+	secret := ""
+	if c.APISecret != "" {
+		secret = Redacted
+	}
+	return fmt.Sprintf("{AccountSID:%s APIKey:%s APISecret:%s Region:%s}", c.AccountSID, c.APIKey, secret, c.Region)
+}
+
+// NewContext returns a new Context with the DefaultHTTPClient and various informations
 // loaded from envs.
 func NewContext() Context {
-	return NewContextWithHTTP("", "", "", "", http.DefaultClient)
+	return NewContextWithHTTP("", "", "", "", DefaultHTTPClient)
 }
 
-// NewContextWithHTTP sames as `NewContext` but requires a `twilio.RequestHandler`.
+// NewContextWithHTTP sames as `NewContext` but requires a `twilio.RequestHandler`,
+// a nil handler falls back to the DefaultHTTPClient.
 func NewContextWithHTTP(accountSID, apiKey, apiSecret, region string, reqHandler RequestHandler) Context {
+	if reqHandler == nil {
+		reqHandler = DefaultHTTPClient
+	}
 	if accountSID == "" {
 		accountSID = os.Getenv("TWILIO_ACCOUNT_SID")
 	}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"os"
 	"testing"
+
+	"github.com/pkg/errors"
 )
 
 func TestNewContext(t *testing.T) {
@@ -45,11 +47,26 @@ func TestNewContext(t *testing.T) {
 	if c.Region != region {
 		t.Errorf("exp auth %s, got %s", auth, c.Region)
 	}
-	if c.RequestHandler != http.DefaultClient {
-		t.Errorf("exp *http.Client, got %T", c.RequestHandler)
+	if c.RequestHandler != DefaultHTTPClient {
+		t.Errorf("exp twilio.DefaultHTTPClient, got %T", c.RequestHandler)
 	}
 }
 
+func TestNewContextWithHTTP(t *testing.T) {
+	t.Run("custom request handler", func(t *testing.T) {
+		rh := &http.Client{}
+		if c := NewContextWithHTTP("", "", "", "", rh); c.RequestHandler != rh {
+			t.Errorf("exp custom request handler, got %v", c.RequestHandler)
+		}
+	})
+
+	t.Run("nil request handler", func(t *testing.T) {
+		if c := NewContextWithHTTP("", "", "", "", nil); c.RequestHandler != DefaultHTTPClient {
+			t.Errorf("exp twilio.DefaultHTTPClient, got %v", c.RequestHandler)
+		}
+	})
+}
+
 func TestErrTwilioResponse(t *testing.T) {
 	err := ErrTwilioResponse{1, 2, "msg"}
 
@@ -58,3 +75,26 @@ func TestErrTwilioResponse(t *testing.T) {
 		t.Errorf("exp err msg %s, got %s", exp, err.Error())
 	}
 }
+
+func TestIsNotFound(t *testing.T) {
+	tt := map[error]bool{
+		ErrTwilioResponse{Status: 404, Code: 20404}:                           true,
+		errors.Wrap(ErrTwilioResponse{Status: 404}, "could not read channel"): true,
+		ErrTwilioResponse{Status: 400}:                                        false,
+		errors.New("network"):                                                 false,
+	}
+	for err, exp := range tt {
+		if got := IsNotFound(err); exp != got {
+			t.Errorf("exp IsNotFound(%v) %v, got %v", err, exp, got)
+		}
+	}
+}
+
+func TestContextString(t *testing.T) {
+	c := Context{AccountSID: "acc", APIKey: "key", APISecret: "secret", Region: "ie1"}
+
+	exp := "{AccountSID:acc APIKey:key APISecret:REDACTED Region:ie1}"
+	if got := fmt.Sprintf("%v", c); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
@@ -0,0 +1,162 @@
+package twiml
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// InboundMessage is the request made to the webhook of a phone number or service
+// when it receives a message.
+// https://www.twilio.com/docs/messaging/guides/webhook-request
+type InboundMessage struct {
+	MessageSid          string
+	AccountSid          string
+	MessagingServiceSid string
+	From                string
+	To                  string
+	Body                string
+	NumSegments         int
+	Media               []InboundMedia
+	FromCity            string
+	FromState           string
+	FromZip             string
+	FromCountry         string
+}
+
+// InboundMedia is an attachment of an inbound MMS.
+type InboundMedia struct {
+	URL         string
+	ContentType string
+}
+
+// InboundCall is the request made to the voice webhook of a phone number when it
+// receives a call, and to the action urls of the verbs during the call.
+// https://www.twilio.com/docs/voice/twiml#request-parameters
+type InboundCall struct {
+	CallSid       string
+	AccountSid    string
+	From          string
+	To            string
+	CallStatus    string
+	Direction     string
+	CallerName    string
+	ForwardedFrom string
+
+	// Digits and SpeechResult are sent to the action url of Gather.
+	Digits       string
+	SpeechResult string
+	Confidence   float64
+
+	// RecordingURL is sent to the action url of Record.
+	RecordingURL      string
+	RecordingDuration int
+}
+
+// ParseInboundMessage parses the form params of an inbound message request.
+func ParseInboundMessage(params url.Values) InboundMessage {
+	msg := InboundMessage{
+		MessageSid:          params.Get("MessageSid"),
+		AccountSid:          params.Get("AccountSid"),
+		MessagingServiceSid: params.Get("MessagingServiceSid"),
+		From:                params.Get("From"),
+		To:                  params.Get("To"),
+		Body:                params.Get("Body"),
+		FromCity:            params.Get("FromCity"),
+		FromState:           params.Get("FromState"),
+		FromZip:             params.Get("FromZip"),
+		FromCountry:         params.Get("FromCountry"),
+	}
+	msg.NumSegments, _ = strconv.Atoi(params.Get("NumSegments"))
+
+	numMedia, _ := strconv.Atoi(params.Get("NumMedia"))
+	for i := 0; i < numMedia; i++ {
+		n := strconv.Itoa(i)
+		msg.Media = append(msg.Media, InboundMedia{
+			URL:         params.Get("MediaUrl" + n),
+			ContentType: params.Get("MediaContentType" + n),
+		})
+	}
+	return msg
+}
+
+// ParseInboundCall parses the form params of an inbound call request.
+func ParseInboundCall(params url.Values) InboundCall {
+	call := InboundCall{
+		CallSid:       params.Get("CallSid"),
+		AccountSid:    params.Get("AccountSid"),
+		From:          params.Get("From"),
+		To:            params.Get("To"),
+		CallStatus:    params.Get("CallStatus"),
+		Direction:     params.Get("Direction"),
+		CallerName:    params.Get("CallerName"),
+		ForwardedFrom: params.Get("ForwardedFrom"),
+		Digits:        params.Get("Digits"),
+		SpeechResult:  params.Get("SpeechResult"),
+		RecordingURL:  params.Get("RecordingUrl"),
+	}
+	call.Confidence, _ = strconv.ParseFloat(params.Get("Confidence"), 64)
+	call.RecordingDuration, _ = strconv.Atoi(params.Get("RecordingDuration"))
+	return call
+}
+
+// MessageHandlerFunc returns the TwiML replying to an inbound message, a nil
+// response replies nothing.
+type MessageHandlerFunc func(context.Context, InboundMessage) (*Response, error)
+
+// CallHandlerFunc returns the TwiML controlling an inbound call.
+type CallHandlerFunc func(context.Context, InboundCall) (*Response, error)
+
+// MessageHandler returns an http.Handler validating the signature of the inbound
+// message webhooks and writing the TwiML returned by fn.
+func MessageHandler(validator twilio.WebhookValidator, fn MessageHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params, err := validator.Validate(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		resp, err := fn(r.Context(), ParseInboundMessage(params))
+		write(w, resp, err)
+	})
+}
+
+// CallHandler returns an http.Handler validating the signature of the inbound
+// call webhooks and writing the TwiML returned by fn.
+func CallHandler(validator twilio.WebhookValidator, fn CallHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params, err := validator.Validate(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		resp, err := fn(r.Context(), ParseInboundCall(params))
+		write(w, resp, err)
+	})
+}
+
+// ServeHTTP implements http.Handler, writing a static document.
+func (r *Response) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	write(w, r, nil)
+}
+
+func write(w http.ResponseWriter, resp *Response, err error) {
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if resp == nil {
+		resp = NewResponse()
+	}
+
+	b, err := resp.Bytes()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.Write(b)
+}
@@ -0,0 +1,147 @@
+package twiml
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+const webhookURL = "https://example.com/inbound"
+
+var validator = twilio.WebhookValidator{AuthToken: "12345"}
+
+func signedRequest(params url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, webhookURL, strings.NewReader(params.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set(twilio.SignatureHeader, twilio.Signature("12345", webhookURL, params))
+	return r
+}
+
+func TestParseInboundMessage(t *testing.T) {
+	params := url.Values{
+		"MessageSid":        {"SM1"},
+		"AccountSid":        {"AC1"},
+		"From":              {"+14155552345"},
+		"To":                {"+14155552346"},
+		"Body":              {"hello"},
+		"NumSegments":       {"1"},
+		"NumMedia":          {"2"},
+		"MediaUrl0":         {"https://example.com/0"},
+		"MediaContentType0": {"image/jpeg"},
+		"MediaUrl1":         {"https://example.com/1"},
+		"MediaContentType1": {"image/png"},
+		"FromCountry":       {"US"},
+	}
+	exp := InboundMessage{
+		MessageSid:  "SM1",
+		AccountSid:  "AC1",
+		From:        "+14155552345",
+		To:          "+14155552346",
+		Body:        "hello",
+		NumSegments: 1,
+		Media: []InboundMedia{
+			{URL: "https://example.com/0", ContentType: "image/jpeg"},
+			{URL: "https://example.com/1", ContentType: "image/png"},
+		},
+		FromCountry: "US",
+	}
+
+	if got := ParseInboundMessage(params); !cmp.Equal(exp, got) {
+		t.Errorf("response diff %v", cmp.Diff(exp, got))
+	}
+}
+
+func TestParseInboundCall(t *testing.T) {
+	params := url.Values{
+		"CallSid":      {"CA1"},
+		"From":         {"+14155552345"},
+		"CallStatus":   {"in-progress"},
+		"Digits":       {"1"},
+		"SpeechResult": {"sales"},
+		"Confidence":   {"0.9"},
+	}
+	exp := InboundCall{
+		CallSid:      "CA1",
+		From:         "+14155552345",
+		CallStatus:   "in-progress",
+		Digits:       "1",
+		SpeechResult: "sales",
+		Confidence:   0.9,
+	}
+
+	if got := ParseInboundCall(params); !cmp.Equal(exp, got) {
+		t.Errorf("response diff %v", cmp.Diff(exp, got))
+	}
+}
+
+func TestMessageHandler(t *testing.T) {
+	handler := MessageHandler(validator, func(ctx context.Context, msg InboundMessage) (*Response, error) {
+		switch msg.Body {
+		case "error":
+			return nil, errors.New("bot unavailable")
+		case "ignore":
+			return nil, nil
+		}
+		return NewResponse(Message{Body: "echo " + msg.Body}), nil
+	})
+
+	cases := []struct {
+		name   string
+		req    *http.Request
+		status int
+		body   string
+	}{
+		{"reply", signedRequest(url.Values{"Body": {"hi"}}), http.StatusOK, "<Response><Message><Body>echo hi</Body></Message></Response>"},
+		{"no reply", signedRequest(url.Values{"Body": {"ignore"}}), http.StatusOK, "<Response></Response>"},
+		{"handler error", signedRequest(url.Values{"Body": {"error"}}), http.StatusInternalServerError, "Internal Server Error"},
+		{"invalid signature", httptest.NewRequest(http.MethodPost, webhookURL, nil), http.StatusForbidden, twilio.ErrInvalidSignature.Error()},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, c.req)
+
+			if rec.Code != c.status {
+				t.Errorf("exp status %d, got %d", c.status, rec.Code)
+			}
+			if !strings.Contains(rec.Body.String(), c.body) {
+				t.Errorf("exp body %s, got %s", c.body, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestCallHandler(t *testing.T) {
+	handler := CallHandler(validator, func(ctx context.Context, call InboundCall) (*Response, error) {
+		if call.Digits == "1" {
+			return NewResponse(Dial{To: "+14155552346"}), nil
+		}
+		return NewResponse(Gather{NumDigits: 1, Verbs: []GatherVerb{Say{Text: "Press 1"}}}), nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedRequest(url.Values{"CallSid": {"CA1"}, "Digits": {"1"}}))
+
+	if exp, got := "text/xml; charset=utf-8", rec.Header().Get("Content-Type"); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+	if exp := "<Response><Dial>+14155552346</Dial></Response>"; !strings.HasSuffix(rec.Body.String(), exp) {
+		t.Errorf("exp %s, got %s", exp, rec.Body.String())
+	}
+}
+
+func TestResponseServeHTTP(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewResponse(Reject{}).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, webhookURL, nil))
+
+	if exp := "<Response><Reject></Reject></Response>"; !strings.HasSuffix(rec.Body.String(), exp) {
+		t.Errorf("exp %s, got %s", exp, rec.Body.String())
+	}
+}
@@ -0,0 +1,30 @@
+package twiml
+
+import "encoding/xml"
+
+// Message replies to an inbound message, or sends a message during a call.
+// https://www.twilio.com/docs/messaging/twiml/message
+type Message struct {
+	XMLName        xml.Name `xml:"Message"`
+	To             string   `xml:"to,attr,omitempty"`
+	From           string   `xml:"from,attr,omitempty"`
+	Action         string   `xml:"action,attr,omitempty"`
+	Method         string   `xml:"method,attr,omitempty"`
+	StatusCallback string   `xml:"statusCallback,attr,omitempty"`
+
+	Body string `xml:"Body,omitempty"`
+
+	// Media urls of the images sent as MMS, up to 10.
+	Media []string `xml:"Media,omitempty"`
+}
+
+// Redirect transfers the control to the TwiML returned by another url.
+// https://www.twilio.com/docs/messaging/twiml/redirect
+type Redirect struct {
+	XMLName xml.Name `xml:"Redirect"`
+	Method  string   `xml:"method,attr,omitempty"`
+	URL     string   `xml:",chardata"`
+}
+
+func (Message) verb()  {}
+func (Redirect) verb() {}
@@ -0,0 +1,47 @@
+// Package twiml builds the TwiML documents returned to the webhooks of inbound
+// messages and calls, and serves them from http handlers.
+// https://www.twilio.com/docs/glossary/what-is-twilio-markup-language-twiml
+package twiml
+
+import (
+	"bytes"
+	"encoding/xml"
+)
+
+// Verb is an instruction of a TwiML document.
+type Verb interface {
+	verb()
+}
+
+// Response is the root of a TwiML document, its verbs are executed in order.
+type Response struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []Verb
+}
+
+// NewResponse returns a Response executing the verbs.
+func NewResponse(verbs ...Verb) *Response {
+	return &Response{Verbs: verbs}
+}
+
+// Append adds verbs to the end of the document.
+func (r *Response) Append(verbs ...Verb) *Response {
+	r.Verbs = append(r.Verbs, verbs...)
+	return r
+}
+
+// Bytes returns the XML document, with its header.
+func (r *Response) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// String implements fmt.Stringer, an invalid document returns an empty string.
+func (r *Response) String() string {
+	b, _ := r.Bytes()
+	return string(b)
+}
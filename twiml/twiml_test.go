@@ -0,0 +1,77 @@
+package twiml
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestResponse(t *testing.T) {
+	off := false
+	cases := []struct {
+		name string
+		resp *Response
+		exp  string
+	}{
+		{
+			"empty",
+			NewResponse(),
+			`<Response></Response>`,
+		},
+		{
+			"message",
+			NewResponse(Message{To: "+14155552345", Body: "Tom & Jerry <3", Media: []string{"https://example.com/cat.jpg"}}),
+			`<Response><Message to="+14155552345"><Body>Tom &amp; Jerry &lt;3</Body><Media>https://example.com/cat.jpg</Media></Message></Response>`,
+		},
+		{
+			"redirect",
+			NewResponse(Message{Body: "Hi"}).Append(Redirect{Method: "POST", URL: "/next"}),
+			`<Response><Message><Body>Hi</Body></Message><Redirect method="POST">/next</Redirect></Response>`,
+		},
+		{
+			"gather",
+			NewResponse(
+				Gather{Input: "dtmf speech", NumDigits: 1, Action: "/menu", Verbs: []GatherVerb{
+					Say{Voice: "alice", Text: "Press 1 for sales"},
+					Pause{Length: 2},
+				}},
+				Say{Text: "Goodbye"},
+				Hangup{},
+			),
+			`<Response><Gather input="dtmf speech" action="/menu" numDigits="1"><Say voice="alice">Press 1 for sales</Say><Pause length="2"></Pause></Gather><Say>Goodbye</Say><Hangup></Hangup></Response>`,
+		},
+		{
+			"dial number",
+			NewResponse(Dial{CallerID: "+14155552345", To: "+14155552346"}),
+			`<Response><Dial callerId="+14155552345">+14155552346</Dial></Response>`,
+		},
+		{
+			"dial nouns",
+			NewResponse(Dial{Nouns: []DialNoun{
+				Number{SendDigits: "wwww1928", Number: "+14155552346"},
+				Client{Identity: "jenny"},
+				Conference{Beep: &off, EndConferenceOnExit: true, Name: "room"},
+				Queue{Name: "support"},
+				Sip{URI: "sip:jack@example.com"},
+			}}),
+			`<Response><Dial><Number sendDigits="wwww1928">+14155552346</Number><Client>jenny</Client><Conference endConferenceOnExit="true" beep="false">room</Conference><Queue>support</Queue><Sip>sip:jack@example.com</Sip></Dial></Response>`,
+		},
+		{
+			"record",
+			NewResponse(Record{MaxLength: 30, PlayBeep: &off, Transcribe: true}),
+			`<Response><Record maxLength="30" playBeep="false" transcribe="true"></Record></Response>`,
+		},
+		{
+			"enqueue",
+			NewResponse(Enqueue{WorkflowSid: "WW1", Name: "support"}, Leave{}, Reject{Reason: "busy"}, Play{Loop: 2, URL: "https://example.com/hold.mp3"}),
+			`<Response><Enqueue workflowSid="WW1">support</Enqueue><Leave></Leave><Reject reason="busy"></Reject><Play loop="2">https://example.com/hold.mp3</Play></Response>`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if exp, got := xml.Header+c.exp, c.resp.String(); exp != got {
+				t.Errorf("exp %s, got %s", exp, got)
+			}
+		})
+	}
+}
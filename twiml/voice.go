@@ -0,0 +1,205 @@
+package twiml
+
+import "encoding/xml"
+
+// GatherVerb is a verb nested in Gather, played while waiting for the input.
+type GatherVerb interface {
+	Verb
+	gatherVerb()
+}
+
+// DialNoun is the destination of a Dial.
+type DialNoun interface {
+	dialNoun()
+}
+
+// Say reads a text to the caller.
+// https://www.twilio.com/docs/voice/twiml/say
+type Say struct {
+	XMLName  xml.Name `xml:"Say"`
+	Voice    string   `xml:"voice,attr,omitempty"`
+	Language string   `xml:"language,attr,omitempty"`
+	Loop     int      `xml:"loop,attr,omitempty"`
+	Text     string   `xml:",chardata"`
+}
+
+// Play plays an audio file, or sends DTMF digits.
+// https://www.twilio.com/docs/voice/twiml/play
+type Play struct {
+	XMLName xml.Name `xml:"Play"`
+	Loop    int      `xml:"loop,attr,omitempty"`
+	Digits  string   `xml:"digits,attr,omitempty"`
+	URL     string   `xml:",chardata"`
+}
+
+// Pause waits silently for a number of seconds.
+// https://www.twilio.com/docs/voice/twiml/pause
+type Pause struct {
+	XMLName xml.Name `xml:"Pause"`
+	Length  int      `xml:"length,attr,omitempty"`
+}
+
+// Gather collects the digits or speech of the caller, sent to the action url.
+// https://www.twilio.com/docs/voice/twiml/gather
+type Gather struct {
+	XMLName xml.Name `xml:"Gather"`
+
+	// Input can be dtmf, speech or "dtmf speech".
+	Input               string `xml:"input,attr,omitempty"`
+	Action              string `xml:"action,attr,omitempty"`
+	Method              string `xml:"method,attr,omitempty"`
+	Timeout             int    `xml:"timeout,attr,omitempty"`
+	FinishOnKey         string `xml:"finishOnKey,attr,omitempty"`
+	NumDigits           int    `xml:"numDigits,attr,omitempty"`
+	SpeechTimeout       string `xml:"speechTimeout,attr,omitempty"`
+	Language            string `xml:"language,attr,omitempty"`
+	Hints               string `xml:"hints,attr,omitempty"`
+	ActionOnEmptyResult bool   `xml:"actionOnEmptyResult,attr,omitempty"`
+
+	Verbs []GatherVerb
+}
+
+// Dial connects the caller to a phone number, or to the nouns.
+// https://www.twilio.com/docs/voice/twiml/dial
+type Dial struct {
+	XMLName      xml.Name `xml:"Dial"`
+	Action       string   `xml:"action,attr,omitempty"`
+	Method       string   `xml:"method,attr,omitempty"`
+	Timeout      int      `xml:"timeout,attr,omitempty"`
+	TimeLimit    int      `xml:"timeLimit,attr,omitempty"`
+	HangupOnStar bool     `xml:"hangupOnStar,attr,omitempty"`
+	CallerID     string   `xml:"callerId,attr,omitempty"`
+
+	// Record can be do-not-record, record-from-answer or record-from-ringing.
+	Record                  string `xml:"record,attr,omitempty"`
+	RecordingStatusCallback string `xml:"recordingStatusCallback,attr,omitempty"`
+
+	// To phone number dialed when there are no nouns.
+	To    string `xml:",chardata"`
+	Nouns []DialNoun
+}
+
+// Number is a phone number dialed by Dial.
+// https://www.twilio.com/docs/voice/twiml/number
+type Number struct {
+	XMLName        xml.Name `xml:"Number"`
+	SendDigits     string   `xml:"sendDigits,attr,omitempty"`
+	URL            string   `xml:"url,attr,omitempty"`
+	StatusCallback string   `xml:"statusCallback,attr,omitempty"`
+	Number         string   `xml:",chardata"`
+}
+
+// Client is a Voice SDK client dialed by Dial.
+// https://www.twilio.com/docs/voice/twiml/client
+type Client struct {
+	XMLName  xml.Name `xml:"Client"`
+	URL      string   `xml:"url,attr,omitempty"`
+	Identity string   `xml:",chardata"`
+}
+
+// Conference is a conference room joined by Dial.
+// https://www.twilio.com/docs/voice/twiml/conference
+type Conference struct {
+	XMLName xml.Name `xml:"Conference"`
+
+	// StartConferenceOnEnter and Beep default to true when nil.
+	StartConferenceOnEnter *bool  `xml:"startConferenceOnEnter,attr,omitempty"`
+	EndConferenceOnExit    bool   `xml:"endConferenceOnExit,attr,omitempty"`
+	Muted                  bool   `xml:"muted,attr,omitempty"`
+	Beep                   *bool  `xml:"beep,attr,omitempty"`
+	WaitURL                string `xml:"waitUrl,attr,omitempty"`
+	MaxParticipants        int    `xml:"maxParticipants,attr,omitempty"`
+	StatusCallback         string `xml:"statusCallback,attr,omitempty"`
+
+	// StatusCallbackEvent space separated, eg. "start end join leave".
+	StatusCallbackEvent string `xml:"statusCallbackEvent,attr,omitempty"`
+	Name                string `xml:",chardata"`
+}
+
+// Queue is a call queue dequeued by Dial.
+// https://www.twilio.com/docs/voice/twiml/queue
+type Queue struct {
+	XMLName xml.Name `xml:"Queue"`
+	URL     string   `xml:"url,attr,omitempty"`
+	Name    string   `xml:",chardata"`
+}
+
+// Sip is a SIP endpoint dialed by Dial.
+// https://www.twilio.com/docs/voice/twiml/sip
+type Sip struct {
+	XMLName  xml.Name `xml:"Sip"`
+	Username string   `xml:"username,attr,omitempty"`
+	Password string   `xml:"password,attr,omitempty"`
+	URI      string   `xml:",chardata"`
+}
+
+// Record records the caller, the recording url is sent to the action url.
+// https://www.twilio.com/docs/voice/twiml/record
+type Record struct {
+	XMLName     xml.Name `xml:"Record"`
+	Action      string   `xml:"action,attr,omitempty"`
+	Method      string   `xml:"method,attr,omitempty"`
+	Timeout     int      `xml:"timeout,attr,omitempty"`
+	FinishOnKey string   `xml:"finishOnKey,attr,omitempty"`
+	MaxLength   int      `xml:"maxLength,attr,omitempty"`
+
+	// PlayBeep defaults to true when nil.
+	PlayBeep                *bool  `xml:"playBeep,attr,omitempty"`
+	Trim                    string `xml:"trim,attr,omitempty"`
+	RecordingStatusCallback string `xml:"recordingStatusCallback,attr,omitempty"`
+	Transcribe              bool   `xml:"transcribe,attr,omitempty"`
+	TranscribeCallback      string `xml:"transcribeCallback,attr,omitempty"`
+}
+
+// Enqueue puts the caller in a queue, or in a TaskRouter workflow.
+// https://www.twilio.com/docs/voice/twiml/enqueue
+type Enqueue struct {
+	XMLName     xml.Name `xml:"Enqueue"`
+	Action      string   `xml:"action,attr,omitempty"`
+	Method      string   `xml:"method,attr,omitempty"`
+	WaitURL     string   `xml:"waitUrl,attr,omitempty"`
+	WorkflowSid string   `xml:"workflowSid,attr,omitempty"`
+	Name        string   `xml:",chardata"`
+}
+
+// Hangup ends the call.
+// https://www.twilio.com/docs/voice/twiml/hangup
+type Hangup struct {
+	XMLName xml.Name `xml:"Hangup"`
+}
+
+// Reject rejects an incoming call without billing it.
+// https://www.twilio.com/docs/voice/twiml/reject
+type Reject struct {
+	XMLName xml.Name `xml:"Reject"`
+
+	// Reason can be rejected or busy.
+	Reason string `xml:"reason,attr,omitempty"`
+}
+
+// Leave moves the caller out of the queue, to the verb following Enqueue.
+// https://www.twilio.com/docs/voice/twiml/leave
+type Leave struct {
+	XMLName xml.Name `xml:"Leave"`
+}
+
+func (Say) verb()     {}
+func (Play) verb()    {}
+func (Pause) verb()   {}
+func (Gather) verb()  {}
+func (Dial) verb()    {}
+func (Record) verb()  {}
+func (Enqueue) verb() {}
+func (Hangup) verb()  {}
+func (Reject) verb()  {}
+func (Leave) verb()   {}
+
+func (Say) gatherVerb()   {}
+func (Play) gatherVerb()  {}
+func (Pause) gatherVerb() {}
+
+func (Number) dialNoun()     {}
+func (Client) dialNoun()     {}
+func (Conference) dialNoun() {}
+func (Queue) dialNoun()      {}
+func (Sip) dialNoun()        {}
@@ -7,6 +7,11 @@ import (
 	"strings"
 )
 
+// valueEncoder is implemented by the structs encoded as a single value, eg. PhoneNumber.
+type valueEncoder interface {
+	encodeValue() string
+}
+
 // Values converts a struct into url.Values
 func Values(v interface{}) url.Values {
 	values := make(url.Values)
@@ -61,6 +66,12 @@ func parseValues(values url.Values, val reflect.Value, scope string) {
 			continue
 		}
 
+		// []byte and json.RawMessage are encoded as a single string value.
+		if sv.Kind() == reflect.Slice && sv.Type().Elem().Kind() == reflect.Uint8 {
+			values.Add(name, string(sv.Bytes()))
+			continue
+		}
+
 		if sv.Kind() == reflect.Slice || sv.Kind() == reflect.Array {
 			for i := 0; i < sv.Len(); i++ {
 				values.Add(name, valueString(sv.Index(i), opts))
@@ -75,6 +86,11 @@ func parseValues(values url.Values, val reflect.Value, scope string) {
 			sv = sv.Elem()
 		}
 
+		if e, ok := asValueEncoder(sv); ok {
+			values.Add(name, e.encodeValue())
+			continue
+		}
+
 		if sv.Kind() == reflect.Struct {
 			parseValues(values, sv, name)
 			continue
@@ -95,9 +111,20 @@ func valueString(v reflect.Value, opts tagOptions) string {
 		}
 		v = v.Elem()
 	}
+	if e, ok := asValueEncoder(v); ok {
+		return e.encodeValue()
+	}
 	return fmt.Sprint(v.Interface())
 }
 
+func asValueEncoder(v reflect.Value) (valueEncoder, bool) {
+	if !v.CanInterface() {
+		return nil, false
+	}
+	e, ok := v.Interface().(valueEncoder)
+	return e, ok
+}
+
 func isEmptyValue(v reflect.Value) bool {
 	switch v.Kind() {
 	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
@@ -112,6 +139,10 @@ func isEmptyValue(v reflect.Value) bool {
 		return v.Float() == 0
 	case reflect.Interface, reflect.Ptr:
 		return v.IsNil()
+	case reflect.Struct:
+		if e, ok := asValueEncoder(v); ok {
+			return e.encodeValue() == ""
+		}
 	}
 
 	return false
@@ -0,0 +1,44 @@
+package twilio
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	type params struct {
+		Name       string          `url:",omitempty"`
+		Attributes json.RawMessage `url:",omitempty"`
+		Permission []string
+		Limits     struct {
+			ChannelMembers int `url:",omitempty"`
+		}
+		Ignored string       `url:"-"`
+		To      PhoneNumber  `url:",omitempty"`
+		From    *PhoneNumber `url:",omitempty"`
+		Numbers []PhoneNumber
+	}
+
+	tt := map[string]struct {
+		in  interface{}
+		exp string
+	}{
+		"nil":       {nil, ""},
+		"omitempty": {params{}, ""},
+		"raw json":  {params{Attributes: json.RawMessage(`{"a":1}`)}, "Attributes=%7B%22a%22%3A1%7D"},
+		"repeated":  {params{Permission: []string{"a", "b"}}, "Permission=a&Permission=b"},
+		"nested": {params{Limits: struct {
+			ChannelMembers int `url:",omitempty"`
+		}{5}}, "Limits.ChannelMembers=5"},
+		"ignored":          {params{Ignored: "x", Name: "n"}, "Name=n"},
+		"not a struct":     {"string", ""},
+		"phone number":     {params{To: PhoneNumber{ChannelWhatsApp, "+14155238886"}}, "To=whatsapp%3A%2B14155238886"},
+		"phone number ptr": {params{From: &PhoneNumber{Number: "+14155238886"}}, "From=%2B14155238886"},
+		"phone numbers":    {params{Numbers: []PhoneNumber{{Number: "+1"}, {Number: "+2"}}}, "Numbers=%2B1&Numbers=%2B2"},
+	}
+	for name, tc := range tt {
+		if got := Values(tc.in).Encode(); tc.exp != got {
+			t.Errorf("%s: exp %s, got %s", name, tc.exp, got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package verify
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// BucketResource handles interactions with the Buckets of Rate Limits REST API.
+type BucketResource struct {
+	bucketAPI
+}
+
+// Bucket allows at most Max verifications per value of its rate limit in Interval seconds.
+type Bucket struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	ServiceSid   string `json:"service_sid"`
+	RateLimitSid string `json:"rate_limit_sid"`
+	Max          int    `json:"max"`
+	Interval     int    `json:"interval"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// BucketList holds a page of buckets of a rate limit.
+type BucketList struct {
+	Buckets []Bucket `json:"buckets"`
+	Meta    Meta     `json:"meta"`
+}
+
+// BucketCreateParams holds information used in creating a new bucket.
+// https://www.twilio.com/docs/verify/api/service-rate-limit-buckets#create-a-bucket
+type BucketCreateParams struct {
+	Max      int
+	Interval int
+}
+
+func (bcp BucketCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(bcp).Encode())
+}
+
+// BucketUpdateParams holds information used in updating an existing bucket.
+// https://www.twilio.com/docs/verify/api/service-rate-limit-buckets#update-a-bucket
+type BucketUpdateParams struct {
+	Max      int `url:",omitempty"`
+	Interval int `url:",omitempty"`
+}
+
+func (bup BucketUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(bup).Encode())
+}
@@ -0,0 +1,54 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type bucketAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/RateLimits/{RateLimit SID}/Buckets/{Bucket SID}
+// https://www.twilio.com/docs/verify/api/service-rate-limit-buckets#fetch-a-bucket
+func (api bucketAPI) Read(ctx context.Context, serviceSid, rateLimitSid, bucketSid string) (Bucket, error) {
+	var bucket Bucket
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/RateLimits/%s/Buckets/%s", serviceSid, rateLimitSid, bucketSid), &bucket)
+	return bucket, err
+}
+
+// GET /Services/{Service SID}/RateLimits/{RateLimit SID}/Buckets
+// https://www.twilio.com/docs/verify/api/service-rate-limit-buckets#list-all-buckets
+func (api bucketAPI) List(ctx context.Context, serviceSid, rateLimitSid string, params ListParams) (BucketList, error) {
+	var buckets BucketList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/RateLimits/%s/Buckets%s", serviceSid, rateLimitSid, twilio.Query(params)), &buckets)
+	return buckets, err
+}
+
+// POST /Services/{Service SID}/RateLimits/{RateLimit SID}/Buckets
+// https://www.twilio.com/docs/verify/api/service-rate-limit-buckets#create-a-bucket
+func (api bucketAPI) Create(ctx context.Context, serviceSid, rateLimitSid string, body BucketCreateParams) (Bucket, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/RateLimits/%s/Buckets", serviceSid, rateLimitSid), body.encode())
+}
+
+// POST /Services/{Service SID}/RateLimits/{RateLimit SID}/Buckets/{Bucket SID}
+// https://www.twilio.com/docs/verify/api/service-rate-limit-buckets#update-a-bucket
+func (api bucketAPI) Update(ctx context.Context, serviceSid, rateLimitSid, bucketSid string, body BucketUpdateParams) (Bucket, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/RateLimits/%s/Buckets/%s", serviceSid, rateLimitSid, bucketSid), body.encode())
+}
+
+// DELETE /Services/{Service SID}/RateLimits/{RateLimit SID}/Buckets/{Bucket SID}
+// https://www.twilio.com/docs/verify/api/service-rate-limit-buckets#delete-a-bucket
+func (api bucketAPI) Delete(ctx context.Context, serviceSid, rateLimitSid, bucketSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/RateLimits/%s/Buckets/%s", serviceSid, rateLimitSid, bucketSid))
+	return err
+}
+
+func (api bucketAPI) post(ctx context.Context, path string, body io.Reader) (Bucket, error) {
+	var bucket Bucket
+	err := twilio.PostInto(ctx, api.client, path, body, &bucket)
+	return bucket, err
+}
@@ -0,0 +1,188 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBucketRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/RateLimits/RK1/Buckets/BL1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/bucket.json")
+		}
+
+		var (
+			exp  Bucket
+			f, _ = os.Open("fixtures/bucket.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		bucket, err := (bucketAPI{client}).Read(context.TODO(), "VA1", "RK1", "BL1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, bucket) {
+			t.Errorf("response diff %v", cmp.Diff(exp, bucket))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (bucketAPI{client}).Read(ctx, "VA1", "RK1", "BL1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestBucketList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/RateLimits/RK1/Buckets"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/buckets.json")
+		}
+
+		var (
+			exp  BucketList
+			f, _ = os.Open("fixtures/buckets.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		buckets, err := (bucketAPI{client}).List(context.TODO(), "VA1", "RK1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, buckets) {
+			t.Errorf("response diff %v", cmp.Diff(exp, buckets))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (bucketAPI{client}).List(ctx, "VA1", "RK1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestBucketCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Interval=60&Max=5")
+			)
+
+			if exp := "/Services/VA1/RateLimits/RK1/Buckets"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/bucket.json")
+		}
+
+		var (
+			exp  Bucket
+			f, _ = os.Open("fixtures/bucket.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		bucket, err := (bucketAPI{client}).Create(context.TODO(), "VA1", "RK1", BucketCreateParams{Max: 5, Interval: 60})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, bucket) {
+			t.Errorf("response diff %v", cmp.Diff(exp, bucket))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (bucketAPI{client}).Create(ctx, "VA1", "RK1", BucketCreateParams{Max: 5, Interval: 60})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestBucketUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Max=10")
+			)
+
+			if exp := "/Services/VA1/RateLimits/RK1/Buckets/BL1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/bucket.json")
+		}
+
+		var (
+			exp  Bucket
+			f, _ = os.Open("fixtures/bucket.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		bucket, err := (bucketAPI{client}).Update(context.TODO(), "VA1", "RK1", "BL1", BucketUpdateParams{Max: 10})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, bucket) {
+			t.Errorf("response diff %v", cmp.Diff(exp, bucket))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (bucketAPI{client}).Update(ctx, "VA1", "RK1", "BL1", BucketUpdateParams{Max: 10})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestBucketDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/RateLimits/RK1/Buckets/BL1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (bucketAPI{client}).Delete(context.TODO(), "VA1", "RK1", "BL1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (bucketAPI{client}).Delete(ctx, "VA1", "RK1", "BL1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,10 @@
+package verify
+
+import "testing"
+
+func TestBucketParamsOptionals(t *testing.T) {
+	exp := []byte("Interval=0&Max=0")
+	t.Run("CreateParams", optionalsFn(BucketCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(BucketUpdateParams{}, exp))
+}
@@ -0,0 +1,107 @@
+package verify
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ChallengeResource handles interactions with the Challenges of Entities REST API.
+type ChallengeResource struct {
+	challengeAPI
+}
+
+// Challenge statuses.
+const (
+	ChallengeStatusPending  = "pending"
+	ChallengeStatusExpired  = "expired"
+	ChallengeStatusApproved = "approved"
+	ChallengeStatusDenied   = "denied"
+)
+
+// Challenge is a verification of an entity through one of its verified factors,
+// approved from the device of a push factor or with the code of a TOTP factor.
+type Challenge struct {
+	Sid             string `json:"sid"`
+	AccountSid      string `json:"account_sid"`
+	ServiceSid      string `json:"service_sid"`
+	EntitySid       string `json:"entity_sid"`
+	Identity        string `json:"identity"`
+	FactorSid       string `json:"factor_sid"`
+	FactorType      string `json:"factor_type"`
+	Status          string `json:"status"`
+	RespondedReason string `json:"responded_reason"`
+
+	// Details are shown on the device of a push factor, HiddenDetails are not.
+	Details       json.RawMessage `json:"details"`
+	HiddenDetails json.RawMessage `json:"hidden_details"`
+	Metadata      json.RawMessage `json:"metadata"`
+
+	// DateCreated, DateUpdated, DateResponded and ExpirationDate ISO-8601 format.
+	DateCreated    string `json:"date_created"`
+	DateUpdated    string `json:"date_updated"`
+	DateResponded  string `json:"date_responded"`
+	ExpirationDate string `json:"expiration_date"`
+	URL            string `json:"url"`
+	Links          struct {
+		Notifications string `json:"notifications"`
+	} `json:"links"`
+}
+
+// ChallengeList holds a page of challenges of an entity.
+type ChallengeList struct {
+	Challenges []Challenge `json:"challenges"`
+	Meta       Meta        `json:"meta"`
+}
+
+// ChallengeListParams holds information used in listing challenges.
+// https://www.twilio.com/docs/verify/api/challenge#read-multiple-challenge-resources
+type ChallengeListParams struct {
+	ListParams
+
+	FactorSid string `url:",omitempty"`
+	Status    string `url:",omitempty"`
+}
+
+func (clp ChallengeListParams) query() string {
+	return twilio.Query(clp)
+}
+
+// ChallengeDetails holds the message shown on the device of a push factor.
+type ChallengeDetails struct {
+	Message string `url:",omitempty"`
+
+	// Fields JSON objects with a label and a value, eg. `{"label": "Amount", "value": "$10"}`.
+	Fields []string `url:",omitempty"`
+}
+
+// ChallengeCreateParams holds information used in challenging a factor, push
+// challenges are sent to the device, TOTP challenges are approved with AuthPayload.
+// https://www.twilio.com/docs/verify/api/challenge#create-a-challenge-resource
+type ChallengeCreateParams struct {
+	FactorSid string
+
+	// ExpirationDate ISO-8601 format, defaults to 15 minutes.
+	ExpirationDate string `url:",omitempty"`
+	Details        ChallengeDetails
+	HiddenDetails  json.RawMessage `url:",omitempty"`
+	AuthPayload    string          `url:",omitempty"`
+}
+
+func (ccp ChallengeCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ccp).Encode())
+}
+
+// ChallengeUpdateParams holds information used in responding to a challenge.
+// https://www.twilio.com/docs/verify/api/challenge#update-a-challenge-resource
+type ChallengeUpdateParams struct {
+	// AuthPayload the TOTP code, or the signed response of a push factor.
+	AuthPayload string          `url:",omitempty"`
+	Metadata    json.RawMessage `url:",omitempty"`
+}
+
+func (cup ChallengeUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(cup).Encode())
+}
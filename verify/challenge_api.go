@@ -0,0 +1,47 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type challengeAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Entities/{Identity}/Challenges/{Challenge SID}
+// https://www.twilio.com/docs/verify/api/challenge#fetch-a-challenge-resource
+func (api challengeAPI) Read(ctx context.Context, serviceSid, identity, challengeSid string) (Challenge, error) {
+	var challenge Challenge
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Entities/%s/Challenges/%s", serviceSid, identity, challengeSid), &challenge)
+	return challenge, err
+}
+
+// GET /Services/{Service SID}/Entities/{Identity}/Challenges
+// https://www.twilio.com/docs/verify/api/challenge#read-multiple-challenge-resources
+func (api challengeAPI) List(ctx context.Context, serviceSid, identity string, params ChallengeListParams) (ChallengeList, error) {
+	var challenges ChallengeList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Entities/%s/Challenges%s", serviceSid, identity, params.query()), &challenges)
+	return challenges, err
+}
+
+// POST /Services/{Service SID}/Entities/{Identity}/Challenges
+// https://www.twilio.com/docs/verify/api/challenge#create-a-challenge-resource
+func (api challengeAPI) Create(ctx context.Context, serviceSid, identity string, body ChallengeCreateParams) (Challenge, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Entities/%s/Challenges", serviceSid, identity), body.encode())
+}
+
+// POST /Services/{Service SID}/Entities/{Identity}/Challenges/{Challenge SID}
+// https://www.twilio.com/docs/verify/api/challenge#update-a-challenge-resource
+func (api challengeAPI) Update(ctx context.Context, serviceSid, identity, challengeSid string, body ChallengeUpdateParams) (Challenge, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Entities/%s/Challenges/%s", serviceSid, identity, challengeSid), body.encode())
+}
+
+func (api challengeAPI) post(ctx context.Context, path string, body io.Reader) (Challenge, error) {
+	var challenge Challenge
+	err := twilio.PostInto(ctx, api.client, path, body, &challenge)
+	return challenge, err
+}
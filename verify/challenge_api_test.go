@@ -0,0 +1,161 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestChallengeRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities/id1/Challenges/YC1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/challenge.json")
+		}
+
+		var (
+			exp  Challenge
+			f, _ = os.Open("fixtures/challenge.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		challenge, err := (challengeAPI{client}).Read(context.TODO(), "VA1", "id1", "YC1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, challenge) {
+			t.Errorf("response diff %v", cmp.Diff(exp, challenge))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (challengeAPI{client}).Read(ctx, "VA1", "id1", "YC1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestChallengeList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities/id1/Challenges?Status=pending"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/challenges.json")
+		}
+
+		var (
+			exp  ChallengeList
+			f, _ = os.Open("fixtures/challenges.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		challenges, err := (challengeAPI{client}).List(context.TODO(), "VA1", "id1", ChallengeListParams{Status: ChallengeStatusPending})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, challenges) {
+			t.Errorf("response diff %v", cmp.Diff(exp, challenges))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (challengeAPI{client}).List(ctx, "VA1", "id1", ChallengeListParams{Status: ChallengeStatusPending})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestChallengeCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Details.Message=Sign+in%3F&FactorSid=YF1")
+			)
+
+			if exp := "/Services/VA1/Entities/id1/Challenges"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/challenge.json")
+		}
+
+		var (
+			exp  Challenge
+			f, _ = os.Open("fixtures/challenge.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		challenge, err := (challengeAPI{client}).Create(context.TODO(), "VA1", "id1", ChallengeCreateParams{FactorSid: "YF1", Details: ChallengeDetails{Message: "Sign in?"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, challenge) {
+			t.Errorf("response diff %v", cmp.Diff(exp, challenge))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (challengeAPI{client}).Create(ctx, "VA1", "id1", ChallengeCreateParams{FactorSid: "YF1", Details: ChallengeDetails{Message: "Sign in?"}})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestChallengeUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AuthPayload=123456")
+			)
+
+			if exp := "/Services/VA1/Entities/id1/Challenges/YC1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/challenge.json")
+		}
+
+		var (
+			exp  Challenge
+			f, _ = os.Open("fixtures/challenge.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		challenge, err := (challengeAPI{client}).Update(context.TODO(), "VA1", "id1", "YC1", ChallengeUpdateParams{AuthPayload: "123456"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, challenge) {
+			t.Errorf("response diff %v", cmp.Diff(exp, challenge))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (challengeAPI{client}).Update(ctx, "VA1", "id1", "YC1", ChallengeUpdateParams{AuthPayload: "123456"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
@@ -0,0 +1,13 @@
+package verify
+
+import "testing"
+
+func TestChallengeParamsOptionals(t *testing.T) {
+	exp := []byte("FactorSid=")
+	t.Run("CreateParams", optionalsFn(ChallengeCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(ChallengeUpdateParams{}, exp))
+	if exp, got := "", (ChallengeListParams{}).query(); exp != got {
+		t.Errorf("exp query %q, got %q", exp, got)
+	}
+}
@@ -0,0 +1,50 @@
+package verify
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// EntityResource handles interactions with the Entities of Verify Services REST API.
+type EntityResource struct {
+	entityAPI
+}
+
+// Entity is a user of the service owning factors, identified by an identity of
+// the application. The identity must not hold personal information.
+type Entity struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+	Identity   string `json:"identity"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Factors    string `json:"factors"`
+		NewFactors string `json:"new_factors"`
+		Challenges string `json:"challenges"`
+	} `json:"links"`
+}
+
+// EntityList holds a page of entities of a service.
+type EntityList struct {
+	Entities []Entity `json:"entities"`
+	Meta     Meta     `json:"meta"`
+}
+
+// EntityCreateParams holds information used in creating a new entity.
+// https://www.twilio.com/docs/verify/api/entity#create-an-entity-resource
+type EntityCreateParams struct {
+	Identity string
+}
+
+func (ecp EntityCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ecp).Encode())
+}
@@ -0,0 +1,44 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type entityAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Entities/{Identity}
+// https://www.twilio.com/docs/verify/api/entity#fetch-an-entity-resource
+func (api entityAPI) Read(ctx context.Context, serviceSid, identity string) (Entity, error) {
+	var entity Entity
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Entities/%s", serviceSid, identity), &entity)
+	return entity, err
+}
+
+// GET /Services/{Service SID}/Entities
+// https://www.twilio.com/docs/verify/api/entity#read-multiple-entity-resources
+func (api entityAPI) List(ctx context.Context, serviceSid string, params ListParams) (EntityList, error) {
+	var entities EntityList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Entities%s", serviceSid, twilio.Query(params)), &entities)
+	return entities, err
+}
+
+// POST /Services/{Service SID}/Entities
+// https://www.twilio.com/docs/verify/api/entity#create-an-entity-resource
+func (api entityAPI) Create(ctx context.Context, serviceSid string, body EntityCreateParams) (Entity, error) {
+	var entity Entity
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/Entities", serviceSid), body.encode(), &entity)
+	return entity, err
+}
+
+// Delete removes an entity with all its factors and challenges.
+// DELETE /Services/{Service SID}/Entities/{Identity}
+// https://www.twilio.com/docs/verify/api/entity#delete-an-entity-resource
+func (api entityAPI) Delete(ctx context.Context, serviceSid, identity string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/Entities/%s", serviceSid, identity))
+	return err
+}
@@ -0,0 +1,147 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEntityRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities/id1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/entity.json")
+		}
+
+		var (
+			exp  Entity
+			f, _ = os.Open("fixtures/entity.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		entity, err := (entityAPI{client}).Read(context.TODO(), "VA1", "id1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, entity) {
+			t.Errorf("response diff %v", cmp.Diff(exp, entity))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (entityAPI{client}).Read(ctx, "VA1", "id1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestEntityList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/entities.json")
+		}
+
+		var (
+			exp  EntityList
+			f, _ = os.Open("fixtures/entities.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		entities, err := (entityAPI{client}).List(context.TODO(), "VA1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, entities) {
+			t.Errorf("response diff %v", cmp.Diff(exp, entities))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (entityAPI{client}).List(ctx, "VA1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestEntityCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Identity=id1")
+			)
+
+			if exp := "/Services/VA1/Entities"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/entity.json")
+		}
+
+		var (
+			exp  Entity
+			f, _ = os.Open("fixtures/entity.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		entity, err := (entityAPI{client}).Create(context.TODO(), "VA1", EntityCreateParams{Identity: "id1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, entity) {
+			t.Errorf("response diff %v", cmp.Diff(exp, entity))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (entityAPI{client}).Create(ctx, "VA1", EntityCreateParams{Identity: "id1"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestEntityDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities/id1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (entityAPI{client}).Delete(context.TODO(), "VA1", "id1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (entityAPI{client}).Delete(ctx, "VA1", "id1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
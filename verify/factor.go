@@ -0,0 +1,108 @@
+package verify
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// FactorResource handles interactions with the Factors of Entities REST API.
+type FactorResource struct {
+	factorAPI
+}
+
+// Factor types.
+const (
+	FactorTypePush = "push"
+	FactorTypeTotp = "totp"
+)
+
+// Factor statuses.
+const (
+	FactorStatusUnverified = "unverified"
+	FactorStatusVerified   = "verified"
+)
+
+// Factor is a device or app of an entity used in verifying it, a push factor
+// holds the public key of a device and a TOTP factor the secret of an authenticator app.
+type Factor struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	ServiceSid   string `json:"service_sid"`
+	EntitySid    string `json:"entity_sid"`
+	Identity     string `json:"identity"`
+	FriendlyName string `json:"friendly_name"`
+	FactorType   string `json:"factor_type"`
+
+	// Status a new factor is unverified until `Verify`, unverified factors cannot
+	// be challenged.
+	Status string `json:"status"`
+
+	// Binding holds the TOTP secret and uri when created, unset afterwards.
+	Binding  json.RawMessage `json:"binding"`
+	Config   json.RawMessage `json:"config"`
+	Metadata json.RawMessage `json:"metadata"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// FactorList holds a page of factors of an entity.
+type FactorList struct {
+	Factors []Factor `json:"factors"`
+	Meta    Meta     `json:"meta"`
+}
+
+// FactorBinding holds the public key of a push factor, or the secret of a TOTP factor.
+type FactorBinding struct {
+	Alg       string `url:",omitempty"`
+	PublicKey string `url:",omitempty"`
+	Secret    string `url:",omitempty"`
+}
+
+// FactorConfig holds the configuration of a factor, the notification fields apply
+// to push factors and the code fields to TOTP factors.
+type FactorConfig struct {
+	AppID                string `url:"AppId,omitempty"`
+	NotificationPlatform string `url:",omitempty"`
+	NotificationToken    string `url:",omitempty"`
+	SdkVersion           string `url:",omitempty"`
+	TimeStep             int    `url:",omitempty"`
+	Skew                 int    `url:",omitempty"`
+	CodeLength           int    `url:",omitempty"`
+	Alg                  string `url:",omitempty"`
+}
+
+// FactorCreateParams holds information used in creating a new factor, push factors
+// are usually created by the Verify SDKs of the devices.
+// https://www.twilio.com/docs/verify/api/factor#create-a-factor-resource
+type FactorCreateParams struct {
+	FriendlyName string
+	FactorType   string
+	Binding      FactorBinding
+	Config       FactorConfig
+	Metadata     json.RawMessage `url:",omitempty"`
+}
+
+func (fcp FactorCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(fcp).Encode())
+}
+
+// FactorUpdateParams holds information used in updating a factor, see `Verify`.
+// https://www.twilio.com/docs/verify/api/factor#update-a-factor-resource
+type FactorUpdateParams struct {
+	// AuthPayload the TOTP code verifying a new factor.
+	AuthPayload  string `url:",omitempty"`
+	FriendlyName string `url:",omitempty"`
+	Config       FactorConfig
+}
+
+func (fup FactorUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(fup).Encode())
+}
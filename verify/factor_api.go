@@ -0,0 +1,63 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type factorAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Entities/{Identity}/Factors/{Factor SID}
+// https://www.twilio.com/docs/verify/api/factor#fetch-a-factor-resource
+func (api factorAPI) Read(ctx context.Context, serviceSid, identity, factorSid string) (Factor, error) {
+	var factor Factor
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Entities/%s/Factors/%s", serviceSid, identity, factorSid), &factor)
+	return factor, err
+}
+
+// GET /Services/{Service SID}/Entities/{Identity}/Factors
+// https://www.twilio.com/docs/verify/api/factor#read-multiple-factor-resources
+func (api factorAPI) List(ctx context.Context, serviceSid, identity string, params ListParams) (FactorList, error) {
+	var factors FactorList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Entities/%s/Factors%s", serviceSid, identity, twilio.Query(params)), &factors)
+	return factors, err
+}
+
+// Create creates an unverified factor, the entity is created with its first factor.
+// POST /Services/{Service SID}/Entities/{Identity}/Factors
+// https://www.twilio.com/docs/verify/api/factor#create-a-factor-resource
+func (api factorAPI) Create(ctx context.Context, serviceSid, identity string, body FactorCreateParams) (Factor, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Entities/%s/Factors", serviceSid, identity), body.encode())
+}
+
+// POST /Services/{Service SID}/Entities/{Identity}/Factors/{Factor SID}
+// https://www.twilio.com/docs/verify/api/factor#update-a-factor-resource
+func (api factorAPI) Update(ctx context.Context, serviceSid, identity, factorSid string, body FactorUpdateParams) (Factor, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Entities/%s/Factors/%s", serviceSid, identity, factorSid), body.encode())
+}
+
+// Verify verifies a new TOTP factor with a code of the authenticator app, the
+// factor stays unverified if the code is invalid.
+// POST /Services/{Service SID}/Entities/{Identity}/Factors/{Factor SID}
+// https://www.twilio.com/docs/verify/api/factor#update-a-factor-resource
+func (api factorAPI) Verify(ctx context.Context, serviceSid, identity, factorSid, code string) (Factor, error) {
+	return api.Update(ctx, serviceSid, identity, factorSid, FactorUpdateParams{AuthPayload: code})
+}
+
+// DELETE /Services/{Service SID}/Entities/{Identity}/Factors/{Factor SID}
+// https://www.twilio.com/docs/verify/api/factor#delete-a-factor-resource
+func (api factorAPI) Delete(ctx context.Context, serviceSid, identity, factorSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/Entities/%s/Factors/%s", serviceSid, identity, factorSid))
+	return err
+}
+
+func (api factorAPI) post(ctx context.Context, path string, body io.Reader) (Factor, error) {
+	var factor Factor
+	err := twilio.PostInto(ctx, api.client, path, body, &factor)
+	return factor, err
+}
@@ -0,0 +1,229 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFactorRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities/id1/Factors/YF1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/factor.json")
+		}
+
+		var (
+			exp  Factor
+			f, _ = os.Open("fixtures/factor.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		factor, err := (factorAPI{client}).Read(context.TODO(), "VA1", "id1", "YF1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, factor) {
+			t.Errorf("response diff %v", cmp.Diff(exp, factor))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (factorAPI{client}).Read(ctx, "VA1", "id1", "YF1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestFactorList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities/id1/Factors"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/factors.json")
+		}
+
+		var (
+			exp  FactorList
+			f, _ = os.Open("fixtures/factors.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		factors, err := (factorAPI{client}).List(context.TODO(), "VA1", "id1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, factors) {
+			t.Errorf("response diff %v", cmp.Diff(exp, factors))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (factorAPI{client}).List(ctx, "VA1", "id1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestFactorCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Config.TimeStep=30&FactorType=totp&FriendlyName=phone")
+			)
+
+			if exp := "/Services/VA1/Entities/id1/Factors"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/factor.json")
+		}
+
+		var (
+			exp  Factor
+			f, _ = os.Open("fixtures/factor.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		factor, err := (factorAPI{client}).Create(context.TODO(), "VA1", "id1", FactorCreateParams{FriendlyName: "phone", FactorType: FactorTypeTotp, Config: FactorConfig{TimeStep: 30}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, factor) {
+			t.Errorf("response diff %v", cmp.Diff(exp, factor))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (factorAPI{client}).Create(ctx, "VA1", "id1", FactorCreateParams{FriendlyName: "phone", FactorType: FactorTypeTotp, Config: FactorConfig{TimeStep: 30}})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestFactorUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("FriendlyName=tablet")
+			)
+
+			if exp := "/Services/VA1/Entities/id1/Factors/YF1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/factor.json")
+		}
+
+		var (
+			exp  Factor
+			f, _ = os.Open("fixtures/factor.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		factor, err := (factorAPI{client}).Update(context.TODO(), "VA1", "id1", "YF1", FactorUpdateParams{FriendlyName: "tablet"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, factor) {
+			t.Errorf("response diff %v", cmp.Diff(exp, factor))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (factorAPI{client}).Update(ctx, "VA1", "id1", "YF1", FactorUpdateParams{FriendlyName: "tablet"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestFactorVerify(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AuthPayload=123456")
+			)
+
+			if exp := "/Services/VA1/Entities/id1/Factors/YF1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/factor.json")
+		}
+
+		var (
+			exp  Factor
+			f, _ = os.Open("fixtures/factor.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		factor, err := (factorAPI{client}).Verify(context.TODO(), "VA1", "id1", "YF1", "123456")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, factor) {
+			t.Errorf("response diff %v", cmp.Diff(exp, factor))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (factorAPI{client}).Verify(ctx, "VA1", "id1", "YF1", "123456")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestFactorDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Entities/id1/Factors/YF1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (factorAPI{client}).Delete(context.TODO(), "VA1", "id1", "YF1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (factorAPI{client}).Delete(ctx, "VA1", "id1", "YF1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
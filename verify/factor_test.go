@@ -0,0 +1,23 @@
+package verify
+
+import "testing"
+
+func TestFactorParamsOptionals(t *testing.T) {
+	exp := []byte("FactorType=&FriendlyName=")
+	t.Run("CreateParams", optionalsFn(FactorCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(FactorUpdateParams{}, exp))
+
+	exp = []byte("Binding.Alg=ES256&Binding.PublicKey=key&Config.AppId=com.example.app&Config.NotificationPlatform=fcm&Config.NotificationToken=token&Config.SdkVersion=1.0.0&FactorType=push&FriendlyName=phone")
+	t.Run("CreateParams push", optionalsFn(FactorCreateParams{
+		FriendlyName: "phone",
+		FactorType:   FactorTypePush,
+		Binding:      FactorBinding{Alg: "ES256", PublicKey: "key"},
+		Config: FactorConfig{
+			AppID:                "com.example.app",
+			NotificationPlatform: "fcm",
+			NotificationToken:    "token",
+			SdkVersion:           "1.0.0",
+		},
+	}, exp))
+}
@@ -0,0 +1,62 @@
+package verify
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// RateLimitResource handles interactions with the Rate Limits of Verify Services REST API.
+type RateLimitResource struct {
+	rateLimitAPI
+}
+
+// RateLimit limits the verifications started per value of a key, eg. per end user
+// ip address, its buckets hold the limits.
+type RateLimit struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+
+	// UniqueName is the key of the value passed in the RateLimits of a verification.
+	UniqueName  string `json:"unique_name"`
+	Description string `json:"description"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Buckets string `json:"buckets"`
+	} `json:"links"`
+}
+
+// RateLimitList holds a page of rate limits of a service.
+type RateLimitList struct {
+	RateLimits []RateLimit `json:"rate_limits"`
+	Meta       Meta        `json:"meta"`
+}
+
+// RateLimitCreateParams holds information used in creating a new rate limit.
+// https://www.twilio.com/docs/verify/api/service-rate-limits#create-a-rate-limit
+type RateLimitCreateParams struct {
+	UniqueName  string
+	Description string `url:",omitempty"`
+}
+
+func (rcp RateLimitCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rcp).Encode())
+}
+
+// RateLimitUpdateParams holds information used in updating an existing rate limit.
+// https://www.twilio.com/docs/verify/api/service-rate-limits#update-a-rate-limit
+type RateLimitUpdateParams struct {
+	Description string `url:",omitempty"`
+}
+
+func (rup RateLimitUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rup).Encode())
+}
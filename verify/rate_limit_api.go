@@ -0,0 +1,54 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type rateLimitAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/RateLimits/{RateLimit SID}
+// https://www.twilio.com/docs/verify/api/service-rate-limits#fetch-a-rate-limit
+func (api rateLimitAPI) Read(ctx context.Context, serviceSid, rateLimitSid string) (RateLimit, error) {
+	var rl RateLimit
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/RateLimits/%s", serviceSid, rateLimitSid), &rl)
+	return rl, err
+}
+
+// GET /Services/{Service SID}/RateLimits
+// https://www.twilio.com/docs/verify/api/service-rate-limits#list-all-rate-limits
+func (api rateLimitAPI) List(ctx context.Context, serviceSid string, params ListParams) (RateLimitList, error) {
+	var rls RateLimitList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/RateLimits%s", serviceSid, twilio.Query(params)), &rls)
+	return rls, err
+}
+
+// POST /Services/{Service SID}/RateLimits
+// https://www.twilio.com/docs/verify/api/service-rate-limits#create-a-rate-limit
+func (api rateLimitAPI) Create(ctx context.Context, serviceSid string, body RateLimitCreateParams) (RateLimit, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/RateLimits", serviceSid), body.encode())
+}
+
+// POST /Services/{Service SID}/RateLimits/{RateLimit SID}
+// https://www.twilio.com/docs/verify/api/service-rate-limits#update-a-rate-limit
+func (api rateLimitAPI) Update(ctx context.Context, serviceSid, rateLimitSid string, body RateLimitUpdateParams) (RateLimit, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/RateLimits/%s", serviceSid, rateLimitSid), body.encode())
+}
+
+// DELETE /Services/{Service SID}/RateLimits/{RateLimit SID}
+// https://www.twilio.com/docs/verify/api/service-rate-limits#delete-a-rate-limit
+func (api rateLimitAPI) Delete(ctx context.Context, serviceSid, rateLimitSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Services/%s/RateLimits/%s", serviceSid, rateLimitSid))
+	return err
+}
+
+func (api rateLimitAPI) post(ctx context.Context, path string, body io.Reader) (RateLimit, error) {
+	var rl RateLimit
+	err := twilio.PostInto(ctx, api.client, path, body, &rl)
+	return rl, err
+}
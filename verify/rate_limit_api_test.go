@@ -0,0 +1,188 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRateLimitRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/RateLimits/RK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/rate_limit.json")
+		}
+
+		var (
+			exp  RateLimit
+			f, _ = os.Open("fixtures/rate_limit.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rl, err := (rateLimitAPI{client}).Read(context.TODO(), "VA1", "RK1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rl) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rl))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (rateLimitAPI{client}).Read(ctx, "VA1", "RK1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRateLimitList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/RateLimits"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/rate_limits.json")
+		}
+
+		var (
+			exp  RateLimitList
+			f, _ = os.Open("fixtures/rate_limits.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rls, err := (rateLimitAPI{client}).List(context.TODO(), "VA1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rls) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rls))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (rateLimitAPI{client}).List(ctx, "VA1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRateLimitCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("UniqueName=end_user_ip_address")
+			)
+
+			if exp := "/Services/VA1/RateLimits"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/rate_limit.json")
+		}
+
+		var (
+			exp  RateLimit
+			f, _ = os.Open("fixtures/rate_limit.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rl, err := (rateLimitAPI{client}).Create(context.TODO(), "VA1", RateLimitCreateParams{UniqueName: "end_user_ip_address"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rl) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rl))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (rateLimitAPI{client}).Create(ctx, "VA1", RateLimitCreateParams{UniqueName: "end_user_ip_address"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestRateLimitUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Description=by+ip")
+			)
+
+			if exp := "/Services/VA1/RateLimits/RK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/rate_limit.json")
+		}
+
+		var (
+			exp  RateLimit
+			f, _ = os.Open("fixtures/rate_limit.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rl, err := (rateLimitAPI{client}).Update(context.TODO(), "VA1", "RK1", RateLimitUpdateParams{Description: "by ip"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rl) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rl))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (rateLimitAPI{client}).Update(ctx, "VA1", "RK1", RateLimitUpdateParams{Description: "by ip"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestRateLimitDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/RateLimits/RK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (rateLimitAPI{client}).Delete(context.TODO(), "VA1", "RK1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (rateLimitAPI{client}).Delete(ctx, "VA1", "RK1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,10 @@
+package verify
+
+import "testing"
+
+func TestRateLimitParamsOptionals(t *testing.T) {
+	exp := []byte("UniqueName=")
+	t.Run("CreateParams", optionalsFn(RateLimitCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(RateLimitUpdateParams{}, exp))
+}
@@ -0,0 +1,120 @@
+package verify
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ServiceResource handles interactions with Verify Services REST API.
+type ServiceResource struct {
+	serviceAPI
+}
+
+// Service holds the configuration of the verifications, its friendly name is
+// included in the messages sent to the users.
+type Service struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+
+	// CodeLength of the one time passwords, 4 to 10. Default 6.
+	CodeLength               int    `json:"code_length"`
+	LookupEnabled            bool   `json:"lookup_enabled"`
+	Psd2Enabled              bool   `json:"psd2_enabled"`
+	SkipSmsToLandlines       bool   `json:"skip_sms_to_landlines"`
+	DtmfInputRequired        bool   `json:"dtmf_input_required"`
+	TtsName                  string `json:"tts_name"`
+	DoNotShareWarningEnabled bool   `json:"do_not_share_warning_enabled"`
+	CustomCodeEnabled        bool   `json:"custom_code_enabled"`
+	DefaultTemplateSid       string `json:"default_template_sid"`
+
+	// Push and Totp configure the factors of the service.
+	Push json.RawMessage `json:"push"`
+	Totp json.RawMessage `json:"totp"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Verifications           string `json:"verifications"`
+		VerificationChecks      string `json:"verification_checks"`
+		RateLimits              string `json:"rate_limits"`
+		Entities                string `json:"entities"`
+		MessagingConfigurations string `json:"messaging_configurations"`
+		Webhooks                string `json:"webhooks"`
+		AccessTokens            string `json:"access_tokens"`
+	} `json:"links"`
+}
+
+// ServiceList holds a page of services.
+type ServiceList struct {
+	Services []Service `json:"services"`
+	Meta     Meta      `json:"meta"`
+}
+
+// ServicePush holds the push factor configuration of a service.
+type ServicePush struct {
+	IncludeDate      *bool  `url:",omitempty"`
+	ApnCredentialSid string `url:",omitempty"`
+	FcmCredentialSid string `url:",omitempty"`
+}
+
+// ServiceTotp holds the TOTP factor configuration of a service.
+type ServiceTotp struct {
+	Issuer string `url:",omitempty"`
+
+	// TimeStep seconds a code is valid, 20 to 60. Default 30.
+	TimeStep   int `url:",omitempty"`
+	CodeLength int `url:",omitempty"`
+	Skew       int `url:",omitempty"`
+}
+
+// ServiceCreateParams holds information used in creating a new service.
+// https://www.twilio.com/docs/verify/api/service#create-a-verification-service
+type ServiceCreateParams struct {
+	FriendlyName             string
+	CodeLength               int    `url:",omitempty"`
+	LookupEnabled            *bool  `url:",omitempty"`
+	SkipSmsToLandlines       *bool  `url:",omitempty"`
+	DtmfInputRequired        *bool  `url:",omitempty"`
+	TtsName                  string `url:",omitempty"`
+	Psd2Enabled              *bool  `url:",omitempty"`
+	DoNotShareWarningEnabled *bool  `url:",omitempty"`
+	CustomCodeEnabled        *bool  `url:",omitempty"`
+	DefaultTemplateSid       string `url:",omitempty"`
+
+	Push ServicePush
+	Totp ServiceTotp
+}
+
+func (scp ServiceCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(scp).Encode())
+}
+
+// ServiceUpdateParams holds information used in updating an existing service.
+// https://www.twilio.com/docs/verify/api/service#update-a-service
+type ServiceUpdateParams struct {
+	FriendlyName             string `url:",omitempty"`
+	CodeLength               int    `url:",omitempty"`
+	LookupEnabled            *bool  `url:",omitempty"`
+	SkipSmsToLandlines       *bool  `url:",omitempty"`
+	DtmfInputRequired        *bool  `url:",omitempty"`
+	TtsName                  string `url:",omitempty"`
+	Psd2Enabled              *bool  `url:",omitempty"`
+	DoNotShareWarningEnabled *bool  `url:",omitempty"`
+	CustomCodeEnabled        *bool  `url:",omitempty"`
+	DefaultTemplateSid       string `url:",omitempty"`
+
+	Push ServicePush
+	Totp ServiceTotp
+}
+
+func (sup ServiceUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(sup).Encode())
+}
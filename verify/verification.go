@@ -0,0 +1,105 @@
+package verify
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// VerificationResource handles interactions with Verifications REST API.
+type VerificationResource struct {
+	verificationAPI
+}
+
+// Verification channels.
+const (
+	ChannelSMS      = "sms"
+	ChannelCall     = "call"
+	ChannelEmail    = "email"
+	ChannelWhatsApp = "whatsapp"
+	ChannelSNA      = "sna"
+)
+
+// Verification statuses.
+const (
+	StatusPending            = "pending"
+	StatusApproved           = "approved"
+	StatusCanceled           = "canceled"
+	StatusMaxAttemptsReached = "max_attempts_reached"
+	StatusDeleted            = "deleted"
+	StatusFailed             = "failed"
+	StatusExpired            = "expired"
+)
+
+// Verification is a one time password sent to a phone number or email, it is
+// pending until checked or expired after 10 minutes.
+type Verification struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+	To         string `json:"to"`
+	Channel    string `json:"channel"`
+	Status     string `json:"status"`
+
+	// Valid is true once the verification is approved.
+	Valid            bool            `json:"valid"`
+	Lookup           json.RawMessage `json:"lookup"`
+	Amount           string          `json:"amount"`
+	Payee            string          `json:"payee"`
+	SendCodeAttempts []struct {
+		Time    string `json:"time"`
+		Channel string `json:"channel"`
+	} `json:"send_code_attempts"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// VerificationCreateParams holds information used in starting a verification.
+// https://www.twilio.com/docs/verify/api/verification#start-new-verification
+type VerificationCreateParams struct {
+	// To phone number in E.164 format, or email address.
+	To      string
+	Channel string
+
+	CustomFriendlyName string `url:",omitempty"`
+	CustomCode         string `url:",omitempty"`
+	Locale             string `url:",omitempty"`
+	SendDigits         string `url:",omitempty"`
+	AppHash            string `url:",omitempty"`
+	TemplateSid        string `url:",omitempty"`
+	DeviceIP           string `url:"DeviceIp,omitempty"`
+
+	// Amount and Payee are required by PSD2 enabled services.
+	Amount string `url:",omitempty"`
+	Payee  string `url:",omitempty"`
+
+	// RateLimits maps the unique names of the rate limits to their values, JSON format.
+	RateLimits json.RawMessage `url:",omitempty"`
+
+	// ChannelConfiguration eg. the email template substitutions, JSON format.
+	ChannelConfiguration        json.RawMessage `url:",omitempty"`
+	TemplateCustomSubstitutions json.RawMessage `url:",omitempty"`
+}
+
+func (vcp VerificationCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(vcp).Encode())
+}
+
+// VerificationUpdateParams holds information used in updating a pending verification,
+// see `Cancel` and `Approve`.
+// https://www.twilio.com/docs/verify/api/verification#update-a-verification-status
+type VerificationUpdateParams struct {
+	// Status can be canceled or approved.
+	Status string
+}
+
+func (vup VerificationUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(vup).Encode())
+}
@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type verificationAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Services/{Service SID}/Verifications/{Verification SID}
+// https://www.twilio.com/docs/verify/api/verification#fetch-a-verification
+func (api verificationAPI) Read(ctx context.Context, serviceSid, verificationSid string) (Verification, error) {
+	var v Verification
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Services/%s/Verifications/%s", serviceSid, verificationSid), &v)
+	return v, err
+}
+
+// Start sends a one time password to the user over the channel.
+// POST /Services/{Service SID}/Verifications
+// https://www.twilio.com/docs/verify/api/verification#start-new-verification
+func (api verificationAPI) Start(ctx context.Context, serviceSid string, body VerificationCreateParams) (Verification, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Verifications", serviceSid), body.encode())
+}
+
+// POST /Services/{Service SID}/Verifications/{Verification SID}
+// https://www.twilio.com/docs/verify/api/verification#update-a-verification-status
+func (api verificationAPI) Update(ctx context.Context, serviceSid, verificationSid string, body VerificationUpdateParams) (Verification, error) {
+	return api.post(ctx, fmt.Sprintf("/Services/%s/Verifications/%s", serviceSid, verificationSid), body.encode())
+}
+
+// Cancel cancels a pending verification, its code can no longer be checked.
+// POST /Services/{Service SID}/Verifications/{Verification SID}
+// https://www.twilio.com/docs/verify/api/verification#update-a-verification-status
+func (api verificationAPI) Cancel(ctx context.Context, serviceSid, verificationSid string) (Verification, error) {
+	return api.Update(ctx, serviceSid, verificationSid, VerificationUpdateParams{Status: StatusCanceled})
+}
+
+// Approve approves a pending verification verified out of band, eg. by a silent
+// network auth.
+// POST /Services/{Service SID}/Verifications/{Verification SID}
+// https://www.twilio.com/docs/verify/api/verification#update-a-verification-status
+func (api verificationAPI) Approve(ctx context.Context, serviceSid, verificationSid string) (Verification, error) {
+	return api.Update(ctx, serviceSid, verificationSid, VerificationUpdateParams{Status: StatusApproved})
+}
+
+func (api verificationAPI) post(ctx context.Context, path string, body io.Reader) (Verification, error) {
+	var v Verification
+	err := twilio.PostInto(ctx, api.client, path, body, &v)
+	return v, err
+}
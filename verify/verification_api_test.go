@@ -0,0 +1,210 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVerificationRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Services/VA1/Verifications/VE1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/verification.json")
+		}
+
+		var (
+			exp  Verification
+			f, _ = os.Open("fixtures/verification.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		v, err := (verificationAPI{client}).Read(context.TODO(), "VA1", "VE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, v) {
+			t.Errorf("response diff %v", cmp.Diff(exp, v))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (verificationAPI{client}).Read(ctx, "VA1", "VE1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestVerificationStart(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Channel=sms&RateLimits=%7B%22end_user_ip_address%22%3A%22127.0.0.1%22%7D&To=%2B15017122661")
+			)
+
+			if exp := "/Services/VA1/Verifications"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/verification.json")
+		}
+
+		var (
+			exp  Verification
+			f, _ = os.Open("fixtures/verification.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		v, err := (verificationAPI{client}).Start(context.TODO(), "VA1", VerificationCreateParams{To: "+15017122661", Channel: ChannelSMS, RateLimits: []byte(`{"end_user_ip_address":"127.0.0.1"}`)})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, v) {
+			t.Errorf("response diff %v", cmp.Diff(exp, v))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (verificationAPI{client}).Start(ctx, "VA1", VerificationCreateParams{To: "+15017122661", Channel: ChannelSMS, RateLimits: []byte(`{"end_user_ip_address":"127.0.0.1"}`)})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestVerificationUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=approved")
+			)
+
+			if exp := "/Services/VA1/Verifications/VE1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/verification.json")
+		}
+
+		var (
+			exp  Verification
+			f, _ = os.Open("fixtures/verification.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		v, err := (verificationAPI{client}).Update(context.TODO(), "VA1", "VE1", VerificationUpdateParams{Status: StatusApproved})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, v) {
+			t.Errorf("response diff %v", cmp.Diff(exp, v))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (verificationAPI{client}).Update(ctx, "VA1", "VE1", VerificationUpdateParams{Status: StatusApproved})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestVerificationCancel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=canceled")
+			)
+
+			if exp := "/Services/VA1/Verifications/VE1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/verification.json")
+		}
+
+		var (
+			exp  Verification
+			f, _ = os.Open("fixtures/verification.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		v, err := (verificationAPI{client}).Cancel(context.TODO(), "VA1", "VE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, v) {
+			t.Errorf("response diff %v", cmp.Diff(exp, v))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (verificationAPI{client}).Cancel(ctx, "VA1", "VE1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestVerificationApprove(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=approved")
+			)
+
+			if exp := "/Services/VA1/Verifications/VE1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/verification.json")
+		}
+
+		var (
+			exp  Verification
+			f, _ = os.Open("fixtures/verification.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		v, err := (verificationAPI{client}).Approve(context.TODO(), "VA1", "VE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, v) {
+			t.Errorf("response diff %v", cmp.Diff(exp, v))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (verificationAPI{client}).Approve(ctx, "VA1", "VE1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
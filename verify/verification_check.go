@@ -0,0 +1,48 @@
+package verify
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// VerificationCheckResource handles interactions with Verification Checks REST API.
+type VerificationCheckResource struct {
+	verificationCheckAPI
+}
+
+// VerificationCheck is the result of checking the code of a verification, the
+// verification is approved when the code is valid.
+type VerificationCheck struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+	ServiceSid string `json:"service_sid"`
+	To         string `json:"to"`
+	Channel    string `json:"channel"`
+	Status     string `json:"status"`
+	Valid      bool   `json:"valid"`
+	Amount     string `json:"amount"`
+	Payee      string `json:"payee"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+}
+
+// VerificationCheckParams holds information used in checking a code, either To or
+// VerificationSid is required.
+// https://www.twilio.com/docs/verify/api/verification-check#check-a-verification
+type VerificationCheckParams struct {
+	Code            string
+	To              string `url:",omitempty"`
+	VerificationSid string `url:",omitempty"`
+	Amount          string `url:",omitempty"`
+	Payee           string `url:",omitempty"`
+}
+
+func (vcp VerificationCheckParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(vcp).Encode())
+}
@@ -0,0 +1,22 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type verificationCheckAPI struct {
+	client twilio.HTTPClient
+}
+
+// Check checks the code entered by the user, an invalid code is not an error and
+// returns a pending check.
+// POST /Services/{Service SID}/VerificationCheck
+// https://www.twilio.com/docs/verify/api/verification-check#check-a-verification
+func (api verificationCheckAPI) Check(ctx context.Context, serviceSid string, body VerificationCheckParams) (VerificationCheck, error) {
+	var check VerificationCheck
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Services/%s/VerificationCheck", serviceSid), body.encode(), &check)
+	return check, err
+}
@@ -0,0 +1,54 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVerificationCheck(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Code=123456&To=%2B15017122661")
+			)
+
+			if exp := "/Services/VA1/VerificationCheck"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/verification_check.json")
+		}
+
+		var (
+			exp  VerificationCheck
+			f, _ = os.Open("fixtures/verification_check.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		check, err := (verificationCheckAPI{client}).Check(context.TODO(), "VA1", VerificationCheckParams{Code: "123456", To: "+15017122661"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, check) {
+			t.Errorf("response diff %v", cmp.Diff(exp, check))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (verificationCheckAPI{client}).Check(ctx, "VA1", VerificationCheckParams{Code: "123456", To: "+15017122661"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
@@ -0,0 +1,12 @@
+package verify
+
+import "testing"
+
+func TestVerificationParamsOptionals(t *testing.T) {
+	exp := []byte("Channel=&To=")
+	t.Run("CreateParams", optionalsFn(VerificationCreateParams{}, exp))
+	exp = []byte("Status=")
+	t.Run("UpdateParams", optionalsFn(VerificationUpdateParams{}, exp))
+	exp = []byte("Code=")
+	t.Run("CheckParams", optionalsFn(VerificationCheckParams{}, exp))
+}
@@ -0,0 +1,62 @@
+// Package verify is a client of the Twilio Verify v2 API, verifying users with one
+// time passwords sent over sms, call, email or whatsapp, and with TOTP and push factors.
+package verify
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Verify verify v2 interface
+type Verify struct {
+	Services           ServiceResource
+	Verifications      VerificationResource
+	VerificationChecks VerificationCheckResource
+	RateLimits         RateLimitResource
+	Buckets            BucketResource
+	Entities           EntityResource
+	Factors            FactorResource
+	Challenges         ChallengeResource
+}
+
+// New returns a verify instance with a base url set to `https://verify.twilio.com/v2`
+// if `TWILIO_VERIFY_HOST` not set.
+func New(tctx twilio.Context) (Verify, error) {
+	var verify Verify
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		verifyEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return verify, err
+	}
+
+	{
+		verify.Services = ServiceResource{serviceAPI{client}}
+		verify.Verifications = VerificationResource{verificationAPI{client}}
+		verify.VerificationChecks = VerificationCheckResource{verificationCheckAPI{client}}
+		verify.RateLimits = RateLimitResource{rateLimitAPI{client}}
+		verify.Buckets = BucketResource{bucketAPI{client}}
+		verify.Entities = EntityResource{entityAPI{client}}
+		verify.Factors = FactorResource{factorAPI{client}}
+		verify.Challenges = ChallengeResource{challengeAPI{client}}
+	}
+	return verify, nil
+}
+
+func verifyEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_VERIFY_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://verify.%s.twilio.com/v2", region)
+	} else if url == "" {
+		return "https://verify.twilio.com/v2"
+	}
+	return url
+}
@@ -0,0 +1,124 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("unsuccessful invalid env url", func(t *testing.T) {
+		os.Setenv("TWILIO_VERIFY_HOST", "%2")
+		if _, err := New(twilio.Context{}); err == nil {
+			t.Errorf("exp parsing err, got none")
+		}
+		os.Unsetenv("TWILIO_VERIFY_HOST")
+	})
+
+	t.Run("verify services", func(t *testing.T) {
+		_, err := New(twilio.Context{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestVerifyEndpoint(t *testing.T) {
+	exp := "https://verify.twilio.com/v2"
+
+	t.Run("default url", func(*testing.T) {
+		if got := verifyEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("default url with region", func(*testing.T) {
+		exp := "https://verify.uk.twilio.com/v2"
+		if got := verifyEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("env url", func(*testing.T) {
+		os.Setenv("TWILIO_VERIFY_HOST", exp)
+		if got := verifyEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		if got := verifyEndpointForRegion("uk"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		os.Unsetenv("TWILIO_VERIFY_HOST")
+	})
+}
+
+type requestHandlerFunc func(*http.Request) (*http.Response, error)
+
+func (fn requestHandlerFunc) Do(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+type bufferLogger struct{ strings.Builder }
+
+func (l *bufferLogger) Info(msg string, keyvals ...interface{}) {
+	fmt.Fprintln(l, append([]interface{}{msg}, keyvals...)...)
+}
+
+func (l *bufferLogger) Error(msg string, keyvals ...interface{}) {
+	fmt.Fprintln(l, append([]interface{}{msg}, keyvals...)...)
+}
+
+func TestLoggedSecrets(t *testing.T) {
+	handler := requestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+	})
+	ctx := context.TODO()
+
+	tt := map[string]func(twilio.HTTPClient) error{
+		"verification start": func(c twilio.HTTPClient) error {
+			_, err := (verificationAPI{c}).Start(ctx, "VA1", VerificationCreateParams{To: "+15555550100", Channel: "sms", CustomCode: "TOPSECRET"})
+			return err
+		},
+		"verification check": func(c twilio.HTTPClient) error {
+			_, err := (verificationCheckAPI{c}).Check(ctx, "VA1", VerificationCheckParams{Code: "TOPSECRET", To: "+15555550100"})
+			return err
+		},
+		"factor create": func(c twilio.HTTPClient) error {
+			_, err := (factorAPI{c}).Create(ctx, "VA1", "alice", FactorCreateParams{FactorType: "totp", Binding: FactorBinding{Secret: "TOPSECRET"}})
+			return err
+		},
+		"factor update": func(c twilio.HTTPClient) error {
+			_, err := (factorAPI{c}).Update(ctx, "VA1", "alice", "YF1", FactorUpdateParams{AuthPayload: "TOPSECRET"})
+			return err
+		},
+		"factor verify": func(c twilio.HTTPClient) error {
+			_, err := (factorAPI{c}).Verify(ctx, "VA1", "alice", "YF1", "TOPSECRET")
+			return err
+		},
+		"challenge create": func(c twilio.HTTPClient) error {
+			_, err := (challengeAPI{c}).Create(ctx, "VA1", "alice", ChallengeCreateParams{FactorSid: "YF1", AuthPayload: "TOPSECRET"})
+			return err
+		},
+		"challenge update": func(c twilio.HTTPClient) error {
+			_, err := (challengeAPI{c}).Update(ctx, "VA1", "alice", "YC1", ChallengeUpdateParams{AuthPayload: "TOPSECRET"})
+			return err
+		},
+	}
+	for name, call := range tt {
+		t.Run(name, func(t *testing.T) {
+			logger := &bufferLogger{}
+			client, _ := twilio.NewHTTPClient("key", "secret", "https://verify.twilio.com/v2", handler, twilio.WithLogger(logger))
+			if err := call(client); err != nil {
+				t.Fatalf("exp no err, got %v", err)
+			}
+			if got := logger.String(); !strings.Contains(got, "params") || strings.Contains(got, "TOPSECRET") {
+				t.Errorf("exp the secret redacted, got %s", got)
+			}
+		})
+	}
+}
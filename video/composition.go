@@ -0,0 +1,110 @@
+package video
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// CompositionResource handles interactions with Video Compositions REST API.
+type CompositionResource struct {
+	compositionAPI
+}
+
+// Composition statuses.
+const (
+	CompositionStatusEnqueued   = "enqueued"
+	CompositionStatusProcessing = "processing"
+	CompositionStatusCompleted  = "completed"
+	CompositionStatusDeleted    = "deleted"
+	CompositionStatusFailed     = "failed"
+)
+
+// Composition formats.
+const (
+	FormatMP4  = "mp4"
+	FormatWebM = "webm"
+)
+
+// Composition mixes the recordings of a completed room into a single playable file.
+type Composition struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+	RoomSid    string `json:"room_sid"`
+	Status     string `json:"status"`
+
+	// AudioSources and AudioSourcesExcluded track names, `*` matches any.
+	AudioSources         []string `json:"audio_sources"`
+	AudioSourcesExcluded []string `json:"audio_sources_excluded"`
+
+	// VideoLayout regions of the video sources.
+	// https://www.twilio.com/docs/video/api/compositions-resource#specifying-video-layouts
+	VideoLayout json.RawMessage `json:"video_layout"`
+
+	// Resolution width x height, eg. 1280x720.
+	Resolution            string `json:"resolution"`
+	Trim                  bool   `json:"trim"`
+	Format                string `json:"format"`
+	Bitrate               int    `json:"bitrate"`
+	Size                  int64  `json:"size"`
+	Duration              int    `json:"duration"`
+	MediaExternalLocation string `json:"media_external_location"`
+	StatusCallback        string `json:"status_callback"`
+	StatusCallbackMethod  string `json:"status_callback_method"`
+
+	// DateCreated, DateCompleted and DateDeleted ISO-8601 format.
+	DateCreated   string `json:"date_created"`
+	DateCompleted string `json:"date_completed"`
+	DateDeleted   string `json:"date_deleted"`
+	URL           string `json:"url"`
+	Links         struct {
+		Media string `json:"media"`
+	} `json:"links"`
+}
+
+// CompositionList holds a page of compositions.
+type CompositionList struct {
+	Compositions []Composition `json:"compositions"`
+	Meta         Meta          `json:"meta"`
+}
+
+// CompositionListParams holds information used in filtering the compositions listed.
+// https://www.twilio.com/docs/video/api/compositions-resource#get-list-resource
+type CompositionListParams struct {
+	ListParams
+
+	Status  string `url:",omitempty"`
+	RoomSid string `url:",omitempty"`
+
+	// DateCreatedAfter and DateCreatedBefore ISO-8601 format.
+	DateCreatedAfter  string `url:",omitempty"`
+	DateCreatedBefore string `url:",omitempty"`
+}
+
+func (p CompositionListParams) query() string {
+	return twilio.Query(p)
+}
+
+// CompositionCreateParams holds information used in composing the recordings of a room.
+// https://www.twilio.com/docs/video/api/compositions-resource#post-list-resource
+type CompositionCreateParams struct {
+	RoomSid              string
+	VideoLayout          json.RawMessage `url:",omitempty"`
+	AudioSources         []string        `url:",omitempty"`
+	AudioSourcesExcluded []string        `url:",omitempty"`
+	Resolution           string          `url:",omitempty"`
+
+	// Format mp4 or webm. Default webm.
+	Format               string `url:",omitempty"`
+	StatusCallback       string `url:",omitempty"`
+	StatusCallbackMethod string `url:",omitempty"`
+
+	// Trim removes the intervals without any media. Default true.
+	Trim *bool `url:",omitempty"`
+}
+
+func (ccp CompositionCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ccp).Encode())
+}
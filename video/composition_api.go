@@ -0,0 +1,54 @@
+package video
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type compositionAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Compositions/{Composition SID}
+// https://www.twilio.com/docs/video/api/compositions-resource#get-instance
+func (api compositionAPI) Read(ctx context.Context, compositionSid string) (Composition, error) {
+	var comp Composition
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Compositions/%s", compositionSid), &comp)
+	return comp, err
+}
+
+// GET /Compositions
+// https://www.twilio.com/docs/video/api/compositions-resource#get-list-resource
+func (api compositionAPI) List(ctx context.Context, params CompositionListParams) (CompositionList, error) {
+	var comps CompositionList
+	err := twilio.GetInto(ctx, api.client, "/Compositions"+params.query(), &comps)
+	return comps, err
+}
+
+// Create enqueues the composition of a completed room, its status callback is
+// notified once the media is available.
+// POST /Compositions
+// https://www.twilio.com/docs/video/api/compositions-resource#post-list-resource
+func (api compositionAPI) Create(ctx context.Context, body CompositionCreateParams) (Composition, error) {
+	var comp Composition
+	err := twilio.PostInto(ctx, api.client, "/Compositions", body.encode(), &comp)
+	return comp, err
+}
+
+// Download streams the media of a completed composition, Twilio redirects the
+// request to a short lived url of the media.
+// GET /Compositions/{Composition SID}/Media
+// https://www.twilio.com/docs/video/api/compositions-resource#get-media-subresource
+func (api compositionAPI) Download(ctx context.Context, compositionSid string) (io.ReadCloser, error) {
+	return twilio.GetStream(ctx, api.client, fmt.Sprintf("/Compositions/%s/Media", compositionSid))
+}
+
+// DELETE /Compositions/{Composition SID}
+// https://www.twilio.com/docs/video/api/compositions-resource#delete-instance
+func (api compositionAPI) Delete(ctx context.Context, compositionSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Compositions/%s", compositionSid))
+	return err
+}
@@ -0,0 +1,167 @@
+package video
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompositionRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Compositions/CJ1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/composition.json")
+		}
+
+		var (
+			exp  Composition
+			f, _ = os.Open("fixtures/composition.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		comp, err := (compositionAPI{client}).Read(context.TODO(), "CJ1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, comp) {
+			t.Errorf("response diff %v", cmp.Diff(exp, comp))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (compositionAPI{client}).Read(ctx, "CJ1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCompositionList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Compositions?RoomSid=RM1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/compositions.json")
+		}
+
+		var (
+			exp  CompositionList
+			f, _ = os.Open("fixtures/compositions.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		comps, err := (compositionAPI{client}).List(context.TODO(), CompositionListParams{RoomSid: "RM1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, comps) {
+			t.Errorf("response diff %v", cmp.Diff(exp, comps))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (compositionAPI{client}).List(ctx, CompositionListParams{RoomSid: "RM1"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCompositionCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AudioSources=%2A&Format=mp4&RoomSid=RM1&VideoLayout=%7B%22grid%22%3A%7B%22video_sources%22%3A%5B%22%2A%22%5D%7D%7D")
+			)
+
+			if exp := "/Compositions"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/composition.json")
+		}
+
+		var (
+			exp  Composition
+			f, _ = os.Open("fixtures/composition.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		comp, err := (compositionAPI{client}).Create(context.TODO(), CompositionCreateParams{RoomSid: "RM1", AudioSources: []string{"*"}, VideoLayout: []byte(`{"grid":{"video_sources":["*"]}}`), Format: FormatMP4})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, comp) {
+			t.Errorf("response diff %v", cmp.Diff(exp, comp))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (compositionAPI{client}).Create(ctx, CompositionCreateParams{RoomSid: "RM1", AudioSources: []string{"*"}, VideoLayout: []byte(`{"grid":{"video_sources":["*"]}}`), Format: FormatMP4})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCompositionDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Compositions/CJ1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (compositionAPI{client}).Delete(context.TODO(), "CJ1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (compositionAPI{client}).Delete(ctx, "CJ1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestCompositionDownload(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+		if exp := "/Compositions/CJ1/Media"; exp != path {
+			t.Errorf("exp path %s, got %s", exp, path)
+		}
+		return []byte("ftypmp4"), nil
+	}
+
+	body, err := (compositionAPI{client}).Download(context.TODO(), "CJ1")
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	defer body.Close()
+
+	if got, _ := ioutil.ReadAll(body); !bytes.Equal([]byte("ftypmp4"), got) {
+		t.Errorf("exp body ftypmp4, got %s", got)
+	}
+}
@@ -0,0 +1,99 @@
+package video
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// CompositionHookResource handles interactions with Video Composition Hooks REST API.
+type CompositionHookResource struct {
+	compositionHookAPI
+}
+
+// CompositionHook creates a composition of every room completed while it is enabled.
+type CompositionHook struct {
+	Sid                  string          `json:"sid"`
+	AccountSid           string          `json:"account_sid"`
+	FriendlyName         string          `json:"friendly_name"`
+	Enabled              bool            `json:"enabled"`
+	AudioSources         []string        `json:"audio_sources"`
+	AudioSourcesExcluded []string        `json:"audio_sources_excluded"`
+	VideoLayout          json.RawMessage `json:"video_layout"`
+	Resolution           string          `json:"resolution"`
+	Trim                 bool            `json:"trim"`
+	Format               string          `json:"format"`
+	StatusCallback       string          `json:"status_callback"`
+	StatusCallbackMethod string          `json:"status_callback_method"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// CompositionHookList holds a page of composition hooks.
+type CompositionHookList struct {
+	CompositionHooks []CompositionHook `json:"composition_hooks"`
+	Meta             Meta              `json:"meta"`
+}
+
+// CompositionHookListParams holds information used in filtering the hooks listed.
+// https://www.twilio.com/docs/video/api/composition-hooks#get-list-resource
+type CompositionHookListParams struct {
+	ListParams
+
+	Enabled      *bool  `url:",omitempty"`
+	FriendlyName string `url:",omitempty"`
+
+	// DateCreatedAfter and DateCreatedBefore ISO-8601 format.
+	DateCreatedAfter  string `url:",omitempty"`
+	DateCreatedBefore string `url:",omitempty"`
+}
+
+func (p CompositionHookListParams) query() string {
+	return twilio.Query(p)
+}
+
+// CompositionHookCreateParams holds information used in creating a new hook.
+// https://www.twilio.com/docs/video/api/composition-hooks#hks-post-list-resource
+type CompositionHookCreateParams struct {
+	FriendlyName         string
+	Enabled              *bool           `url:",omitempty"`
+	VideoLayout          json.RawMessage `url:",omitempty"`
+	AudioSources         []string        `url:",omitempty"`
+	AudioSourcesExcluded []string        `url:",omitempty"`
+	Resolution           string          `url:",omitempty"`
+	Format               string          `url:",omitempty"`
+	StatusCallback       string          `url:",omitempty"`
+	StatusCallbackMethod string          `url:",omitempty"`
+	Trim                 *bool           `url:",omitempty"`
+}
+
+func (ccp CompositionHookCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ccp).Encode())
+}
+
+// CompositionHookUpdateParams holds information used in updating an existing hook,
+// the friendly name is required.
+// https://www.twilio.com/docs/video/api/composition-hooks#hks-post-instance-resource
+type CompositionHookUpdateParams struct {
+	FriendlyName         string
+	Enabled              *bool           `url:",omitempty"`
+	VideoLayout          json.RawMessage `url:",omitempty"`
+	AudioSources         []string        `url:",omitempty"`
+	AudioSourcesExcluded []string        `url:",omitempty"`
+	Resolution           string          `url:",omitempty"`
+	Format               string          `url:",omitempty"`
+	StatusCallback       string          `url:",omitempty"`
+	StatusCallbackMethod string          `url:",omitempty"`
+	Trim                 *bool           `url:",omitempty"`
+}
+
+func (cup CompositionHookUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(cup).Encode())
+}
@@ -0,0 +1,54 @@
+package video
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type compositionHookAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /CompositionHooks/{Hook SID}
+// https://www.twilio.com/docs/video/api/composition-hooks#hk-get-instance
+func (api compositionHookAPI) Read(ctx context.Context, hookSid string) (CompositionHook, error) {
+	var hook CompositionHook
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/CompositionHooks/%s", hookSid), &hook)
+	return hook, err
+}
+
+// GET /CompositionHooks
+// https://www.twilio.com/docs/video/api/composition-hooks#get-list-resource
+func (api compositionHookAPI) List(ctx context.Context, params CompositionHookListParams) (CompositionHookList, error) {
+	var hooks CompositionHookList
+	err := twilio.GetInto(ctx, api.client, "/CompositionHooks"+params.query(), &hooks)
+	return hooks, err
+}
+
+// POST /CompositionHooks
+// https://www.twilio.com/docs/video/api/composition-hooks#hks-post-list-resource
+func (api compositionHookAPI) Create(ctx context.Context, body CompositionHookCreateParams) (CompositionHook, error) {
+	return api.post(ctx, "/CompositionHooks", body.encode())
+}
+
+// POST /CompositionHooks/{Hook SID}
+// https://www.twilio.com/docs/video/api/composition-hooks#hks-post-instance-resource
+func (api compositionHookAPI) Update(ctx context.Context, hookSid string, body CompositionHookUpdateParams) (CompositionHook, error) {
+	return api.post(ctx, fmt.Sprintf("/CompositionHooks/%s", hookSid), body.encode())
+}
+
+// DELETE /CompositionHooks/{Hook SID}
+// https://www.twilio.com/docs/video/api/composition-hooks#hks-delete-instance-resource
+func (api compositionHookAPI) Delete(ctx context.Context, hookSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/CompositionHooks/%s", hookSid))
+	return err
+}
+
+func (api compositionHookAPI) post(ctx context.Context, path string, body io.Reader) (CompositionHook, error) {
+	var hook CompositionHook
+	err := twilio.PostInto(ctx, api.client, path, body, &hook)
+	return hook, err
+}
@@ -0,0 +1,188 @@
+package video
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompositionHookRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/CompositionHooks/HK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/composition_hook.json")
+		}
+
+		var (
+			exp  CompositionHook
+			f, _ = os.Open("fixtures/composition_hook.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hook, err := (compositionHookAPI{client}).Read(context.TODO(), "HK1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hook) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hook))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (compositionHookAPI{client}).Read(ctx, "HK1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCompositionHookList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/CompositionHooks?FriendlyName=escalations"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/composition_hooks.json")
+		}
+
+		var (
+			exp  CompositionHookList
+			f, _ = os.Open("fixtures/composition_hooks.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hooks, err := (compositionHookAPI{client}).List(context.TODO(), CompositionHookListParams{FriendlyName: "escalations"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hooks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hooks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (compositionHookAPI{client}).List(ctx, CompositionHookListParams{FriendlyName: "escalations"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCompositionHookCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AudioSources=%2A&Format=mp4&FriendlyName=escalations")
+			)
+
+			if exp := "/CompositionHooks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/composition_hook.json")
+		}
+
+		var (
+			exp  CompositionHook
+			f, _ = os.Open("fixtures/composition_hook.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hook, err := (compositionHookAPI{client}).Create(context.TODO(), CompositionHookCreateParams{FriendlyName: "escalations", AudioSources: []string{"*"}, Format: FormatMP4})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hook) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hook))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (compositionHookAPI{client}).Create(ctx, CompositionHookCreateParams{FriendlyName: "escalations", AudioSources: []string{"*"}, Format: FormatMP4})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCompositionHookUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("FriendlyName=escalations&Resolution=640x480")
+			)
+
+			if exp := "/CompositionHooks/HK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/composition_hook.json")
+		}
+
+		var (
+			exp  CompositionHook
+			f, _ = os.Open("fixtures/composition_hook.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		hook, err := (compositionHookAPI{client}).Update(context.TODO(), "HK1", CompositionHookUpdateParams{FriendlyName: "escalations", Resolution: "640x480"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, hook) {
+			t.Errorf("response diff %v", cmp.Diff(exp, hook))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (compositionHookAPI{client}).Update(ctx, "HK1", CompositionHookUpdateParams{FriendlyName: "escalations", Resolution: "640x480"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCompositionHookDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/CompositionHooks/HK1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (compositionHookAPI{client}).Delete(context.TODO(), "HK1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (compositionHookAPI{client}).Delete(ctx, "HK1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,16 @@
+package video
+
+import "testing"
+
+func TestCompositionHookParamsOptionals(t *testing.T) {
+	exp := []byte("FriendlyName=")
+	t.Run("CreateParams", optionalsFn(CompositionHookCreateParams{}, exp))
+	t.Run("UpdateParams", optionalsFn(CompositionHookUpdateParams{}, exp))
+}
+
+func TestCompositionHookListParamsQuery(t *testing.T) {
+	enabled := true
+	if exp, got := "?Enabled=true", (CompositionHookListParams{Enabled: &enabled}).query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
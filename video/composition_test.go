@@ -0,0 +1,12 @@
+package video
+
+import "testing"
+
+func TestCompositionParamsOptionals(t *testing.T) {
+	exp := []byte("RoomSid=")
+	t.Run("CreateParams", optionalsFn(CompositionCreateParams{}, exp))
+
+	disabled := false
+	exp = []byte("RoomSid=RM1&Trim=false")
+	t.Run("CreateParams untrimmed", optionalsFn(CompositionCreateParams{RoomSid: "RM1", Trim: &disabled}, exp))
+}
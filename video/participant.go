@@ -0,0 +1,82 @@
+package video
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ParticipantResource handles interactions with Video Participants REST API.
+type ParticipantResource struct {
+	participantAPI
+}
+
+// Participant statuses.
+const (
+	ParticipantStatusConnected    = "connected"
+	ParticipantStatusDisconnected = "disconnected"
+)
+
+// Participant is a client connected to a room with an access token of its identity.
+type Participant struct {
+	Sid        string `json:"sid"`
+	RoomSid    string `json:"room_sid"`
+	AccountSid string `json:"account_sid"`
+	Identity   string `json:"identity"`
+	Status     string `json:"status"`
+
+	// StartTime and EndTime ISO-8601 format.
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+
+	// Duration seconds, set once the participant is disconnected.
+	Duration int `json:"duration"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		PublishedTracks  string `json:"published_tracks"`
+		SubscribedTracks string `json:"subscribed_tracks"`
+		SubscribeRules   string `json:"subscribe_rules"`
+		Anonymize        string `json:"anonymize"`
+	} `json:"links"`
+}
+
+// ParticipantList holds a page of participants.
+type ParticipantList struct {
+	Participants []Participant `json:"participants"`
+	Meta         Meta          `json:"meta"`
+}
+
+// ParticipantListParams holds information used in filtering the participants listed.
+// https://www.twilio.com/docs/video/api/participants#get-list-resource
+type ParticipantListParams struct {
+	ListParams
+
+	// Status connected by default.
+	Status   string `url:",omitempty"`
+	Identity string `url:",omitempty"`
+
+	// DateCreatedAfter and DateCreatedBefore ISO-8601 format.
+	DateCreatedAfter  string `url:",omitempty"`
+	DateCreatedBefore string `url:",omitempty"`
+}
+
+func (p ParticipantListParams) query() string {
+	return twilio.Query(p)
+}
+
+// ParticipantUpdateParams holds information used in updating an existing participant.
+// https://www.twilio.com/docs/video/api/participants#post-instance
+type ParticipantUpdateParams struct {
+	Status string
+}
+
+func (pup ParticipantUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(pup).Encode())
+}
@@ -0,0 +1,45 @@
+package video
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type participantAPI struct {
+	client twilio.HTTPClient
+}
+
+// Read returns a participant by its sid or identity.
+// GET /Rooms/{Room SID}/Participants/{Participant SID}
+// https://www.twilio.com/docs/video/api/participants#get-instance
+func (api participantAPI) Read(ctx context.Context, roomSid, participantSid string) (Participant, error) {
+	var p Participant
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s", roomSid, participantSid), &p)
+	return p, err
+}
+
+// GET /Rooms/{Room SID}/Participants
+// https://www.twilio.com/docs/video/api/participants#get-list-resource
+func (api participantAPI) List(ctx context.Context, roomSid string, params ParticipantListParams) (ParticipantList, error) {
+	var ps ParticipantList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants", roomSid)+params.query(), &ps)
+	return ps, err
+}
+
+// Update changes the status of a participant, disconnected is the only status accepted.
+// POST /Rooms/{Room SID}/Participants/{Participant SID}
+// https://www.twilio.com/docs/video/api/participants#post-instance
+func (api participantAPI) Update(ctx context.Context, roomSid, participantSid string, body ParticipantUpdateParams) (Participant, error) {
+	var p Participant
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s", roomSid, participantSid), body.encode(), &p)
+	return p, err
+}
+
+// Disconnect removes a participant from a room, it may connect again with its access token.
+// POST /Rooms/{Room SID}/Participants/{Participant SID}
+// https://www.twilio.com/docs/video/api/participants#post-instance
+func (api participantAPI) Disconnect(ctx context.Context, roomSid, participantSid string) (Participant, error) {
+	return api.Update(ctx, roomSid, participantSid, ParticipantUpdateParams{Status: ParticipantStatusDisconnected})
+}
@@ -0,0 +1,161 @@
+package video
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParticipantRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Participants/PA1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		p, err := (participantAPI{client}).Read(context.TODO(), "RM1", "PA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, p) {
+			t.Errorf("response diff %v", cmp.Diff(exp, p))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Read(ctx, "RM1", "PA1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestParticipantList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Participants?Identity=alice"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/participants.json")
+		}
+
+		var (
+			exp  ParticipantList
+			f, _ = os.Open("fixtures/participants.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		ps, err := (participantAPI{client}).List(context.TODO(), "RM1", ParticipantListParams{Identity: "alice"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, ps) {
+			t.Errorf("response diff %v", cmp.Diff(exp, ps))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).List(ctx, "RM1", ParticipantListParams{Identity: "alice"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestParticipantUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=disconnected")
+			)
+
+			if exp := "/Rooms/RM1/Participants/PA1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		p, err := (participantAPI{client}).Update(context.TODO(), "RM1", "PA1", ParticipantUpdateParams{Status: ParticipantStatusDisconnected})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, p) {
+			t.Errorf("response diff %v", cmp.Diff(exp, p))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Update(ctx, "RM1", "PA1", ParticipantUpdateParams{Status: ParticipantStatusDisconnected})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestParticipantDisconnect(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=disconnected")
+			)
+
+			if exp := "/Rooms/RM1/Participants/PA1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		p, err := (participantAPI{client}).Disconnect(context.TODO(), "RM1", "PA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, p) {
+			t.Errorf("response diff %v", cmp.Diff(exp, p))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Disconnect(ctx, "RM1", "PA1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
@@ -0,0 +1,8 @@
+package video
+
+import "testing"
+
+func TestParticipantParamsOptionals(t *testing.T) {
+	exp := []byte("Status=")
+	t.Run("UpdateParams", optionalsFn(ParticipantUpdateParams{}, exp))
+}
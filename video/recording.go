@@ -0,0 +1,107 @@
+package video
+
+import "github.com/smnalex/twilio-go"
+
+// RecordingResource handles interactions with Video Recordings REST API.
+type RecordingResource struct {
+	recordingAPI
+}
+
+// RoomRecordingResource handles interactions with Video Room Recordings REST API.
+type RoomRecordingResource struct {
+	roomRecordingAPI
+}
+
+// Recording statuses.
+const (
+	RecordingStatusProcessing = "processing"
+	RecordingStatusCompleted  = "completed"
+	RecordingStatusDeleted    = "deleted"
+	RecordingStatusFailed     = "failed"
+)
+
+// Recording is the media of a track of a participant, mka for audio and mkv for video.
+type Recording struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+
+	// RoomSid is set only for the recordings listed by room.
+	RoomSid string `json:"room_sid,omitempty"`
+
+	// SourceSid of the recorded track.
+	SourceSid       string `json:"source_sid"`
+	Status          string `json:"status"`
+	Type            string `json:"type"`
+	ContainerFormat string `json:"container_format"`
+	Codec           string `json:"codec"`
+	TrackName       string `json:"track_name"`
+	GroupingSids    struct {
+		RoomSid        string `json:"room_sid"`
+		ParticipantSid string `json:"participant_sid"`
+	} `json:"grouping_sids"`
+
+	// Size bytes.
+	Size int64 `json:"size"`
+
+	// Duration seconds.
+	Duration int `json:"duration"`
+
+	// Offset milliseconds from the start of the room to the start of the recording.
+	Offset                int64  `json:"offset"`
+	MediaExternalLocation string `json:"media_external_location"`
+	StatusCallback        string `json:"status_callback"`
+	StatusCallbackMethod  string `json:"status_callback_method"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+	URL         string `json:"url"`
+	Links       struct {
+		Media string `json:"media"`
+	} `json:"links"`
+}
+
+// RecordingList holds a page of recordings.
+type RecordingList struct {
+	Recordings []Recording `json:"recordings"`
+	Meta       Meta        `json:"meta"`
+}
+
+// RecordingListParams holds information used in filtering the recordings listed.
+// https://www.twilio.com/docs/video/api/recordings-resource#get-list-resource
+type RecordingListParams struct {
+	ListParams
+
+	Status    string `url:",omitempty"`
+	SourceSid string `url:",omitempty"`
+
+	// GroupingSid room or participant sids of the recordings.
+	GroupingSid []string `url:",omitempty"`
+
+	// MediaType audio or video.
+	MediaType string `url:",omitempty"`
+
+	// DateCreatedAfter and DateCreatedBefore ISO-8601 format.
+	DateCreatedAfter  string `url:",omitempty"`
+	DateCreatedBefore string `url:",omitempty"`
+}
+
+func (p RecordingListParams) query() string {
+	return twilio.Query(p)
+}
+
+// RoomRecordingListParams holds information used in filtering the recordings of a room.
+// https://www.twilio.com/docs/video/api/recordings-resource#filter-by-room
+type RoomRecordingListParams struct {
+	ListParams
+
+	Status    string `url:",omitempty"`
+	SourceSid string `url:",omitempty"`
+
+	// DateCreatedAfter and DateCreatedBefore ISO-8601 format.
+	DateCreatedAfter  string `url:",omitempty"`
+	DateCreatedBefore string `url:",omitempty"`
+}
+
+func (p RoomRecordingListParams) query() string {
+	return twilio.Query(p)
+}
@@ -0,0 +1,72 @@
+package video
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type recordingAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Recordings/{Recording SID}
+// https://www.twilio.com/docs/video/api/recordings-resource#get-instance
+func (api recordingAPI) Read(ctx context.Context, recordingSid string) (Recording, error) {
+	var rec Recording
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Recordings/%s", recordingSid), &rec)
+	return rec, err
+}
+
+// GET /Recordings
+// https://www.twilio.com/docs/video/api/recordings-resource#get-list-resource
+func (api recordingAPI) List(ctx context.Context, params RecordingListParams) (RecordingList, error) {
+	var recs RecordingList
+	err := twilio.GetInto(ctx, api.client, "/Recordings"+params.query(), &recs)
+	return recs, err
+}
+
+// Download streams the media of a completed recording, Twilio redirects the request
+// to a short lived url of the media.
+// GET /Recordings/{Recording SID}/Media
+// https://www.twilio.com/docs/video/api/recordings-resource#get-media-subresource
+func (api recordingAPI) Download(ctx context.Context, recordingSid string) (io.ReadCloser, error) {
+	return twilio.GetStream(ctx, api.client, fmt.Sprintf("/Recordings/%s/Media", recordingSid))
+}
+
+// Delete removes the media of a recording, its metadata is kept with the deleted status.
+// DELETE /Recordings/{Recording SID}
+// https://www.twilio.com/docs/video/api/recordings-resource#delete-instance
+func (api recordingAPI) Delete(ctx context.Context, recordingSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Recordings/%s", recordingSid))
+	return err
+}
+
+type roomRecordingAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Rooms/{Room SID}/Recordings/{Recording SID}
+// https://www.twilio.com/docs/video/api/recordings-resource#filter-by-room
+func (api roomRecordingAPI) Read(ctx context.Context, roomSid, recordingSid string) (Recording, error) {
+	var rec Recording
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Recordings/%s", roomSid, recordingSid), &rec)
+	return rec, err
+}
+
+// GET /Rooms/{Room SID}/Recordings
+// https://www.twilio.com/docs/video/api/recordings-resource#filter-by-room
+func (api roomRecordingAPI) List(ctx context.Context, roomSid string, params RoomRecordingListParams) (RecordingList, error) {
+	var recs RecordingList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Recordings", roomSid)+params.query(), &recs)
+	return recs, err
+}
+
+// DELETE /Rooms/{Room SID}/Recordings/{Recording SID}
+// https://www.twilio.com/docs/video/api/recordings-resource#filter-by-room
+func (api roomRecordingAPI) Delete(ctx context.Context, roomSid, recordingSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Rooms/%s/Recordings/%s", roomSid, recordingSid))
+	return err
+}
@@ -0,0 +1,218 @@
+package video
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRecordingRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Recordings/RT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (recordingAPI{client}).Read(context.TODO(), "RT1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (recordingAPI{client}).Read(ctx, "RT1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRecordingList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Recordings?GroupingSid=RM1&GroupingSid=PA1&MediaType=video"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recordings.json")
+		}
+
+		var (
+			exp  RecordingList
+			f, _ = os.Open("fixtures/recordings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		recs, err := (recordingAPI{client}).List(context.TODO(), RecordingListParams{GroupingSid: []string{"RM1", "PA1"}, MediaType: KindVideo})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, recs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, recs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (recordingAPI{client}).List(ctx, RecordingListParams{GroupingSid: []string{"RM1", "PA1"}, MediaType: KindVideo})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRecordingDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Recordings/RT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (recordingAPI{client}).Delete(context.TODO(), "RT1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (recordingAPI{client}).Delete(ctx, "RT1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestRoomRecordingRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Recordings/RT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/room_recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/room_recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (roomRecordingAPI{client}).Read(context.TODO(), "RM1", "RT1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roomRecordingAPI{client}).Read(ctx, "RM1", "RT1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRoomRecordingList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Recordings?SourceSid=MT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/room_recordings.json")
+		}
+
+		var (
+			exp  RecordingList
+			f, _ = os.Open("fixtures/room_recordings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		recs, err := (roomRecordingAPI{client}).List(context.TODO(), "RM1", RoomRecordingListParams{SourceSid: "MT1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, recs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, recs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roomRecordingAPI{client}).List(ctx, "RM1", RoomRecordingListParams{SourceSid: "MT1"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRoomRecordingDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Recordings/RT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (roomRecordingAPI{client}).Delete(context.TODO(), "RM1", "RT1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (roomRecordingAPI{client}).Delete(ctx, "RM1", "RT1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestRecordingDownload(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+		if exp := "/Recordings/RT1/Media"; exp != path {
+			t.Errorf("exp path %s, got %s", exp, path)
+		}
+		return []byte("matroska"), nil
+	}
+
+	body, err := (recordingAPI{client}).Download(context.TODO(), "RT1")
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	defer body.Close()
+
+	if got, _ := ioutil.ReadAll(body); !bytes.Equal([]byte("matroska"), got) {
+		t.Errorf("exp body matroska, got %s", got)
+	}
+}
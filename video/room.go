@@ -0,0 +1,130 @@
+package video
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// RoomResource handles interactions with Video Rooms REST API.
+type RoomResource struct {
+	roomAPI
+}
+
+// Room types.
+const (
+	RoomTypeGo         = "go"
+	RoomTypePeerToPeer = "peer-to-peer"
+	RoomTypeGroup      = "group"
+	RoomTypeGroupSmall = "group-small"
+)
+
+// Room statuses.
+const (
+	RoomStatusInProgress = "in-progress"
+	RoomStatusCompleted  = "completed"
+	RoomStatusFailed     = "failed"
+)
+
+// Room holds the participants of a video session.
+type Room struct {
+	Sid        string `json:"sid"`
+	AccountSid string `json:"account_sid"`
+	UniqueName string `json:"unique_name"`
+	Status     string `json:"status"`
+	Type       string `json:"type"`
+
+	// MaxParticipants 50 for group rooms, 10 for peer-to-peer rooms and 2 for go rooms.
+	MaxParticipants int `json:"max_participants"`
+
+	// MaxParticipantDuration seconds a participant may stay connected. Default 14400.
+	MaxParticipantDuration       int      `json:"max_participant_duration"`
+	MaxConcurrentPublishedTracks int      `json:"max_concurrent_published_tracks"`
+	RecordParticipantsOnConnect  bool     `json:"record_participants_on_connect"`
+	VideoCodecs                  []string `json:"video_codecs"`
+	MediaRegion                  string   `json:"media_region"`
+	AudioOnly                    bool     `json:"audio_only"`
+	EmptyRoomTimeout             int      `json:"empty_room_timeout"`
+	UnusedRoomTimeout            int      `json:"unused_room_timeout"`
+	LargeRoom                    bool     `json:"large_room"`
+	EnableTurn                   bool     `json:"enable_turn"`
+	StatusCallback               string   `json:"status_callback"`
+	StatusCallbackMethod         string   `json:"status_callback_method"`
+
+	// Duration seconds, set once the room is completed.
+	Duration int `json:"duration"`
+
+	// EndTime ISO-8601 format.
+	EndTime string `json:"end_time"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+	Links       struct {
+		Participants   string `json:"participants"`
+		Recordings     string `json:"recordings"`
+		RecordingRules string `json:"recording_rules"`
+	} `json:"links"`
+}
+
+// RoomList holds a page of rooms.
+type RoomList struct {
+	Rooms []Room `json:"rooms"`
+	Meta  Meta   `json:"meta"`
+}
+
+// RoomListParams holds information used in filtering the rooms listed.
+// https://www.twilio.com/docs/video/api/rooms-resource#get-list-resource
+type RoomListParams struct {
+	ListParams
+
+	Status     string `url:",omitempty"`
+	UniqueName string `url:",omitempty"`
+
+	// DateCreatedAfter and DateCreatedBefore ISO-8601 format.
+	DateCreatedAfter  string `url:",omitempty"`
+	DateCreatedBefore string `url:",omitempty"`
+}
+
+func (p RoomListParams) query() string {
+	return twilio.Query(p)
+}
+
+// RoomCreateParams holds information used in creating a new room.
+// https://www.twilio.com/docs/video/api/rooms-resource#post-parameters
+type RoomCreateParams struct {
+	// Type of the room, group unless set otherwise in the console.
+	Type                        string   `url:",omitempty"`
+	UniqueName                  string   `url:",omitempty"`
+	StatusCallback              string   `url:",omitempty"`
+	StatusCallbackMethod        string   `url:",omitempty"`
+	MaxParticipants             int      `url:",omitempty"`
+	MaxParticipantDuration      int      `url:",omitempty"`
+	RecordParticipantsOnConnect *bool    `url:",omitempty"`
+	VideoCodecs                 []string `url:",omitempty"`
+	MediaRegion                 string   `url:",omitempty"`
+	AudioOnly                   *bool    `url:",omitempty"`
+
+	// EmptyRoomTimeout and UnusedRoomTimeout minutes before an idle room is completed.
+	EmptyRoomTimeout  int   `url:",omitempty"`
+	UnusedRoomTimeout int   `url:",omitempty"`
+	LargeRoom         *bool `url:",omitempty"`
+}
+
+func (rcp RoomCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rcp).Encode())
+}
+
+// RoomUpdateParams holds information used in updating an existing room.
+// https://www.twilio.com/docs/video/api/rooms-resource#post-instance
+type RoomUpdateParams struct {
+	Status string
+}
+
+func (rup RoomUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rup).Encode())
+}
@@ -0,0 +1,56 @@
+package video
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type roomAPI struct {
+	client twilio.HTTPClient
+}
+
+// Read returns a room by its sid or the unique name of an in progress room.
+// GET /Rooms/{Room SID}
+// https://www.twilio.com/docs/video/api/rooms-resource#get-instance
+func (api roomAPI) Read(ctx context.Context, roomSid string) (Room, error) {
+	var room Room
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s", roomSid), &room)
+	return room, err
+}
+
+// GET /Rooms
+// https://www.twilio.com/docs/video/api/rooms-resource#get-list-resource
+func (api roomAPI) List(ctx context.Context, params RoomListParams) (RoomList, error) {
+	var rooms RoomList
+	err := twilio.GetInto(ctx, api.client, "/Rooms"+params.query(), &rooms)
+	return rooms, err
+}
+
+// POST /Rooms
+// https://www.twilio.com/docs/video/api/rooms-resource#post-list-resource
+func (api roomAPI) Create(ctx context.Context, body RoomCreateParams) (Room, error) {
+	return api.post(ctx, "/Rooms", body.encode())
+}
+
+// Update changes the status of a room, completed is the only status accepted.
+// POST /Rooms/{Room SID}
+// https://www.twilio.com/docs/video/api/rooms-resource#post-instance
+func (api roomAPI) Update(ctx context.Context, roomSid string, body RoomUpdateParams) (Room, error) {
+	return api.post(ctx, fmt.Sprintf("/Rooms/%s", roomSid), body.encode())
+}
+
+// Complete ends a room, disconnecting all of its participants.
+// POST /Rooms/{Room SID}
+// https://www.twilio.com/docs/video/api/rooms-resource#post-instance
+func (api roomAPI) Complete(ctx context.Context, roomSid string) (Room, error) {
+	return api.Update(ctx, roomSid, RoomUpdateParams{Status: RoomStatusCompleted})
+}
+
+func (api roomAPI) post(ctx context.Context, path string, body io.Reader) (Room, error) {
+	var room Room
+	err := twilio.PostInto(ctx, api.client, path, body, &room)
+	return room, err
+}
@@ -0,0 +1,202 @@
+package video
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRoomRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/room.json")
+		}
+
+		var (
+			exp  Room
+			f, _ = os.Open("fixtures/room.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		room, err := (roomAPI{client}).Read(context.TODO(), "RM1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, room) {
+			t.Errorf("response diff %v", cmp.Diff(exp, room))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roomAPI{client}).Read(ctx, "RM1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRoomList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms?Status=in-progress&UniqueName=CH1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/rooms.json")
+		}
+
+		var (
+			exp  RoomList
+			f, _ = os.Open("fixtures/rooms.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rooms, err := (roomAPI{client}).List(context.TODO(), RoomListParams{Status: RoomStatusInProgress, UniqueName: "CH1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rooms) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rooms))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roomAPI{client}).List(ctx, RoomListParams{Status: RoomStatusInProgress, UniqueName: "CH1"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRoomCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Type=group&UniqueName=CH1&VideoCodecs=VP8&VideoCodecs=H264")
+			)
+
+			if exp := "/Rooms"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/room.json")
+		}
+
+		var (
+			exp  Room
+			f, _ = os.Open("fixtures/room.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		room, err := (roomAPI{client}).Create(context.TODO(), RoomCreateParams{Type: RoomTypeGroup, UniqueName: "CH1", VideoCodecs: []string{"VP8", "H264"}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, room) {
+			t.Errorf("response diff %v", cmp.Diff(exp, room))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roomAPI{client}).Create(ctx, RoomCreateParams{Type: RoomTypeGroup, UniqueName: "CH1", VideoCodecs: []string{"VP8", "H264"}})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestRoomUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=completed")
+			)
+
+			if exp := "/Rooms/RM1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/room.json")
+		}
+
+		var (
+			exp  Room
+			f, _ = os.Open("fixtures/room.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		room, err := (roomAPI{client}).Update(context.TODO(), "RM1", RoomUpdateParams{Status: RoomStatusCompleted})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, room) {
+			t.Errorf("response diff %v", cmp.Diff(exp, room))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roomAPI{client}).Update(ctx, "RM1", RoomUpdateParams{Status: RoomStatusCompleted})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestRoomComplete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=completed")
+			)
+
+			if exp := "/Rooms/RM1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/room.json")
+		}
+
+		var (
+			exp  Room
+			f, _ = os.Open("fixtures/room.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		room, err := (roomAPI{client}).Complete(context.TODO(), "RM1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, room) {
+			t.Errorf("response diff %v", cmp.Diff(exp, room))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (roomAPI{client}).Complete(ctx, "RM1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
@@ -0,0 +1,41 @@
+package video
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+type optionals interface {
+	encode() io.Reader
+}
+
+var optionalsFn = func(m optionals, exp []byte) func(*testing.T) {
+	return func(t *testing.T) {
+		got, err := ioutil.ReadAll(m.encode())
+		if err != nil {
+			t.Errorf("exp parsing err, got %v", err)
+		}
+		if !bytes.Equal(got, exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	}
+}
+
+func TestRoomParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("CreateParams", optionalsFn(RoomCreateParams{}, exp))
+	exp = []byte("Status=")
+	t.Run("UpdateParams", optionalsFn(RoomUpdateParams{}, exp))
+
+	disabled := false
+	exp = []byte("AudioOnly=false")
+	t.Run("CreateParams disabled", optionalsFn(RoomCreateParams{AudioOnly: &disabled}, exp))
+}
+
+func TestRoomListParamsQuery(t *testing.T) {
+	if exp, got := "", (RoomListParams{}).query(); exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+}
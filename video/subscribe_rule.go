@@ -0,0 +1,59 @@
+package video
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// SubscribeRuleResource handles interactions with Video Track Subscriptions REST API.
+type SubscribeRuleResource struct {
+	subscribeRuleAPI
+}
+
+// Subscribe rule types.
+const (
+	RuleInclude = "include"
+	RuleExclude = "exclude"
+)
+
+// SubscribeRule includes or excludes the tracks matching all of its filters, rules
+// are applied in order.
+// https://www.twilio.com/docs/video/api/track-subscriptions#specifying-sr
+type SubscribeRule struct {
+	Type      string `json:"type"`
+	All       bool   `json:"all,omitempty"`
+	Publisher string `json:"publisher,omitempty"`
+	Track     string `json:"track,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Priority  string `json:"priority,omitempty"`
+}
+
+// SubscribeRules holds the rules of the tracks a participant subscribes to.
+type SubscribeRules struct {
+	ParticipantSid string          `json:"participant_sid"`
+	RoomSid        string          `json:"room_sid"`
+	Rules          []SubscribeRule `json:"rules"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+}
+
+// SubscribeRuleUpdateParams holds the rules replacing the rules of a participant.
+// https://www.twilio.com/docs/video/api/track-subscriptions#update-subscribe-rules
+type SubscribeRuleUpdateParams struct {
+	Rules []SubscribeRule
+}
+
+// encode sends the rules as a JSON array, no rules unsubscribes from every track.
+func (sup SubscribeRuleUpdateParams) encode() io.Reader {
+	if sup.Rules == nil {
+		sup.Rules = []SubscribeRule{}
+	}
+	rules, _ := json.Marshal(sup.Rules)
+	return strings.NewReader(url.Values{"Rules": {string(rules)}}.Encode())
+}
@@ -0,0 +1,29 @@
+package video
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type subscribeRuleAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Rooms/{Room SID}/Participants/{Participant SID}/SubscribeRules
+// https://www.twilio.com/docs/video/api/track-subscriptions#get-subscribe-rules
+func (api subscribeRuleAPI) Read(ctx context.Context, roomSid, participantSid string) (SubscribeRules, error) {
+	var rules SubscribeRules
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s/SubscribeRules", roomSid, participantSid), &rules)
+	return rules, err
+}
+
+// Update replaces the subscribe rules of a participant.
+// POST /Rooms/{Room SID}/Participants/{Participant SID}/SubscribeRules
+// https://www.twilio.com/docs/video/api/track-subscriptions#update-subscribe-rules
+func (api subscribeRuleAPI) Update(ctx context.Context, roomSid, participantSid string, body SubscribeRuleUpdateParams) (SubscribeRules, error) {
+	var rules SubscribeRules
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s/SubscribeRules", roomSid, participantSid), body.encode(), &rules)
+	return rules, err
+}
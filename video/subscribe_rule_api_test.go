@@ -0,0 +1,87 @@
+package video
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSubscribeRuleRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Participants/PA1/SubscribeRules"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/subscribe_rules.json")
+		}
+
+		var (
+			exp  SubscribeRules
+			f, _ = os.Open("fixtures/subscribe_rules.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rules, err := (subscribeRuleAPI{client}).Read(context.TODO(), "RM1", "PA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rules) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rules))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (subscribeRuleAPI{client}).Read(ctx, "RM1", "PA1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestSubscribeRuleUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Rules=%5B%7B%22type%22%3A%22include%22%2C%22all%22%3Atrue%7D%5D")
+			)
+
+			if exp := "/Rooms/RM1/Participants/PA1/SubscribeRules"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/subscribe_rules.json")
+		}
+
+		var (
+			exp  SubscribeRules
+			f, _ = os.Open("fixtures/subscribe_rules.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rules, err := (subscribeRuleAPI{client}).Update(context.TODO(), "RM1", "PA1", SubscribeRuleUpdateParams{Rules: []SubscribeRule{{Type: RuleInclude, All: true}}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rules) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rules))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (subscribeRuleAPI{client}).Update(ctx, "RM1", "PA1", SubscribeRuleUpdateParams{Rules: []SubscribeRule{{Type: RuleInclude, All: true}}})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
@@ -0,0 +1,12 @@
+package video
+
+import "testing"
+
+func TestSubscribeRuleParamsOptionals(t *testing.T) {
+	exp := []byte("Rules=%5B%5D")
+	t.Run("UpdateParams", optionalsFn(SubscribeRuleUpdateParams{}, exp))
+
+	rules := []SubscribeRule{{Type: RuleExclude, Publisher: "bob", Kind: KindVideo}}
+	exp = []byte("Rules=%5B%7B%22type%22%3A%22exclude%22%2C%22publisher%22%3A%22bob%22%2C%22kind%22%3A%22video%22%7D%5D")
+	t.Run("UpdateParams filters", optionalsFn(SubscribeRuleUpdateParams{Rules: rules}, exp))
+}
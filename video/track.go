@@ -0,0 +1,66 @@
+package video
+
+// PublishedTrackResource handles interactions with Video Published Tracks REST API.
+type PublishedTrackResource struct {
+	publishedTrackAPI
+}
+
+// SubscribedTrackResource handles interactions with Video Subscribed Tracks REST API.
+type SubscribedTrackResource struct {
+	subscribedTrackAPI
+}
+
+// Track kinds.
+const (
+	KindAudio = "audio"
+	KindVideo = "video"
+	KindData  = "data"
+)
+
+// PublishedTrack is an audio, video or data track shared by a participant.
+type PublishedTrack struct {
+	Sid            string `json:"sid"`
+	ParticipantSid string `json:"participant_sid"`
+	RoomSid        string `json:"room_sid"`
+	Name           string `json:"name"`
+	Kind           string `json:"kind"`
+	Enabled        bool   `json:"enabled"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// PublishedTrackList holds a page of published tracks.
+type PublishedTrackList struct {
+	PublishedTracks []PublishedTrack `json:"published_tracks"`
+	Meta            Meta             `json:"meta"`
+}
+
+// SubscribedTrack is a track of a publisher received by a subscriber.
+type SubscribedTrack struct {
+	Sid            string `json:"sid"`
+	ParticipantSid string `json:"participant_sid"`
+	PublisherSid   string `json:"publisher_sid"`
+	SubscriberSid  string `json:"subscriber_sid"`
+	RoomSid        string `json:"room_sid"`
+	Name           string `json:"name"`
+	Kind           string `json:"kind"`
+	Enabled        bool   `json:"enabled"`
+
+	// DateCreated ISO-8601 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated ISO-8601 format.
+	DateUpdated string `json:"date_updated"`
+	URL         string `json:"url"`
+}
+
+// SubscribedTrackList holds a page of subscribed tracks.
+type SubscribedTrackList struct {
+	SubscribedTracks []SubscribedTrack `json:"subscribed_tracks"`
+	Meta             Meta              `json:"meta"`
+}
@@ -0,0 +1,48 @@
+package video
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type publishedTrackAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Rooms/{Room SID}/Participants/{Participant SID}/PublishedTracks/{Track SID}
+// https://www.twilio.com/docs/video/api/track-resource#get-instance
+func (api publishedTrackAPI) Read(ctx context.Context, roomSid, participantSid, trackSid string) (PublishedTrack, error) {
+	var track PublishedTrack
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s/PublishedTracks/%s", roomSid, participantSid, trackSid), &track)
+	return track, err
+}
+
+// GET /Rooms/{Room SID}/Participants/{Participant SID}/PublishedTracks
+// https://www.twilio.com/docs/video/api/track-resource#get-list-resource
+func (api publishedTrackAPI) List(ctx context.Context, roomSid, participantSid string, params ListParams) (PublishedTrackList, error) {
+	var tracks PublishedTrackList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s/PublishedTracks", roomSid, participantSid)+twilio.Query(params), &tracks)
+	return tracks, err
+}
+
+type subscribedTrackAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Rooms/{Room SID}/Participants/{Participant SID}/SubscribedTracks/{Track SID}
+// https://www.twilio.com/docs/video/api/subscribedtrack-resource#get-instance
+func (api subscribedTrackAPI) Read(ctx context.Context, roomSid, participantSid, trackSid string) (SubscribedTrack, error) {
+	var track SubscribedTrack
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s/SubscribedTracks/%s", roomSid, participantSid, trackSid), &track)
+	return track, err
+}
+
+// GET /Rooms/{Room SID}/Participants/{Participant SID}/SubscribedTracks
+// https://www.twilio.com/docs/video/api/subscribedtrack-resource#get-list-resource
+func (api subscribedTrackAPI) List(ctx context.Context, roomSid, participantSid string, params ListParams) (SubscribedTrackList, error) {
+	var tracks SubscribedTrackList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Rooms/%s/Participants/%s/SubscribedTracks", roomSid, participantSid)+twilio.Query(params), &tracks)
+	return tracks, err
+}
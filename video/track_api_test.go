@@ -0,0 +1,143 @@
+package video
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPublishedTrackRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Participants/PA1/PublishedTracks/MT1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/published_track.json")
+		}
+
+		var (
+			exp  PublishedTrack
+			f, _ = os.Open("fixtures/published_track.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		track, err := (publishedTrackAPI{client}).Read(context.TODO(), "RM1", "PA1", "MT1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, track) {
+			t.Errorf("response diff %v", cmp.Diff(exp, track))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (publishedTrackAPI{client}).Read(ctx, "RM1", "PA1", "MT1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestPublishedTrackList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Participants/PA1/PublishedTracks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/published_tracks.json")
+		}
+
+		var (
+			exp  PublishedTrackList
+			f, _ = os.Open("fixtures/published_tracks.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		tracks, err := (publishedTrackAPI{client}).List(context.TODO(), "RM1", "PA1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, tracks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, tracks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (publishedTrackAPI{client}).List(ctx, "RM1", "PA1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestSubscribedTrackRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Participants/PA1/SubscribedTracks/MT2"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/subscribed_track.json")
+		}
+
+		var (
+			exp  SubscribedTrack
+			f, _ = os.Open("fixtures/subscribed_track.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		track, err := (subscribedTrackAPI{client}).Read(context.TODO(), "RM1", "PA1", "MT2")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, track) {
+			t.Errorf("response diff %v", cmp.Diff(exp, track))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (subscribedTrackAPI{client}).Read(ctx, "RM1", "PA1", "MT2")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestSubscribedTrackList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Rooms/RM1/Participants/PA1/SubscribedTracks"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/subscribed_tracks.json")
+		}
+
+		var (
+			exp  SubscribedTrackList
+			f, _ = os.Open("fixtures/subscribed_tracks.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		tracks, err := (subscribedTrackAPI{client}).List(context.TODO(), "RM1", "PA1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, tracks) {
+			t.Errorf("response diff %v", cmp.Diff(exp, tracks))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (subscribedTrackAPI{client}).List(ctx, "RM1", "PA1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
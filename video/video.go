@@ -0,0 +1,64 @@
+// Package video is a client of the Twilio Video v1 API, managing rooms and their
+// participants, tracks, recordings and compositions.
+package video
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Video video v1 interface
+type Video struct {
+	Rooms            RoomResource
+	Participants     ParticipantResource
+	PublishedTracks  PublishedTrackResource
+	SubscribedTracks SubscribedTrackResource
+	SubscribeRules   SubscribeRuleResource
+	Recordings       RecordingResource
+	RoomRecordings   RoomRecordingResource
+	Compositions     CompositionResource
+	CompositionHooks CompositionHookResource
+}
+
+// New returns a video instance with a base url set to `https://video.twilio.com/v1`
+// if `TWILIO_VIDEO_HOST` not set.
+func New(tctx twilio.Context) (Video, error) {
+	var video Video
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		videoEndpointForRegion(tctx.Region),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return video, err
+	}
+
+	{
+		video.Rooms = RoomResource{roomAPI{client}}
+		video.Participants = ParticipantResource{participantAPI{client}}
+		video.PublishedTracks = PublishedTrackResource{publishedTrackAPI{client}}
+		video.SubscribedTracks = SubscribedTrackResource{subscribedTrackAPI{client}}
+		video.SubscribeRules = SubscribeRuleResource{subscribeRuleAPI{client}}
+		video.Recordings = RecordingResource{recordingAPI{client}}
+		video.RoomRecordings = RoomRecordingResource{roomRecordingAPI{client}}
+		video.Compositions = CompositionResource{compositionAPI{client}}
+		video.CompositionHooks = CompositionHookResource{compositionHookAPI{client}}
+	}
+	return video, nil
+}
+
+func videoEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_VIDEO_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://video.%s.twilio.com/v1", region)
+	} else if url == "" {
+		return "https://video.twilio.com/v1"
+	}
+	return url
+}
@@ -0,0 +1,174 @@
+package voice
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// CallResource handles interactions with Calls REST API.
+type CallResource struct {
+	callAPI
+}
+
+// Call statuses.
+const (
+	StatusQueued     = "queued"
+	StatusRinging    = "ringing"
+	StatusInProgress = "in-progress"
+	StatusCompleted  = "completed"
+	StatusBusy       = "busy"
+	StatusFailed     = "failed"
+	StatusNoAnswer   = "no-answer"
+	StatusCanceled   = "canceled"
+)
+
+// Status callback events of a call.
+const (
+	EventInitiated = "initiated"
+	EventRinging   = "ringing"
+	EventAnswered  = "answered"
+	EventCompleted = "completed"
+)
+
+// Call is an inbound or outbound phone call.
+type Call struct {
+	Sid            string `json:"sid"`
+	AccountSid     string `json:"account_sid"`
+	ParentCallSid  string `json:"parent_call_sid"`
+	PhoneNumberSid string `json:"phone_number_sid"`
+	To             string `json:"to"`
+	ToFormatted    string `json:"to_formatted"`
+	From           string `json:"from"`
+	FromFormatted  string `json:"from_formatted"`
+	Status         string `json:"status"`
+
+	// Direction can be inbound, outbound-api or outbound-dial.
+	Direction string `json:"direction"`
+
+	// AnsweredBy is set when MachineDetection is enabled, eg. human or machine_start.
+	AnsweredBy    string `json:"answered_by"`
+	ForwardedFrom string `json:"forwarded_from"`
+	CallerName    string `json:"caller_name"`
+	GroupSid      string `json:"group_sid"`
+	TrunkSid      string `json:"trunk_sid"`
+	QueueTime     string `json:"queue_time"`
+
+	// Duration seconds, returned as a string.
+	Duration  string `json:"duration"`
+	Price     string `json:"price"`
+	PriceUnit string `json:"price_unit"`
+
+	// DateCreated, DateUpdated, StartTime and EndTime RFC 2822 format.
+	DateCreated     string `json:"date_created"`
+	DateUpdated     string `json:"date_updated"`
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	APIVersion      string `json:"api_version"`
+	URI             string `json:"uri"`
+	SubresourceURIs struct {
+		Notifications string `json:"notifications"`
+		Recordings    string `json:"recordings"`
+		Payments      string `json:"payments"`
+		Events        string `json:"events"`
+		Siprec        string `json:"siprec"`
+		Streams       string `json:"streams"`
+	} `json:"subresource_uris"`
+}
+
+// CallList holds a page of calls.
+type CallList struct {
+	Calls []Call `json:"calls"`
+	Meta
+}
+
+// CallListParams holds information used in listing calls.
+// https://www.twilio.com/docs/voice/api/call-resource#read-multiple-call-resources
+type CallListParams struct {
+	ListParams
+
+	To            string `url:",omitempty"`
+	From          string `url:",omitempty"`
+	ParentCallSid string `url:",omitempty"`
+	Status        string `url:",omitempty"`
+
+	// StartTime and EndTime filters, YYYY-MM-DD format.
+	StartTime       string `url:",omitempty"`
+	StartTimeBefore string `url:"StartTime<,omitempty"`
+	StartTimeAfter  string `url:"StartTime>,omitempty"`
+	EndTime         string `url:",omitempty"`
+	EndTimeBefore   string `url:"EndTime<,omitempty"`
+	EndTimeAfter    string `url:"EndTime>,omitempty"`
+}
+
+func (clp CallListParams) query() string {
+	return twilio.Query(clp)
+}
+
+// CallCreateParams holds information used in placing an outbound call, one of
+// URL, Twiml or ApplicationSid is required.
+// https://www.twilio.com/docs/voice/api/call-resource#create-a-call-resource
+type CallCreateParams struct {
+	To   string
+	From string
+
+	// URL returning the TwiML of the call, or the inline Twiml of the call,
+	// eg. the String of a `twiml.Response`.
+	URL            string `url:"Url,omitempty"`
+	Twiml          string `url:",omitempty"`
+	ApplicationSid string `url:",omitempty"`
+	Method         string `url:",omitempty"`
+	FallbackURL    string `url:"FallbackUrl,omitempty"`
+	FallbackMethod string `url:",omitempty"`
+
+	// StatusCallback url receiving the StatusCallbackEvent events, initiated,
+	// ringing, answered and completed. Defaults to completed.
+	StatusCallback       string   `url:",omitempty"`
+	StatusCallbackEvent  []string `url:",omitempty"`
+	StatusCallbackMethod string   `url:",omitempty"`
+	SendDigits           string   `url:",omitempty"`
+
+	// Timeout seconds the call rings before it is considered unanswered, 5 to 600. Default 60.
+	Timeout   int `url:",omitempty"`
+	TimeLimit int `url:",omitempty"`
+
+	Record                        bool     `url:",omitempty"`
+	RecordingChannels             string   `url:",omitempty"`
+	RecordingStatusCallback       string   `url:",omitempty"`
+	RecordingStatusCallbackEvent  []string `url:",omitempty"`
+	RecordingStatusCallbackMethod string   `url:",omitempty"`
+	Trim                          string   `url:",omitempty"`
+
+	// MachineDetection can be Enable or DetectMessageEnd, the result is set in AnsweredBy.
+	MachineDetection        string `url:",omitempty"`
+	MachineDetectionTimeout int    `url:",omitempty"`
+	AsyncAmd                bool   `url:",omitempty"`
+	AsyncAmdStatusCallback  string `url:",omitempty"`
+	CallerID                string `url:"CallerId,omitempty"`
+}
+
+func (ccp CallCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(ccp).Encode())
+}
+
+// CallUpdateParams holds information used in updating a live call, see `Redirect`
+// and `Hangup`.
+// https://www.twilio.com/docs/voice/api/call-resource#update-a-call-resource
+type CallUpdateParams struct {
+	URL            string `url:"Url,omitempty"`
+	Twiml          string `url:",omitempty"`
+	Method         string `url:",omitempty"`
+	FallbackURL    string `url:"FallbackUrl,omitempty"`
+	FallbackMethod string `url:",omitempty"`
+
+	// Status `canceled` ends a queued or ringing call, `completed` ends a call in progress.
+	Status               string `url:",omitempty"`
+	StatusCallback       string `url:",omitempty"`
+	StatusCallbackMethod string `url:",omitempty"`
+	TimeLimit            int    `url:",omitempty"`
+}
+
+func (cup CallUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(cup).Encode())
+}
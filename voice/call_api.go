@@ -0,0 +1,75 @@
+package voice
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type callAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Calls/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/call-resource#fetch-a-call-resource
+func (api callAPI) Read(ctx context.Context, callSid string) (Call, error) {
+	var call Call
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Calls/%s.json", callSid), &call)
+	return call, err
+}
+
+// GET /Accounts/{Account SID}/Calls.json
+// https://www.twilio.com/docs/voice/api/call-resource#read-multiple-call-resources
+func (api callAPI) List(ctx context.Context, params CallListParams) (CallList, error) {
+	var calls CallList
+	err := twilio.GetInto(ctx, api.client, "/Calls.json"+params.query(), &calls)
+	return calls, err
+}
+
+// POST /Accounts/{Account SID}/Calls.json
+// https://www.twilio.com/docs/voice/api/call-resource#create-a-call-resource
+func (api callAPI) Create(ctx context.Context, body CallCreateParams) (Call, error) {
+	return api.post(ctx, "/Calls.json", body.encode())
+}
+
+// POST /Accounts/{Account SID}/Calls/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/call-resource#update-a-call-resource
+func (api callAPI) Update(ctx context.Context, callSid string, body CallUpdateParams) (Call, error) {
+	return api.post(ctx, fmt.Sprintf("/Calls/%s.json", callSid), body.encode())
+}
+
+// Redirect moves a live call to the TwiML returned by the url.
+// POST /Accounts/{Account SID}/Calls/{Call SID}.json
+// https://www.twilio.com/docs/voice/tutorials/how-to-modify-calls-in-progress
+func (api callAPI) Redirect(ctx context.Context, callSid, url string) (Call, error) {
+	return api.Update(ctx, callSid, CallUpdateParams{URL: url})
+}
+
+// Hangup ends a call in progress.
+// POST /Accounts/{Account SID}/Calls/{Call SID}.json
+// https://www.twilio.com/docs/voice/tutorials/how-to-modify-calls-in-progress
+func (api callAPI) Hangup(ctx context.Context, callSid string) (Call, error) {
+	return api.Update(ctx, callSid, CallUpdateParams{Status: StatusCompleted})
+}
+
+// Cancel ends a queued or ringing call, calls in progress are not affected.
+// POST /Accounts/{Account SID}/Calls/{Call SID}.json
+// https://www.twilio.com/docs/voice/tutorials/how-to-modify-calls-in-progress
+func (api callAPI) Cancel(ctx context.Context, callSid string) (Call, error) {
+	return api.Update(ctx, callSid, CallUpdateParams{Status: StatusCanceled})
+}
+
+// DELETE /Accounts/{Account SID}/Calls/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/call-resource#delete-a-call-resource
+func (api callAPI) Delete(ctx context.Context, callSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Calls/%s.json", callSid))
+	return err
+}
+
+func (api callAPI) post(ctx context.Context, path string, body io.Reader) (Call, error) {
+	var call Call
+	err := twilio.PostInto(ctx, api.client, path, body, &call)
+	return call, err
+}
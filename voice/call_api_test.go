@@ -0,0 +1,311 @@
+package voice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCallRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Calls/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/call.json")
+		}
+
+		var (
+			exp  Call
+			f, _ = os.Open("fixtures/call.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		call, err := (callAPI{client}).Read(context.TODO(), "CA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, call) {
+			t.Errorf("response diff %v", cmp.Diff(exp, call))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callAPI{client}).Read(ctx, "CA1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCallList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Calls.json?PageSize=1&StartTime%3E=2020-01-01&Status=completed"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/calls.json")
+		}
+
+		var (
+			exp  CallList
+			f, _ = os.Open("fixtures/calls.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		calls, err := (callAPI{client}).List(context.TODO(), CallListParams{Status: StatusCompleted, StartTimeAfter: "2020-01-01", ListParams: ListParams{PageSize: 1}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, calls) {
+			t.Errorf("response diff %v", cmp.Diff(exp, calls))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callAPI{client}).List(ctx, CallListParams{Status: StatusCompleted, StartTimeAfter: "2020-01-01", ListParams: ListParams{PageSize: 1}})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCallCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("From=%2B14155552345&MachineDetection=Enable&StatusCallback=https%3A%2F%2Fexample.com%2Fstatus&StatusCallbackEvent=answered&StatusCallbackEvent=completed&Timeout=30&To=%2B14155552346&Url=https%3A%2F%2Fexample.com%2Fcall")
+			)
+
+			if exp := "/Calls.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/call.json")
+		}
+
+		var (
+			exp  Call
+			f, _ = os.Open("fixtures/call.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		call, err := (callAPI{client}).Create(context.TODO(), CallCreateParams{To: "+14155552346", From: "+14155552345", URL: "https://example.com/call", StatusCallback: "https://example.com/status", StatusCallbackEvent: []string{EventAnswered, EventCompleted}, MachineDetection: "Enable", Timeout: 30})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, call) {
+			t.Errorf("response diff %v", cmp.Diff(exp, call))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callAPI{client}).Create(ctx, CallCreateParams{To: "+14155552346", From: "+14155552345", URL: "https://example.com/call", StatusCallback: "https://example.com/status", StatusCallbackEvent: []string{EventAnswered, EventCompleted}, MachineDetection: "Enable", Timeout: 30})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Twiml=%3CResponse%3E%3CHangup%2F%3E%3C%2FResponse%3E")
+			)
+
+			if exp := "/Calls/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/call.json")
+		}
+
+		var (
+			exp  Call
+			f, _ = os.Open("fixtures/call.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		call, err := (callAPI{client}).Update(context.TODO(), "CA1", CallUpdateParams{Twiml: "<Response><Hangup/></Response>"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, call) {
+			t.Errorf("response diff %v", cmp.Diff(exp, call))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callAPI{client}).Update(ctx, "CA1", CallUpdateParams{Twiml: "<Response><Hangup/></Response>"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallRedirect(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Url=https%3A%2F%2Fexample.com%2Fnext")
+			)
+
+			if exp := "/Calls/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/call.json")
+		}
+
+		var (
+			exp  Call
+			f, _ = os.Open("fixtures/call.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		call, err := (callAPI{client}).Redirect(context.TODO(), "CA1", "https://example.com/next")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, call) {
+			t.Errorf("response diff %v", cmp.Diff(exp, call))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callAPI{client}).Redirect(ctx, "CA1", "https://example.com/next")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallHangup(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=completed")
+			)
+
+			if exp := "/Calls/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/call.json")
+		}
+
+		var (
+			exp  Call
+			f, _ = os.Open("fixtures/call.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		call, err := (callAPI{client}).Hangup(context.TODO(), "CA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, call) {
+			t.Errorf("response diff %v", cmp.Diff(exp, call))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callAPI{client}).Hangup(ctx, "CA1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallCancel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=canceled")
+			)
+
+			if exp := "/Calls/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/call.json")
+		}
+
+		var (
+			exp  Call
+			f, _ = os.Open("fixtures/call.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		call, err := (callAPI{client}).Cancel(context.TODO(), "CA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, call) {
+			t.Errorf("response diff %v", cmp.Diff(exp, call))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callAPI{client}).Cancel(ctx, "CA1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Calls/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (callAPI{client}).Delete(context.TODO(), "CA1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (callAPI{client}).Delete(ctx, "CA1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,52 @@
+package voice
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+type optionals interface {
+	encode() io.Reader
+}
+
+var optionalsFn = func(m optionals, exp []byte) func(*testing.T) {
+	return func(t *testing.T) {
+		got, err := ioutil.ReadAll(m.encode())
+		if err != nil {
+			t.Errorf("exp parsing err, got %v", err)
+		}
+		if !bytes.Equal(got, exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	}
+}
+
+func TestCallParamsOptionals(t *testing.T) {
+	exp := []byte("From=&To=")
+	t.Run("CreateParams", optionalsFn(CallCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(CallUpdateParams{}, exp))
+}
+
+func TestCallCreateParams(t *testing.T) {
+	params := CallCreateParams{
+		To:               "+15558675310",
+		From:             "+15017122661",
+		Twiml:            "<Response><Say>Hi</Say></Response>",
+		Record:           true,
+		CallerID:         "+15017122661",
+		AsyncAmd:         true,
+		MachineDetection: "DetectMessageEnd",
+	}
+	exp := []byte("AsyncAmd=true&CallerId=%2B15017122661&From=%2B15017122661&MachineDetection=DetectMessageEnd&Record=true&To=%2B15558675310&Twiml=%3CResponse%3E%3CSay%3EHi%3C%2FSay%3E%3C%2FResponse%3E")
+	t.Run("encode", optionalsFn(params, exp))
+}
+
+func TestCallListParams(t *testing.T) {
+	params := CallListParams{From: "+123", EndTimeBefore: "2020-01-01"}
+	if exp, got := "?EndTime%3C=2020-01-01&From=%2B123", params.query(); exp != got {
+		t.Errorf("exp query %s, got %s", exp, got)
+	}
+}
@@ -0,0 +1,88 @@
+package voice
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ConferenceResource handles interactions with Conferences REST API.
+type ConferenceResource struct {
+	conferenceAPI
+}
+
+// Conference statuses.
+const (
+	ConferenceStatusInit       = "init"
+	ConferenceStatusInProgress = "in-progress"
+	ConferenceStatusCompleted  = "completed"
+)
+
+// Conference is a room connecting the calls of its participants.
+type Conference struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+	Status       string `json:"status"`
+	Region       string `json:"region"`
+
+	// ReasonConferenceEnded eg. conference-ended-via-api or participant-with-end-conference-on-exit-left.
+	ReasonConferenceEnded   string `json:"reason_conference_ended"`
+	CallSidEndingConference string `json:"call_sid_ending_conference"`
+
+	// DateCreated RFC 2822 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated RFC 2822 format.
+	DateUpdated     string `json:"date_updated"`
+	APIVersion      string `json:"api_version"`
+	URI             string `json:"uri"`
+	SubresourceURIs struct {
+		Participants string `json:"participants"`
+		Recordings   string `json:"recordings"`
+	} `json:"subresource_uris"`
+}
+
+// ConferenceList holds a page of conferences.
+type ConferenceList struct {
+	Conferences []Conference `json:"conferences"`
+	Meta
+}
+
+// ConferenceListParams holds information used in listing conferences.
+// https://www.twilio.com/docs/voice/api/conference-resource#read-multiple-conference-resources
+type ConferenceListParams struct {
+	ListParams
+
+	FriendlyName string `url:",omitempty"`
+	Status       string `url:",omitempty"`
+
+	// DateCreated and DateUpdated filters, YYYY-MM-DD format.
+	DateCreated       string `url:",omitempty"`
+	DateCreatedBefore string `url:"DateCreated<,omitempty"`
+	DateCreatedAfter  string `url:"DateCreated>,omitempty"`
+	DateUpdated       string `url:",omitempty"`
+	DateUpdatedBefore string `url:"DateUpdated<,omitempty"`
+	DateUpdatedAfter  string `url:"DateUpdated>,omitempty"`
+}
+
+func (clp ConferenceListParams) query() string {
+	return twilio.Query(clp)
+}
+
+// ConferenceUpdateParams holds information used in updating a conference, see
+// `End` and `Announce`.
+// https://www.twilio.com/docs/voice/api/conference-resource#update-a-conference-resource
+type ConferenceUpdateParams struct {
+	// Status `completed` ends the conference and disconnects its participants.
+	Status string `url:",omitempty"`
+
+	// AnnounceURL returning the TwiML played to all participants, Say and Play only.
+	AnnounceURL    string `url:"AnnounceUrl,omitempty"`
+	AnnounceMethod string `url:",omitempty"`
+}
+
+func (cup ConferenceUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(cup).Encode())
+}
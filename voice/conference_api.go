@@ -0,0 +1,50 @@
+package voice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type conferenceAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Conferences/{Conference SID}.json
+// https://www.twilio.com/docs/voice/api/conference-resource#fetch-a-conference-resource
+func (api conferenceAPI) Read(ctx context.Context, conferenceSid string) (Conference, error) {
+	var conf Conference
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Conferences/%s.json", conferenceSid), &conf)
+	return conf, err
+}
+
+// GET /Accounts/{Account SID}/Conferences.json
+// https://www.twilio.com/docs/voice/api/conference-resource#read-multiple-conference-resources
+func (api conferenceAPI) List(ctx context.Context, params ConferenceListParams) (ConferenceList, error) {
+	var confs ConferenceList
+	err := twilio.GetInto(ctx, api.client, "/Conferences.json"+params.query(), &confs)
+	return confs, err
+}
+
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}.json
+// https://www.twilio.com/docs/voice/api/conference-resource#update-a-conference-resource
+func (api conferenceAPI) Update(ctx context.Context, conferenceSid string, body ConferenceUpdateParams) (Conference, error) {
+	var conf Conference
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Conferences/%s.json", conferenceSid), body.encode(), &conf)
+	return conf, err
+}
+
+// End ends a conference, disconnecting all its participants.
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}.json
+// https://www.twilio.com/docs/voice/api/conference-resource#update-a-conference-resource
+func (api conferenceAPI) End(ctx context.Context, conferenceSid string) (Conference, error) {
+	return api.Update(ctx, conferenceSid, ConferenceUpdateParams{Status: ConferenceStatusCompleted})
+}
+
+// Announce plays the TwiML returned by the url to all the participants.
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}.json
+// https://www.twilio.com/docs/voice/api/conference-resource#update-a-conference-resource
+func (api conferenceAPI) Announce(ctx context.Context, conferenceSid, url string) (Conference, error) {
+	return api.Update(ctx, conferenceSid, ConferenceUpdateParams{AnnounceURL: url})
+}
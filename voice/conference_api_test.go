@@ -0,0 +1,202 @@
+package voice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConferenceRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences/CF1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/conference.json")
+		}
+
+		var (
+			exp  Conference
+			f, _ = os.Open("fixtures/conference.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (conferenceAPI{client}).Read(context.TODO(), "CF1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceAPI{client}).Read(ctx, "CF1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConferenceList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences.json?FriendlyName=agents&Status=in-progress"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/conferences.json")
+		}
+
+		var (
+			exp  ConferenceList
+			f, _ = os.Open("fixtures/conferences.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		confs, err := (conferenceAPI{client}).List(context.TODO(), ConferenceListParams{FriendlyName: "agents", Status: ConferenceStatusInProgress})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, confs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, confs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceAPI{client}).List(ctx, ConferenceListParams{FriendlyName: "agents", Status: ConferenceStatusInProgress})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConferenceUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AnnounceMethod=GET&AnnounceUrl=https%3A%2F%2Fexample.com%2Fannounce")
+			)
+
+			if exp := "/Conferences/CF1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/conference.json")
+		}
+
+		var (
+			exp  Conference
+			f, _ = os.Open("fixtures/conference.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (conferenceAPI{client}).Update(context.TODO(), "CF1", ConferenceUpdateParams{AnnounceURL: "https://example.com/announce", AnnounceMethod: "GET"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceAPI{client}).Update(ctx, "CF1", ConferenceUpdateParams{AnnounceURL: "https://example.com/announce", AnnounceMethod: "GET"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestConferenceEnd(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=completed")
+			)
+
+			if exp := "/Conferences/CF1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/conference.json")
+		}
+
+		var (
+			exp  Conference
+			f, _ = os.Open("fixtures/conference.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (conferenceAPI{client}).End(context.TODO(), "CF1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceAPI{client}).End(ctx, "CF1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestConferenceAnnounce(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AnnounceUrl=https%3A%2F%2Fexample.com%2Fannounce")
+			)
+
+			if exp := "/Conferences/CF1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/conference.json")
+		}
+
+		var (
+			exp  Conference
+			f, _ = os.Open("fixtures/conference.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		conf, err := (conferenceAPI{client}).Announce(context.TODO(), "CF1", "https://example.com/announce")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, conf) {
+			t.Errorf("response diff %v", cmp.Diff(exp, conf))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceAPI{client}).Announce(ctx, "CF1", "https://example.com/announce")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
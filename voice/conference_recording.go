@@ -0,0 +1,7 @@
+package voice
+
+// ConferenceRecordingResource handles interactions with the Recordings of
+// Conferences REST API.
+type ConferenceRecordingResource struct {
+	conferenceRecordingAPI
+}
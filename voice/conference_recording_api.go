@@ -0,0 +1,43 @@
+package voice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type conferenceRecordingAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Conferences/{Conference SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/conference-recording-resource#fetch-a-conferencerecording-resource
+func (api conferenceRecordingAPI) Read(ctx context.Context, conferenceSid, recordingSid string) (Recording, error) {
+	var rec Recording
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Conferences/%s/Recordings/%s.json", conferenceSid, recordingSid), &rec)
+	return rec, err
+}
+
+// GET /Accounts/{Account SID}/Conferences/{Conference SID}/Recordings.json
+// https://www.twilio.com/docs/voice/api/conference-recording-resource#read-multiple-conferencerecording-resources
+func (api conferenceRecordingAPI) List(ctx context.Context, conferenceSid string, params RecordingListParams) (RecordingList, error) {
+	var recs RecordingList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Conferences/%s/Recordings.json%s", conferenceSid, params.query()), &recs)
+	return recs, err
+}
+
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/conference-recording-resource#update-a-conferencerecording-resource
+func (api conferenceRecordingAPI) Update(ctx context.Context, conferenceSid, recordingSid string, body RecordingUpdateParams) (Recording, error) {
+	var rec Recording
+	err := twilio.PostInto(ctx, api.client, fmt.Sprintf("/Conferences/%s/Recordings/%s.json", conferenceSid, recordingSid), body.encode(), &rec)
+	return rec, err
+}
+
+// DELETE /Accounts/{Account SID}/Conferences/{Conference SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/conference-recording-resource#delete-a-conferencerecording-resource
+func (api conferenceRecordingAPI) Delete(ctx context.Context, conferenceSid, recordingSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Conferences/%s/Recordings/%s.json", conferenceSid, recordingSid))
+	return err
+}
@@ -0,0 +1,147 @@
+package voice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConferenceRecordingRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences/CF1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (conferenceRecordingAPI{client}).Read(context.TODO(), "CF1", "RE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceRecordingAPI{client}).Read(ctx, "CF1", "RE1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConferenceRecordingList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences/CF1/Recordings.json?DateCreated%3E=2016-10-14"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recordings.json")
+		}
+
+		var (
+			exp  RecordingList
+			f, _ = os.Open("fixtures/recordings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		recs, err := (conferenceRecordingAPI{client}).List(context.TODO(), "CF1", RecordingListParams{DateCreatedAfter: "2016-10-14"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, recs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, recs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceRecordingAPI{client}).List(ctx, "CF1", RecordingListParams{DateCreatedAfter: "2016-10-14"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestConferenceRecordingUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("PauseBehavior=skip&Status=paused")
+			)
+
+			if exp := "/Conferences/CF1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (conferenceRecordingAPI{client}).Update(context.TODO(), "CF1", "RE1", RecordingUpdateParams{Status: RecordingStatusPaused, PauseBehavior: "skip"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (conferenceRecordingAPI{client}).Update(ctx, "CF1", "RE1", RecordingUpdateParams{Status: RecordingStatusPaused, PauseBehavior: "skip"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestConferenceRecordingDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences/CF1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (conferenceRecordingAPI{client}).Delete(context.TODO(), "CF1", "RE1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (conferenceRecordingAPI{client}).Delete(ctx, "CF1", "RE1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
@@ -0,0 +1,11 @@
+package voice
+
+import "testing"
+
+func TestConferenceParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("UpdateParams", optionalsFn(ConferenceUpdateParams{}, exp))
+	if exp, got := "", (ConferenceListParams{}).query(); exp != got {
+		t.Errorf("exp query %q, got %q", exp, got)
+	}
+}
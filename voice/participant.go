@@ -0,0 +1,134 @@
+package voice
+
+import (
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// ParticipantResource handles interactions with the Participants of Conferences REST API.
+type ParticipantResource struct {
+	participantAPI
+}
+
+// Participant is a call connected to a conference, identified by its call sid.
+type Participant struct {
+	CallSid        string `json:"call_sid"`
+	AccountSid     string `json:"account_sid"`
+	ConferenceSid  string `json:"conference_sid"`
+	Label          string `json:"label"`
+	Status         string `json:"status"`
+	Muted          bool   `json:"muted"`
+	Hold           bool   `json:"hold"`
+	Coaching       bool   `json:"coaching"`
+	CallSidToCoach string `json:"call_sid_to_coach"`
+
+	StartConferenceOnEnter bool   `json:"start_conference_on_enter"`
+	EndConferenceOnExit    bool   `json:"end_conference_on_exit"`
+	QueueTime              string `json:"queue_time"`
+
+	// DateCreated RFC 2822 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated RFC 2822 format.
+	DateUpdated string `json:"date_updated"`
+	URI         string `json:"uri"`
+}
+
+// ParticipantList holds a page of participants of a conference.
+type ParticipantList struct {
+	Participants []Participant `json:"participants"`
+	Meta
+}
+
+// ParticipantListParams holds information used in listing participants.
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#read-multiple-participant-resources
+type ParticipantListParams struct {
+	ListParams
+
+	Muted    *bool `url:",omitempty"`
+	Hold     *bool `url:",omitempty"`
+	Coaching *bool `url:",omitempty"`
+}
+
+func (plp ParticipantListParams) query() string {
+	return twilio.Query(plp)
+}
+
+// ParticipantCreateParams holds information used in calling a new participant into
+// a conference, the conference is created by the first participant.
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#create-a-participant-agent-conference-only
+type ParticipantCreateParams struct {
+	From  string
+	To    string
+	Label string `url:",omitempty"`
+
+	// EarlyMedia plays the ringing of the participant to the conference.
+	EarlyMedia *bool  `url:",omitempty"`
+	Timeout    int    `url:",omitempty"`
+	TimeLimit  int    `url:",omitempty"`
+	CallerID   string `url:"CallerId,omitempty"`
+
+	StatusCallback       string   `url:",omitempty"`
+	StatusCallbackMethod string   `url:",omitempty"`
+	StatusCallbackEvent  []string `url:",omitempty"`
+
+	Muted                  bool   `url:",omitempty"`
+	Beep                   string `url:",omitempty"`
+	StartConferenceOnEnter *bool  `url:",omitempty"`
+	EndConferenceOnExit    bool   `url:",omitempty"`
+	WaitURL                string `url:"WaitUrl,omitempty"`
+	WaitMethod             string `url:",omitempty"`
+	MaxParticipants        int    `url:",omitempty"`
+
+	// Coaching lets the participant speak only to CallSidToCoach, eg. a supervisor
+	// whispering to an agent.
+	Coaching       bool   `url:",omitempty"`
+	CallSidToCoach string `url:",omitempty"`
+
+	// Record records the call leg of the participant.
+	Record                       bool     `url:",omitempty"`
+	RecordingChannels            string   `url:",omitempty"`
+	RecordingStatusCallback      string   `url:",omitempty"`
+	RecordingStatusCallbackEvent []string `url:",omitempty"`
+
+	// ConferenceRecord can be record-from-start or do-not-record.
+	ConferenceRecord                  string   `url:",omitempty"`
+	ConferenceTrim                    string   `url:",omitempty"`
+	ConferenceStatusCallback          string   `url:",omitempty"`
+	ConferenceStatusCallbackEvent     []string `url:",omitempty"`
+	ConferenceRecordingStatusCallback string   `url:",omitempty"`
+
+	MachineDetection string `url:",omitempty"`
+	Region           string `url:",omitempty"`
+}
+
+func (pcp ParticipantCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(pcp).Encode())
+}
+
+// ParticipantUpdateParams holds information used in updating a participant, see
+// `Mute` and `Hold`.
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#update-a-participant-resource
+type ParticipantUpdateParams struct {
+	Muted *bool `url:",omitempty"`
+	Hold  *bool `url:",omitempty"`
+
+	// HoldURL returning the TwiML played while on hold, Play, Say, Pause and Redirect only.
+	HoldURL        string `url:"HoldUrl,omitempty"`
+	HoldMethod     string `url:",omitempty"`
+	AnnounceURL    string `url:"AnnounceUrl,omitempty"`
+	AnnounceMethod string `url:",omitempty"`
+	WaitURL        string `url:"WaitUrl,omitempty"`
+	WaitMethod     string `url:",omitempty"`
+
+	BeepOnExit          *bool  `url:",omitempty"`
+	EndConferenceOnExit *bool  `url:",omitempty"`
+	Coaching            *bool  `url:",omitempty"`
+	CallSidToCoach      string `url:",omitempty"`
+}
+
+func (pup ParticipantUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(pup).Encode())
+}
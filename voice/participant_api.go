@@ -0,0 +1,71 @@
+package voice
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type participantAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Conferences/{Conference SID}/Participants/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#fetch-a-participant-resource
+func (api participantAPI) Read(ctx context.Context, conferenceSid, callSid string) (Participant, error) {
+	var participant Participant
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Conferences/%s/Participants/%s.json", conferenceSid, callSid), &participant)
+	return participant, err
+}
+
+// GET /Accounts/{Account SID}/Conferences/{Conference SID}/Participants.json
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#read-multiple-participant-resources
+func (api participantAPI) List(ctx context.Context, conferenceSid string, params ParticipantListParams) (ParticipantList, error) {
+	var participants ParticipantList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Conferences/%s/Participants.json%s", conferenceSid, params.query()), &participants)
+	return participants, err
+}
+
+// Add calls a participant into a conference, the conference sid can be replaced by
+// its friendly name to create it.
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}/Participants.json
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#create-a-participant-agent-conference-only
+func (api participantAPI) Add(ctx context.Context, conferenceSid string, body ParticipantCreateParams) (Participant, error) {
+	return api.post(ctx, fmt.Sprintf("/Conferences/%s/Participants.json", conferenceSid), body.encode())
+}
+
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}/Participants/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#update-a-participant-resource
+func (api participantAPI) Update(ctx context.Context, conferenceSid, callSid string, body ParticipantUpdateParams) (Participant, error) {
+	return api.post(ctx, fmt.Sprintf("/Conferences/%s/Participants/%s.json", conferenceSid, callSid), body.encode())
+}
+
+// Mute mutes or unmutes a participant.
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}/Participants/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#update-a-participant-resource
+func (api participantAPI) Mute(ctx context.Context, conferenceSid, callSid string, muted bool) (Participant, error) {
+	return api.Update(ctx, conferenceSid, callSid, ParticipantUpdateParams{Muted: &muted})
+}
+
+// Hold puts a participant on hold, or takes it back to the conference.
+// POST /Accounts/{Account SID}/Conferences/{Conference SID}/Participants/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#update-a-participant-resource
+func (api participantAPI) Hold(ctx context.Context, conferenceSid, callSid string, hold bool) (Participant, error) {
+	return api.Update(ctx, conferenceSid, callSid, ParticipantUpdateParams{Hold: &hold})
+}
+
+// Delete kicks a participant out of the conference, ending its call.
+// DELETE /Accounts/{Account SID}/Conferences/{Conference SID}/Participants/{Call SID}.json
+// https://www.twilio.com/docs/voice/api/conference-participant-resource#delete-a-participant-resource
+func (api participantAPI) Delete(ctx context.Context, conferenceSid, callSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Conferences/%s/Participants/%s.json", conferenceSid, callSid))
+	return err
+}
+
+func (api participantAPI) post(ctx context.Context, path string, body io.Reader) (Participant, error) {
+	var participant Participant
+	err := twilio.PostInto(ctx, api.client, path, body, &participant)
+	return participant, err
+}
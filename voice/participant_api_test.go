@@ -0,0 +1,272 @@
+package voice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParticipantRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences/CF1/Participants/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		participant, err := (participantAPI{client}).Read(context.TODO(), "CF1", "CA1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, participant) {
+			t.Errorf("response diff %v", cmp.Diff(exp, participant))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Read(ctx, "CF1", "CA1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestParticipantList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences/CF1/Participants.json?PageSize=1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/participants.json")
+		}
+
+		var (
+			exp  ParticipantList
+			f, _ = os.Open("fixtures/participants.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		participants, err := (participantAPI{client}).List(context.TODO(), "CF1", ParticipantListParams{ListParams: ListParams{PageSize: 1}})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, participants) {
+			t.Errorf("response diff %v", cmp.Diff(exp, participants))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).List(ctx, "CF1", ParticipantListParams{ListParams: ListParams{PageSize: 1}})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+var earlyMedia = true
+
+func TestParticipantAdd(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("CallSidToCoach=CA2&Coaching=true&EarlyMedia=true&From=%2B14155552345&To=%2B14155552346")
+			)
+
+			if exp := "/Conferences/agents/Participants.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		participant, err := (participantAPI{client}).Add(context.TODO(), "agents", ParticipantCreateParams{From: "+14155552345", To: "+14155552346", EarlyMedia: &earlyMedia, Coaching: true, CallSidToCoach: "CA2"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, participant) {
+			t.Errorf("response diff %v", cmp.Diff(exp, participant))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Add(ctx, "agents", ParticipantCreateParams{From: "+14155552345", To: "+14155552346", EarlyMedia: &earlyMedia, Coaching: true, CallSidToCoach: "CA2"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestParticipantUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("AnnounceUrl=https%3A%2F%2Fexample.com%2Fannounce")
+			)
+
+			if exp := "/Conferences/CF1/Participants/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		participant, err := (participantAPI{client}).Update(context.TODO(), "CF1", "CA1", ParticipantUpdateParams{AnnounceURL: "https://example.com/announce"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, participant) {
+			t.Errorf("response diff %v", cmp.Diff(exp, participant))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Update(ctx, "CF1", "CA1", ParticipantUpdateParams{AnnounceURL: "https://example.com/announce"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestParticipantMute(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Muted=true")
+			)
+
+			if exp := "/Conferences/CF1/Participants/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		participant, err := (participantAPI{client}).Mute(context.TODO(), "CF1", "CA1", true)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, participant) {
+			t.Errorf("response diff %v", cmp.Diff(exp, participant))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Mute(ctx, "CF1", "CA1", true)
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestParticipantHold(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Hold=false")
+			)
+
+			if exp := "/Conferences/CF1/Participants/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/participant.json")
+		}
+
+		var (
+			exp  Participant
+			f, _ = os.Open("fixtures/participant.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		participant, err := (participantAPI{client}).Hold(context.TODO(), "CF1", "CA1", false)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, participant) {
+			t.Errorf("response diff %v", cmp.Diff(exp, participant))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (participantAPI{client}).Hold(ctx, "CF1", "CA1", false)
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestParticipantDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Conferences/CF1/Participants/CA1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (participantAPI{client}).Delete(context.TODO(), "CF1", "CA1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (participantAPI{client}).Delete(ctx, "CF1", "CA1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
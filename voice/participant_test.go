@@ -0,0 +1,15 @@
+package voice
+
+import "testing"
+
+func TestParticipantParamsOptionals(t *testing.T) {
+	exp := []byte("From=&To=")
+	t.Run("CreateParams", optionalsFn(ParticipantCreateParams{}, exp))
+	exp = []byte("")
+	t.Run("UpdateParams", optionalsFn(ParticipantUpdateParams{}, exp))
+
+	muted := false
+	if exp, got := "?Muted=false", (ParticipantListParams{Muted: &muted}).query(); exp != got {
+		t.Errorf("exp query %q, got %q", exp, got)
+	}
+}
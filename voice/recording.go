@@ -0,0 +1,132 @@
+package voice
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Recording statuses.
+const (
+	RecordingStatusInProgress = "in-progress"
+	RecordingStatusPaused     = "paused"
+	RecordingStatusStopped    = "stopped"
+	RecordingStatusProcessing = "processing"
+	RecordingStatusCompleted  = "completed"
+	RecordingStatusAbsent     = "absent"
+)
+
+// Formats of the recording media.
+const (
+	FormatWAV = "wav"
+	FormatMP3 = "mp3"
+)
+
+// RecordingResource handles interactions with the Recordings of an account.
+type RecordingResource struct {
+	recordingAPI
+}
+
+// CallRecordingResource handles interactions with the Recordings of Calls REST API,
+// starting and controlling the recording of a live call.
+type CallRecordingResource struct {
+	callRecordingAPI
+}
+
+// CurrentRecording refers to the recording in progress of a call in place of its sid.
+const CurrentRecording = "Twilio.CURRENT"
+
+// Recording is the audio recording of a call or conference.
+type Recording struct {
+	Sid           string `json:"sid"`
+	AccountSid    string `json:"account_sid"`
+	CallSid       string `json:"call_sid"`
+	ConferenceSid string `json:"conference_sid"`
+	Status        string `json:"status"`
+
+	// Source can be DialVerb, Conference, OutboundAPI, Trunking, RecordVerb,
+	// StartCallRecordingAPI or StartConferenceRecordingAPI.
+	Source   string `json:"source"`
+	Channels int    `json:"channels"`
+	Track    string `json:"track"`
+
+	// Duration seconds, returned as a string, -1 while in progress.
+	Duration          string          `json:"duration"`
+	Price             string          `json:"price"`
+	PriceUnit         string          `json:"price_unit"`
+	ErrorCode         int             `json:"error_code"`
+	EncryptionDetails json.RawMessage `json:"encryption_details"`
+
+	// DateCreated, DateUpdated and StartTime RFC 2822 format.
+	DateCreated     string `json:"date_created"`
+	DateUpdated     string `json:"date_updated"`
+	StartTime       string `json:"start_time"`
+	APIVersion      string `json:"api_version"`
+	MediaURL        string `json:"media_url"`
+	URI             string `json:"uri"`
+	SubresourceURIs struct {
+		AddOnResults   string `json:"add_on_results"`
+		Transcriptions string `json:"transcriptions"`
+	} `json:"subresource_uris"`
+}
+
+// RecordingList holds a page of recordings.
+type RecordingList struct {
+	Recordings []Recording `json:"recordings"`
+	Meta
+}
+
+// RecordingListParams holds information used in listing recordings.
+// https://www.twilio.com/docs/voice/api/recording#read-multiple-recording-resources
+type RecordingListParams struct {
+	ListParams
+
+	// CallSid and ConferenceSid filter the recordings of an account.
+	CallSid       string `url:",omitempty"`
+	ConferenceSid string `url:",omitempty"`
+
+	// DateCreated filters, YYYY-MM-DD format.
+	DateCreated       string `url:",omitempty"`
+	DateCreatedBefore string `url:"DateCreated<,omitempty"`
+	DateCreatedAfter  string `url:"DateCreated>,omitempty"`
+}
+
+func (rlp RecordingListParams) query() string {
+	return twilio.Query(rlp)
+}
+
+// RecordingCreateParams holds information used in starting the recording of a live call.
+// https://www.twilio.com/docs/voice/api/recording#create-a-recording-resource
+type RecordingCreateParams struct {
+	RecordingStatusCallback       string   `url:",omitempty"`
+	RecordingStatusCallbackEvent  []string `url:",omitempty"`
+	RecordingStatusCallbackMethod string   `url:",omitempty"`
+
+	// RecordingChannels can be mono or dual.
+	RecordingChannels string `url:",omitempty"`
+
+	// RecordingTrack can be inbound, outbound or both.
+	RecordingTrack string `url:",omitempty"`
+	Trim           string `url:",omitempty"`
+}
+
+func (rcp RecordingCreateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rcp).Encode())
+}
+
+// RecordingUpdateParams holds information used in pausing, resuming or stopping
+// a recording in progress.
+// https://www.twilio.com/docs/voice/api/recording#update-a-recording-resource
+type RecordingUpdateParams struct {
+	// Status can be paused, in-progress or stopped.
+	Status string
+
+	// PauseBehavior can be skip or silence. Default silence.
+	PauseBehavior string `url:",omitempty"`
+}
+
+func (rup RecordingUpdateParams) encode() io.Reader {
+	return strings.NewReader(twilio.Values(rup).Encode())
+}
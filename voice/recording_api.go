@@ -0,0 +1,135 @@
+package voice
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type recordingAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#fetch-a-recording-resource
+func (api recordingAPI) Read(ctx context.Context, recordingSid string) (Recording, error) {
+	var rec Recording
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Recordings/%s.json", recordingSid), &rec)
+	return rec, err
+}
+
+// GET /Accounts/{Account SID}/Recordings.json
+// https://www.twilio.com/docs/voice/api/recording#read-multiple-recording-resources
+func (api recordingAPI) List(ctx context.Context, params RecordingListParams) (RecordingList, error) {
+	var recs RecordingList
+	err := twilio.GetInto(ctx, api.client, "/Recordings.json"+params.query(), &recs)
+	return recs, err
+}
+
+// Each pages through the recordings matching the params, eg. a date range, calling
+// fn for each of them. Paging stops at the first error.
+func (api recordingAPI) Each(ctx context.Context, params RecordingListParams, fn func(Recording) error) error {
+	for {
+		page, err := api.List(ctx, params)
+		if err != nil {
+			return err
+		}
+		for _, rec := range page.Recordings {
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+
+		next, ok := page.Meta.Next()
+		if !ok {
+			return nil
+		}
+		params.ListParams = next
+	}
+}
+
+// Download streams the media of a recording in the format, wav or mp3, the stream
+// isn't bounded by the client timeout so the ctx should carry a deadline.
+// GET /Accounts/{Account SID}/Recordings/{Recording SID}.{format}
+// https://www.twilio.com/docs/voice/api/recording#fetch-a-recording-media-file
+func (api recordingAPI) Download(ctx context.Context, recordingSid, format string) (io.ReadCloser, error) {
+	return twilio.GetStream(ctx, api.client, fmt.Sprintf("/Recordings/%s.%s", recordingSid, format))
+}
+
+// DELETE /Accounts/{Account SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#delete-a-recording-resource
+func (api recordingAPI) Delete(ctx context.Context, recordingSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Recordings/%s.json", recordingSid))
+	return err
+}
+
+type callRecordingAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Calls/{Call SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#fetch-a-recording-resource
+func (api callRecordingAPI) Read(ctx context.Context, callSid, recordingSid string) (Recording, error) {
+	var rec Recording
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Calls/%s/Recordings/%s.json", callSid, recordingSid), &rec)
+	return rec, err
+}
+
+// GET /Accounts/{Account SID}/Calls/{Call SID}/Recordings.json
+// https://www.twilio.com/docs/voice/api/recording#read-multiple-recording-resources
+func (api callRecordingAPI) List(ctx context.Context, callSid string, params RecordingListParams) (RecordingList, error) {
+	var recs RecordingList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Calls/%s/Recordings.json%s", callSid, params.query()), &recs)
+	return recs, err
+}
+
+// Start starts recording a live call, eg. the call of a conference participant.
+// POST /Accounts/{Account SID}/Calls/{Call SID}/Recordings.json
+// https://www.twilio.com/docs/voice/api/recording#create-a-recording-resource
+func (api callRecordingAPI) Start(ctx context.Context, callSid string, body RecordingCreateParams) (Recording, error) {
+	return api.post(ctx, fmt.Sprintf("/Calls/%s/Recordings.json", callSid), body.encode())
+}
+
+// Update changes the status of a recording in progress, the recording sid can be
+// `CurrentRecording`.
+// POST /Accounts/{Account SID}/Calls/{Call SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#update-a-recording-resource
+func (api callRecordingAPI) Update(ctx context.Context, callSid, recordingSid string, body RecordingUpdateParams) (Recording, error) {
+	return api.post(ctx, fmt.Sprintf("/Calls/%s/Recordings/%s.json", callSid, recordingSid), body.encode())
+}
+
+// Pause pauses a recording, the paused audio is replaced by silence.
+// POST /Accounts/{Account SID}/Calls/{Call SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#update-a-recording-resource
+func (api callRecordingAPI) Pause(ctx context.Context, callSid, recordingSid string) (Recording, error) {
+	return api.Update(ctx, callSid, recordingSid, RecordingUpdateParams{Status: RecordingStatusPaused})
+}
+
+// Resume resumes a paused recording.
+// POST /Accounts/{Account SID}/Calls/{Call SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#update-a-recording-resource
+func (api callRecordingAPI) Resume(ctx context.Context, callSid, recordingSid string) (Recording, error) {
+	return api.Update(ctx, callSid, recordingSid, RecordingUpdateParams{Status: RecordingStatusInProgress})
+}
+
+// Stop stops a recording, it cannot be resumed.
+// POST /Accounts/{Account SID}/Calls/{Call SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#update-a-recording-resource
+func (api callRecordingAPI) Stop(ctx context.Context, callSid, recordingSid string) (Recording, error) {
+	return api.Update(ctx, callSid, recordingSid, RecordingUpdateParams{Status: RecordingStatusStopped})
+}
+
+// DELETE /Accounts/{Account SID}/Calls/{Call SID}/Recordings/{Recording SID}.json
+// https://www.twilio.com/docs/voice/api/recording#delete-a-recording-resource
+func (api callRecordingAPI) Delete(ctx context.Context, callSid, recordingSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Calls/%s/Recordings/%s.json", callSid, recordingSid))
+	return err
+}
+
+func (api callRecordingAPI) post(ctx context.Context, path string, body io.Reader) (Recording, error) {
+	var rec Recording
+	err := twilio.PostInto(ctx, api.client, path, body, &rec)
+	return rec, err
+}
@@ -0,0 +1,465 @@
+package voice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRecordingRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (recordingAPI{client}).Read(context.TODO(), "RE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (recordingAPI{client}).Read(ctx, "RE1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRecordingList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Recordings.json?DateCreated%3C=2016-11-01&DateCreated%3E=2016-10-01"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recordings.json")
+		}
+
+		var (
+			exp  RecordingList
+			f, _ = os.Open("fixtures/recordings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		recs, err := (recordingAPI{client}).List(context.TODO(), RecordingListParams{DateCreatedAfter: "2016-10-01", DateCreatedBefore: "2016-11-01"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, recs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, recs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (recordingAPI{client}).List(ctx, RecordingListParams{DateCreatedAfter: "2016-10-01", DateCreatedBefore: "2016-11-01"})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRecordingDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (recordingAPI{client}).Delete(context.TODO(), "RE1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (recordingAPI{client}).Delete(ctx, "RE1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestCallRecordingRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Calls/CA1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (callRecordingAPI{client}).Read(context.TODO(), "CA1", "RE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callRecordingAPI{client}).Read(ctx, "CA1", "RE1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCallRecordingList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Calls/CA1/Recordings.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/recordings.json")
+		}
+
+		var (
+			exp  RecordingList
+			f, _ = os.Open("fixtures/recordings.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		recs, err := (callRecordingAPI{client}).List(context.TODO(), "CA1", RecordingListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, recs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, recs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callRecordingAPI{client}).List(ctx, "CA1", RecordingListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestCallRecordingStart(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("RecordingChannels=dual&RecordingStatusCallback=https%3A%2F%2Fexample.com%2Frec")
+			)
+
+			if exp := "/Calls/CA1/Recordings.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (callRecordingAPI{client}).Start(context.TODO(), "CA1", RecordingCreateParams{RecordingChannels: "dual", RecordingStatusCallback: "https://example.com/rec"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callRecordingAPI{client}).Start(ctx, "CA1", RecordingCreateParams{RecordingChannels: "dual", RecordingStatusCallback: "https://example.com/rec"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallRecordingUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("PauseBehavior=skip&Status=paused")
+			)
+
+			if exp := "/Calls/CA1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (callRecordingAPI{client}).Update(context.TODO(), "CA1", "RE1", RecordingUpdateParams{Status: RecordingStatusPaused, PauseBehavior: "skip"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callRecordingAPI{client}).Update(ctx, "CA1", "RE1", RecordingUpdateParams{Status: RecordingStatusPaused, PauseBehavior: "skip"})
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallRecordingPause(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=paused")
+			)
+
+			if exp := "/Calls/CA1/Recordings/Twilio.CURRENT.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (callRecordingAPI{client}).Pause(context.TODO(), "CA1", CurrentRecording)
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callRecordingAPI{client}).Pause(ctx, "CA1", CurrentRecording)
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallRecordingResume(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=in-progress")
+			)
+
+			if exp := "/Calls/CA1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (callRecordingAPI{client}).Resume(context.TODO(), "CA1", "RE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callRecordingAPI{client}).Resume(ctx, "CA1", "RE1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallRecordingStop(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.PostFunc = func(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+			var (
+				gotBody, _ = ioutil.ReadAll(body)
+				expBody    = []byte("Status=stopped")
+			)
+
+			if exp := "/Calls/CA1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			if !bytes.Equal(expBody, gotBody) {
+				t.Errorf("exp req body %s, got %s", expBody, gotBody)
+			}
+			return ioutil.ReadFile("fixtures/recording.json")
+		}
+
+		var (
+			exp  Recording
+			f, _ = os.Open("fixtures/recording.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		rec, err := (callRecordingAPI{client}).Stop(context.TODO(), "CA1", "RE1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, rec) {
+			t.Errorf("response diff %v", cmp.Diff(exp, rec))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (callRecordingAPI{client}).Stop(ctx, "CA1", "RE1")
+		}
+		APIMock(fn).TestPosts((t))
+	})
+}
+
+func TestCallRecordingDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Calls/CA1/Recordings/RE1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (callRecordingAPI{client}).Delete(context.TODO(), "CA1", "RE1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (callRecordingAPI{client}).Delete(ctx, "CA1", "RE1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestRecordingEach(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var paths []string
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			paths = append(paths, path)
+			if len(paths) == 1 {
+				return ioutil.ReadFile("fixtures/recordings.json")
+			}
+			return []byte(`{"recordings": [{"sid": "RE2"}]}`), nil
+		}
+
+		var sids []string
+		err := (recordingAPI{client}).Each(context.TODO(), RecordingListParams{DateCreatedAfter: "2016-10-01"}, func(rec Recording) error {
+			sids = append(sids, rec.Sid)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+
+		exp := []string{
+			"/Recordings.json?DateCreated%3E=2016-10-01",
+			"/Recordings.json?DateCreated%3E=2016-10-01&Page=1&PageSize=1&PageToken=PArecordings",
+		}
+		if !cmp.Equal(exp, paths) {
+			t.Errorf("paths diff %v", cmp.Diff(exp, paths))
+		}
+		if exp := []string{"REXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "RE2"}; !cmp.Equal(exp, sids) {
+			t.Errorf("sids diff %v", cmp.Diff(exp, sids))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return nil, (recordingAPI{client}).Each(ctx, RecordingListParams{}, func(Recording) error { return nil })
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestRecordingDownload(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+		if exp := "/Recordings/RE1.mp3"; exp != path {
+			t.Errorf("exp path %s, got %s", exp, path)
+		}
+		return []byte("ID3"), nil
+	}
+
+	body, err := (recordingAPI{client}).Download(context.TODO(), "RE1", FormatMP3)
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	defer body.Close()
+
+	if got, _ := ioutil.ReadAll(body); !bytes.Equal([]byte("ID3"), got) {
+		t.Errorf("exp body ID3, got %s", got)
+	}
+}
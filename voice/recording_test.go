@@ -0,0 +1,13 @@
+package voice
+
+import "testing"
+
+func TestRecordingParamsOptionals(t *testing.T) {
+	exp := []byte("Status=")
+	t.Run("UpdateParams", optionalsFn(RecordingUpdateParams{}, exp))
+}
+
+func TestRecordingCreateParamsOptionals(t *testing.T) {
+	exp := []byte("")
+	t.Run("CreateParams", optionalsFn(RecordingCreateParams{}, exp))
+}
@@ -0,0 +1,35 @@
+package voice
+
+// TranscriptionResource handles interactions with Transcriptions REST API.
+type TranscriptionResource struct {
+	transcriptionAPI
+}
+
+// Transcription is the text of a recording made with the transcribe option of Record.
+type Transcription struct {
+	Sid               string `json:"sid"`
+	AccountSid        string `json:"account_sid"`
+	RecordingSid      string `json:"recording_sid"`
+	Status            string `json:"status"`
+	TranscriptionText string `json:"transcription_text"`
+	Type              string `json:"type"`
+
+	// Duration seconds, returned as a string.
+	Duration  string `json:"duration"`
+	Price     string `json:"price"`
+	PriceUnit string `json:"price_unit"`
+
+	// DateCreated RFC 2822 format.
+	DateCreated string `json:"date_created"`
+
+	// DateUpdated RFC 2822 format.
+	DateUpdated string `json:"date_updated"`
+	APIVersion  string `json:"api_version"`
+	URI         string `json:"uri"`
+}
+
+// TranscriptionList holds a page of transcriptions.
+type TranscriptionList struct {
+	Transcriptions []Transcription `json:"transcriptions"`
+	Meta
+}
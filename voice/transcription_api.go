@@ -0,0 +1,52 @@
+package voice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type transcriptionAPI struct {
+	client twilio.HTTPClient
+}
+
+// GET /Accounts/{Account SID}/Transcriptions/{Transcription SID}.json
+// https://www.twilio.com/docs/voice/api/recording-transcription#fetch-a-transcription-resource
+func (api transcriptionAPI) Read(ctx context.Context, transcriptionSid string) (Transcription, error) {
+	var tr Transcription
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Transcriptions/%s.json", transcriptionSid), &tr)
+	return tr, err
+}
+
+// GET /Accounts/{Account SID}/Transcriptions.json
+// https://www.twilio.com/docs/voice/api/recording-transcription#read-multiple-transcription-resources
+func (api transcriptionAPI) List(ctx context.Context, params ListParams) (TranscriptionList, error) {
+	var trs TranscriptionList
+	err := twilio.GetInto(ctx, api.client, "/Transcriptions.json"+twilio.Query(params), &trs)
+	return trs, err
+}
+
+// ListByRecording lists the transcriptions of a recording.
+// GET /Accounts/{Account SID}/Recordings/{Recording SID}/Transcriptions.json
+// https://www.twilio.com/docs/voice/api/recording-transcription#read-multiple-transcription-resources
+func (api transcriptionAPI) ListByRecording(ctx context.Context, recordingSid string, params ListParams) (TranscriptionList, error) {
+	var trs TranscriptionList
+	err := twilio.GetInto(ctx, api.client, fmt.Sprintf("/Recordings/%s/Transcriptions.json%s", recordingSid, twilio.Query(params)), &trs)
+	return trs, err
+}
+
+// Text returns the plain text of a transcription.
+// GET /Accounts/{Account SID}/Transcriptions/{Transcription SID}.txt
+// https://www.twilio.com/docs/voice/api/recording-transcription#fetch-a-transcription-resource
+func (api transcriptionAPI) Text(ctx context.Context, transcriptionSid string) (string, error) {
+	text, err := api.client.Get(ctx, fmt.Sprintf("/Transcriptions/%s.txt", transcriptionSid))
+	return string(text), err
+}
+
+// DELETE /Accounts/{Account SID}/Transcriptions/{Transcription SID}.json
+// https://www.twilio.com/docs/voice/api/recording-transcription#delete-a-transcription-resource
+func (api transcriptionAPI) Delete(ctx context.Context, transcriptionSid string) error {
+	_, err := api.client.Delete(ctx, fmt.Sprintf("/Transcriptions/%s.json", transcriptionSid))
+	return err
+}
@@ -0,0 +1,155 @@
+package voice
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTranscriptionRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Transcriptions/TR1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/transcription.json")
+		}
+
+		var (
+			exp  Transcription
+			f, _ = os.Open("fixtures/transcription.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		tr, err := (transcriptionAPI{client}).Read(context.TODO(), "TR1")
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, tr) {
+			t.Errorf("response diff %v", cmp.Diff(exp, tr))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (transcriptionAPI{client}).Read(ctx, "TR1")
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestTranscriptionList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Transcriptions.json?PageSize=1"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/transcriptions.json")
+		}
+
+		var (
+			exp  TranscriptionList
+			f, _ = os.Open("fixtures/transcriptions.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		trs, err := (transcriptionAPI{client}).List(context.TODO(), ListParams{PageSize: 1})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, trs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, trs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (transcriptionAPI{client}).List(ctx, ListParams{PageSize: 1})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestTranscriptionListByRecording(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Recordings/RE1/Transcriptions.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return ioutil.ReadFile("fixtures/transcriptions.json")
+		}
+
+		var (
+			exp  TranscriptionList
+			f, _ = os.Open("fixtures/transcriptions.json")
+		)
+		json.NewDecoder(f).Decode(&exp)
+
+		trs, err := (transcriptionAPI{client}).ListByRecording(context.TODO(), "RE1", ListParams{})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !cmp.Equal(exp, trs) {
+			t.Errorf("response diff %v", cmp.Diff(exp, trs))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			return (transcriptionAPI{client}).ListByRecording(ctx, "RE1", ListParams{})
+		}
+		APIMock(fn).TestGets((t))
+	})
+}
+
+func TestTranscriptionDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := &HTTPClientMock{}
+		client.DeleteFunc = func(ctx context.Context, path string) ([]byte, error) {
+			if exp := "/Transcriptions/TR1.json"; exp != path {
+				t.Errorf("exp path %s, got %s", exp, path)
+			}
+			return nil, nil
+		}
+
+		if err := (transcriptionAPI{client}).Delete(context.TODO(), "TR1"); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if !client.DeleteInvoked {
+			t.Error("exp delete invoked")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fn := func(ctx context.Context, client *HTTPClientMock) (interface{}, error) {
+			err := (transcriptionAPI{client}).Delete(ctx, "TR1")
+			return nil, err
+		}
+		APIMock(fn).TestDeletes((t))
+	})
+}
+
+func TestTranscriptionText(t *testing.T) {
+	client := &HTTPClientMock{}
+	client.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+		if exp := "/Transcriptions/TR1.txt"; exp != path {
+			t.Errorf("exp path %s, got %s", exp, path)
+		}
+		return []byte("Hi, please call me back."), nil
+	}
+
+	text, err := (transcriptionAPI{client}).Text(context.TODO(), "TR1")
+	if err != nil {
+		t.Errorf("exp no err, got %v", err)
+	}
+	if exp := "Hi, please call me back."; exp != text {
+		t.Errorf("exp %s, got %s", exp, text)
+	}
+}
@@ -0,0 +1,68 @@
+// Package voice is a client of the Twilio Programmable Voice API, placing and
+// controlling the calls of an account.
+package voice
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/smnalex/twilio-go"
+)
+
+// ErrMissingAccountSID returned when the context holds no account sid, the
+// voice API is scoped by account.
+var ErrMissingAccountSID = errors.New("voice: missing account sid")
+
+// Voice programmable voice interface
+type Voice struct {
+	Calls                CallResource
+	Conferences          ConferenceResource
+	Participants         ParticipantResource
+	ConferenceRecordings ConferenceRecordingResource
+	Recordings           RecordingResource
+	CallRecordings       CallRecordingResource
+	Transcriptions       TranscriptionResource
+}
+
+// New returns a voice instance with a base url set to `https://api.twilio.com/2010-04-01`
+// if `TWILIO_API_HOST` not set, scoped by the account sid of the context.
+func New(tctx twilio.Context) (Voice, error) {
+	var voice Voice
+	if tctx.AccountSID == "" {
+		return voice, ErrMissingAccountSID
+	}
+
+	client, err := twilio.NewHTTPClient(
+		tctx.APIKey,
+		tctx.APISecret,
+		fmt.Sprintf("%s/Accounts/%s", apiEndpointForRegion(tctx.Region), tctx.AccountSID),
+		tctx.RequestHandler,
+		twilio.WithLogger(tctx.Logger),
+		twilio.WithMaxBodySize(tctx.MaxBodySize),
+	)
+	if err != nil {
+		return voice, err
+	}
+
+	{
+		voice.Calls = CallResource{callAPI{client}}
+		voice.Conferences = ConferenceResource{conferenceAPI{client}}
+		voice.Participants = ParticipantResource{participantAPI{client}}
+		voice.ConferenceRecordings = ConferenceRecordingResource{conferenceRecordingAPI{client}}
+		voice.Recordings = RecordingResource{recordingAPI{client}}
+		voice.CallRecordings = CallRecordingResource{callRecordingAPI{client}}
+		voice.Transcriptions = TranscriptionResource{transcriptionAPI{client}}
+	}
+	return voice, nil
+}
+
+func apiEndpointForRegion(region string) string {
+	url := os.Getenv("TWILIO_API_HOST")
+	if url == "" && region != "" {
+		return fmt.Sprintf("https://api.%s.twilio.com/2010-04-01", region)
+	} else if url == "" {
+		return "https://api.twilio.com/2010-04-01"
+	}
+	return url
+}
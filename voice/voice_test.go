@@ -0,0 +1,56 @@
+package voice
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("unsuccessful invalid env url", func(t *testing.T) {
+		os.Setenv("TWILIO_API_HOST", "%2")
+		if _, err := New(twilio.Context{AccountSID: "AC1"}); err == nil {
+			t.Errorf("exp parsing err, got none")
+		}
+		os.Unsetenv("TWILIO_API_HOST")
+	})
+
+	t.Run("missing account sid", func(t *testing.T) {
+		if _, err := New(twilio.Context{}); err != ErrMissingAccountSID {
+			t.Errorf("exp err %v, got %v", ErrMissingAccountSID, err)
+		}
+	})
+
+	t.Run("voice services", func(t *testing.T) {
+		_, err := New(twilio.Context{AccountSID: "AC1"})
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}
+
+func TestAPIEndpoint(t *testing.T) {
+	exp := "https://api.twilio.com/2010-04-01"
+
+	t.Run("default url", func(*testing.T) {
+		if got := apiEndpointForRegion(""); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("default url with region", func(*testing.T) {
+		exp := "https://api.ie1.twilio.com/2010-04-01"
+		if got := apiEndpointForRegion("ie1"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("env url", func(*testing.T) {
+		os.Setenv("TWILIO_API_HOST", exp)
+		if got := apiEndpointForRegion("ie1"); got != exp {
+			t.Errorf("exp url %s, got %s", exp, got)
+		}
+		os.Unsetenv("TWILIO_API_HOST")
+	})
+}
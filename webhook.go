@@ -0,0 +1,88 @@
+package twilio
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SignatureHeader holds the signature of the webhook requests made by Twilio.
+const SignatureHeader = "X-Twilio-Signature"
+
+// ErrInvalidSignature returned when a webhook request is not signed by Twilio.
+var ErrInvalidSignature = errors.New("twilio: invalid webhook signature")
+
+// Signature returns the base64 HMAC-SHA1 of the url followed by the params sorted by
+// key, signed with the auth token of the account.
+// https://www.twilio.com/docs/usage/security#validating-requests
+func Signature(authToken, url string, params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(url))
+	for _, k := range keys {
+		for _, v := range params[k] {
+			mac.Write([]byte(k + v))
+		}
+	}
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateSignature reports whether the signature matches the url and params.
+func ValidateSignature(authToken, url string, params url.Values, signature string) bool {
+	return hmac.Equal([]byte(Signature(authToken, url, params)), []byte(signature))
+}
+
+// WebhookValidator validates the signature of the webhook requests made by Twilio.
+type WebhookValidator struct {
+	// AuthToken of the account the webhooks are configured on, API secrets
+	// cannot validate signatures.
+	AuthToken string
+
+	// URL optional, the public url of the webhook when served behind a proxy
+	// rewriting the host or scheme. Defaults to the url of the request.
+	URL string
+}
+
+// Validate parses the form of a webhook request and validates its signature,
+// the params are returned only for valid requests.
+func (wv WebhookValidator) Validate(r *http.Request) (url.Values, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, errors.Wrap(err, "twilio: could not parse webhook form")
+	}
+
+	var (
+		signature = r.Header.Get(SignatureHeader)
+		params    = r.PostForm
+		u         = wv.URL
+	)
+	if u == "" {
+		u = requestURL(r)
+	}
+	if signature == "" || !ValidateSignature(wv.AuthToken, u, params, signature) {
+		return nil, ErrInvalidSignature
+	}
+	return params, nil
+}
+
+// requestURL rebuilds the url the request was sent to, honoring the scheme set by proxies.
+func requestURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.ToLower(strings.TrimSpace(strings.Split(proto, ",")[0]))
+	}
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
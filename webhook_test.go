@@ -0,0 +1,85 @@
+package twilio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+var webhookParams = url.Values{
+	"CallSid": {"CA1234567890ABCDE"},
+	"Caller":  {"+12349013030"},
+	"Digits":  {"1234"},
+	"From":    {"+12349013030"},
+	"To":      {"+18005551212"},
+}
+
+const webhookURL = "https://mycompany.com/myapp.php?foo=1&bar=2"
+
+func TestSignature(t *testing.T) {
+	got := Signature("12345", webhookURL, webhookParams)
+	if exp := "0/KCTR6DLpKmkAf8muzZqo1nDgQ="; exp != got {
+		t.Errorf("exp %s, got %s", exp, got)
+	}
+
+	if !ValidateSignature("12345", webhookURL, webhookParams, got) {
+		t.Error("exp valid signature")
+	}
+	if ValidateSignature("54321", webhookURL, webhookParams, got) {
+		t.Error("exp invalid signature for another auth token")
+	}
+}
+
+func TestWebhookValidator(t *testing.T) {
+	newRequest := func(signature string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, webhookURL, strings.NewReader(webhookParams.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set(SignatureHeader, signature)
+		return r
+	}
+	signature := Signature("12345", webhookURL, webhookParams)
+
+	t.Run("valid", func(t *testing.T) {
+		params, err := (WebhookValidator{AuthToken: "12345"}).Validate(newRequest(signature))
+		if err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+		if exp, got := "1234", params.Get("Digits"); exp != got {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, sig := range []string{"", "0/KCTR6DLpKmkAf8muzZqo1nDgQ"} {
+			_, err := (WebhookValidator{AuthToken: "12345"}).Validate(newRequest(sig))
+			if err != ErrInvalidSignature {
+				t.Errorf("exp %v, got %v", ErrInvalidSignature, err)
+			}
+		}
+	})
+
+	t.Run("behind proxy", func(t *testing.T) {
+		r := newRequest(signature)
+		r.Host = "internal:8080"
+		if _, err := (WebhookValidator{AuthToken: "12345"}).Validate(r); err != ErrInvalidSignature {
+			t.Errorf("exp %v, got %v", ErrInvalidSignature, err)
+		}
+
+		r = newRequest(signature)
+		r.Host = "internal:8080"
+		if _, err := (WebhookValidator{AuthToken: "12345", URL: webhookURL}).Validate(r); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+
+	t.Run("forwarded proto", func(t *testing.T) {
+		r := newRequest(signature)
+		r.TLS = nil
+		r.Header.Set("X-Forwarded-Proto", "https")
+		if _, err := (WebhookValidator{AuthToken: "12345"}).Validate(r); err != nil {
+			t.Errorf("exp no err, got %v", err)
+		}
+	})
+}